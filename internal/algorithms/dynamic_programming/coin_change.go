@@ -0,0 +1,263 @@
+package dp
+
+import (
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxCoinAmount bounds the amount, and with it the length of the table
+const maxCoinAmount = 200
+
+// CoinChange implements the dynamic programming solution for making an
+// amount from the fewest coins
+type CoinChange struct {
+	metadata types.Algorithm
+}
+
+// NewCoinChange creates a new CoinChange instance
+func NewCoinChange() *CoinChange {
+	return &CoinChange{
+		metadata: types.Algorithm{
+			ID:          "coin_change",
+			Name:        "Coin Change (Minimum Coins)",
+			Category:    types.CategoryDynamicProgramming,
+			Description: "Finds the fewest coins that add up to an amount, with unlimited coins of each denomination. Entry a of the table holds the fewest coins for amount a: one more than the best for a - c over every coin c that fits. Greedily taking the largest coin can miss the optimum, as with 6 from 1, 3 and 4; the table cannot. Following the coin that set each entry back from the amount lists the coins used.",
+			BigO:        "Time: O(A · k), Space: O(A) for amount A and k denominations",
+			Parameters: []types.Parameter{
+				{
+					Name:        "amount",
+					Type:        "int",
+					Description: "Amount to make",
+					Default:     11,
+					Min:         types.IntPtr(1),
+					Max:         types.IntPtr(maxCoinAmount),
+					Required:    true,
+				},
+				{
+					Name:        "denominations",
+					Type:        "string",
+					Description: "Comma-separated coin values, 1 to 10 distinct positive integers, e.g. \"1,2,5\"",
+					Default:     "1,2,5",
+					Required:    true,
+				},
+			},
+			RelatedIDs: []string{"knapsack_01"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (cc *CoinChange) GetMetadata() types.Algorithm {
+	return cc.metadata
+}
+
+// Execute fills the table for every amount up to the target, then follows
+// the recorded coins back from it
+func (cc *CoinChange) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	amount := 11
+	if a, ok := parameters["amount"].(int); ok {
+		amount = a
+	}
+
+	list := "1,2,5"
+	if d, ok := parameters["denominations"].(string); ok {
+		list = d
+	}
+	coins, err := parseDenominations(list)
+	if err != nil {
+		return nil, err
+	}
+
+	// table[a] is the fewest coins making a, or -1 while a is unreachable,
+	// and lastCoin[a] the coin that achieved it
+	table := make([]int, amount+1)
+	lastCoin := make([]int, amount+1)
+	for a := 1; a <= amount; a++ {
+		table[a] = -1
+	}
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"amount":        amount,
+			"denominations": coins,
+			"table":         append([]int{}, table...),
+		},
+		Message:   fmt.Sprintf("Making %d from coins %v; 0 takes no coins and every other amount starts unreachable", amount, coins),
+		Timestamp: time.Now(),
+	})
+
+	for a := 1; a <= amount; a++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		// Each coin that lowers the count so far, in the order tried
+		updates := []int{}
+		for _, coin := range coins {
+			if coin > a || table[a-coin] < 0 {
+				continue
+			}
+			if count := table[a-coin] + 1; table[a] < 0 || count < table[a] {
+				table[a] = count
+				lastCoin[a] = coin
+				updates = append(updates, coin)
+			}
+		}
+
+		data := map[string]interface{}{
+			"current_amount": a,
+			"updates":        updates,
+			"min_coins":      table[a],
+			"table":          append([]int{}, table...),
+		}
+		message := fmt.Sprintf("Amount %d cannot be made from these coins", a)
+		if table[a] >= 0 {
+			data["coin"] = lastCoin[a]
+			message = fmt.Sprintf("Fewest coins for %d is %d: coin %d on top of the best for %d", a, table[a], lastCoin[a], a-lastCoin[a])
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: a,
+			Action:     "fill_amount",
+			Data:       data,
+			Message:    message,
+			Timestamp:  time.Now(),
+		})
+	}
+
+	if table[amount] < 0 {
+		stepCallback(types.ExecutionStep{
+			StepNumber: amount + 1,
+			Action:     "impossible",
+			Data: map[string]interface{}{
+				"amount":        amount,
+				"denominations": coins,
+			},
+			Message:   fmt.Sprintf("No combination of coins %v adds up to %d", coins, amount),
+			Timestamp: time.Now(),
+		})
+	}
+
+	// Follow the coin that set each entry back down to 0
+	chosen := []int{}
+	if table[amount] >= 0 {
+		for a := amount; a > 0; a -= lastCoin[a] {
+			chosen = append(chosen, lastCoin[a])
+		}
+	}
+
+	result := map[string]interface{}{
+		"amount":        amount,
+		"denominations": coins,
+		"min_coins":     table[amount],
+		"coins":         chosen,
+		"impossible":    table[amount] < 0,
+		"table":         table,
+	}
+
+	message := fmt.Sprintf("Fewest coins for %d is %d: %v", amount, table[amount], chosen)
+	if table[amount] < 0 {
+		message = fmt.Sprintf("%d is impossible to make from coins %v", amount, coins)
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data:       result,
+		Message:    message,
+		Timestamp:  time.Now(),
+	})
+
+	return result, nil
+}
+
+// parseDenominations parses a comma-separated list of 1 to 10 distinct
+// positive coin values
+func parseDenominations(list string) ([]int, error) {
+	fields := strings.Split(list, ",")
+	if len(fields) > 10 {
+		return nil, fmt.Errorf("denominations must list at most 10 coins")
+	}
+
+	coins := make([]int, 0, len(fields))
+	seen := map[int]bool{}
+	for _, field := range fields {
+		coin, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil || coin < 1 || coin > maxCoinAmount {
+			return nil, fmt.Errorf("denominations must be integers between 1 and %d, got %q", maxCoinAmount, strings.TrimSpace(field))
+		}
+		if seen[coin] {
+			return nil, fmt.Errorf("denomination %d is listed twice", coin)
+		}
+		seen[coin] = true
+		coins = append(coins, coin)
+	}
+
+	return coins, nil
+}
+
+// ValidateParameters validates the input parameters
+func (cc *CoinChange) ValidateParameters(parameters map[string]interface{}) error {
+	if amount, ok := parameters["amount"].(int); ok {
+		if amount < 1 || amount > maxCoinAmount {
+			return fmt.Errorf("amount must be between 1 and %d", maxCoinAmount)
+		}
+	}
+
+	if denominations, exists := parameters["denominations"]; exists {
+		list, ok := denominations.(string)
+		if !ok {
+			return fmt.Errorf("denominations must be a comma-separated string")
+		}
+		if _, err := parseDenominations(list); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SelfTest checks known counts, including one greedy gets wrong and an
+// impossible amount, and that the chosen coins add up
+func (cc *CoinChange) SelfTest() error {
+	cases := []struct {
+		amount        int
+		denominations string
+		minCoins      int
+	}{
+		{11, "1,2,5", 3},
+		{6, "1,3,4", 2},
+		{7, "2,4", -1},
+		{100, "25, 10, 5, 1", 4},
+		{3, "5", -1},
+	}
+	for _, c := range cases {
+		output, err := cc.Execute(context.Background(), nil, map[string]interface{}{"amount": c.amount, "denominations": c.denominations}, func(types.ExecutionStep) {})
+		if err != nil {
+			return fmt.Errorf("execution failed: %v", err)
+		}
+
+		result := output.(map[string]interface{})
+		if got := result["min_coins"].(int); got != c.minCoins {
+			return fmt.Errorf("%d from %s: expected %d coins, got %d", c.amount, c.denominations, c.minCoins, got)
+		}
+
+		total := 0
+		chosen := result["coins"].([]int)
+		for _, coin := range chosen {
+			total += coin
+		}
+		if c.minCoins >= 0 && (total != c.amount || len(chosen) != c.minCoins) {
+			return fmt.Errorf("%d from %s: chosen coins %v do not make it in %d", c.amount, c.denominations, chosen, c.minCoins)
+		}
+	}
+
+	return nil
+}
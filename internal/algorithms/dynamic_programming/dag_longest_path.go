@@ -0,0 +1,299 @@
+package dp
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	graphs "algorthmia/internal/algorithms/graphs_trees"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"time"
+)
+
+// DAGLongestPath finds the heaviest path in a weighted directed acyclic graph
+// by relaxing edges in topological order
+type DAGLongestPath struct {
+	metadata types.Algorithm
+}
+
+// NewDAGLongestPath creates a new DAGLongestPath instance
+func NewDAGLongestPath() *DAGLongestPath {
+	return &DAGLongestPath{
+		metadata: types.Algorithm{
+			ID:          "dag_longest_path",
+			Name:        "Longest Path in a DAG",
+			Category:    types.CategoryDynamicProgramming,
+			Description: "Finds the heaviest path in a weighted DAG. Nodes are visited in topological order, so every path into a node is final before the node's outgoing edges are relaxed, and each edge is relaxed once to keep the heavier of the two candidate lengths. In a general graph the problem is NP-hard; without cycles it is linear.",
+			BigO:        "Time: O(V + E), Space: O(V) where V is vertices and E is edges",
+			Parameters: []types.Parameter{
+				{
+					Name:        "num_nodes",
+					Type:        "int",
+					Description: "Number of nodes in the graph",
+					Default:     8,
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(20),
+					Required:    true,
+				},
+				{
+					Name:        "edge_density",
+					Type:        "float",
+					Description: "Probability that each pair of nodes is joined by an edge, from 0.0 to 1.0",
+					Default:     0.35,
+					Required:    false,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible graph generation",
+					Default:     nil,
+					Required:    false,
+				},
+			},
+			InputType:  types.InputEdgeList,
+			RelatedIDs: []string{"topological_sort", "bellman_ford"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (lp *DAGLongestPath) GetMetadata() types.Algorithm {
+	return lp.metadata
+}
+
+// Execute orders the supplied edges or a generated DAG topologically and
+// relaxes each node's outgoing edges in that order
+func (lp *DAGLongestPath) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	numNodes := 8
+	if n, ok := parameters["num_nodes"].(int); ok {
+		numNodes = n
+	}
+
+	var edges []generators.Edge
+	if input != nil {
+		inputEdges, ok := input.([]generators.Edge)
+		if !ok {
+			return nil, fmt.Errorf("invalid input type, expected a list of edges")
+		}
+		edges = inputEdges
+	} else {
+		edges = lp.GenerateInstance(parameters).([]generators.Edge)
+	}
+
+	// Reuse Kahn's algorithm for the order; it also rejects edges outside the graph
+	sorted, err := graphs.NewTopologicalSort().Execute(ctx, edges, map[string]interface{}{"graph_size": numNodes}, func(types.ExecutionStep) {})
+	if err != nil {
+		return nil, err
+	}
+	topological := sorted.(map[string]interface{})
+	if isDAG, _ := topological["is_dag"].(bool); !isDAG {
+		return nil, fmt.Errorf("the graph has a cycle through nodes %v, so it has no longest path", topological["remaining"])
+	}
+	order := topological["order"].([]int)
+
+	outgoing := make([][]generators.Edge, numNodes)
+	for _, e := range edges {
+		outgoing[e.From] = append(outgoing[e.From], e)
+	}
+
+	// Every node starts a path of weight 0; predecessor -1 marks a start
+	distances := make([]int, numNodes)
+	predecessors := make(map[int]int, numNodes)
+	for node := 0; node < numNodes; node++ {
+		predecessors[node] = -1
+	}
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"edges":     edges,
+			"num_nodes": numNodes,
+		},
+		Message:   fmt.Sprintf("Finding the longest path in a DAG of %d nodes and %d edges", numNodes, len(edges)),
+		Timestamp: time.Now(),
+	})
+
+	stepCallback(types.ExecutionStep{
+		StepNumber: 1,
+		Action:     "topo_order",
+		Data: map[string]interface{}{
+			"order":        order,
+			"distances":    distances,
+			"predecessors": predecessors,
+		},
+		Message:   fmt.Sprintf("Topological order: %v; every edge points forward in it", order),
+		Timestamp: time.Now(),
+	})
+
+	stepNumber := 2
+	updates := 0
+
+	for _, node := range order {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "relax_in_topo_order",
+			Data: map[string]interface{}{
+				"node":         node,
+				"distance":     distances[node],
+				"edges":        outgoing[node],
+				"distances":    distances,
+				"predecessors": predecessors,
+			},
+			Message:   fmt.Sprintf("Node %d is final at %d; relaxing its %d outgoing edges", node, distances[node], len(outgoing[node])),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+
+		for _, e := range outgoing[node] {
+			candidate := distances[node] + e.Weight
+			if candidate <= distances[e.To] {
+				continue
+			}
+
+			previous := distances[e.To]
+			distances[e.To] = candidate
+			predecessors[e.To] = node
+			updates++
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "update_longest",
+				Data: map[string]interface{}{
+					"from":         node,
+					"to":           e.To,
+					"weight":       e.Weight,
+					"previous":     previous,
+					"distance":     candidate,
+					"distances":    distances,
+					"predecessors": predecessors,
+				},
+				Message:   fmt.Sprintf("Path to %d through %d weighs %d, more than %d", e.To, node, candidate, previous),
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+		}
+	}
+
+	// The path ends at the heaviest node; walk predecessors back to its start
+	end := 0
+	for node, distance := range distances {
+		if distance > distances[end] {
+			end = node
+		}
+	}
+	path := []int{}
+	for node := end; node != -1; node = predecessors[node] {
+		path = append([]int{node}, path...)
+	}
+
+	result := map[string]interface{}{
+		"edges":        edges,
+		"order":        order,
+		"distances":    distances,
+		"predecessors": predecessors,
+		"path":         path,
+		"weight":       distances[end],
+		"updates":      updates,
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data:       result,
+		Message:    fmt.Sprintf("Longest path %v weighs %d", path, distances[end]),
+		Timestamp:  time.Now(),
+	})
+
+	return result, nil
+}
+
+// GenerateInstance generates the DAG Execute runs on when given no input
+func (lp *DAGLongestPath) GenerateInstance(parameters map[string]interface{}) interface{} {
+	numNodes := 8
+	if n, ok := parameters["num_nodes"].(int); ok {
+		numNodes = n
+	}
+
+	density := 0.35
+	if d, ok := parameters["edge_density"].(float64); ok {
+		density = d
+	}
+
+	return generators.DAGEdges(generators.NewRand(parameters), numNodes, density, 1, 20)
+}
+
+// ValidateParameters validates the input parameters
+func (lp *DAGLongestPath) ValidateParameters(parameters map[string]interface{}) error {
+	if numNodes, ok := parameters["num_nodes"].(int); ok {
+		if numNodes < 3 || numNodes > 20 {
+			return fmt.Errorf("num_nodes must be between 3 and 20")
+		}
+	}
+
+	if density, exists := parameters["edge_density"]; exists {
+		d, ok := density.(float64)
+		if !ok || d < 0 || d > 1 {
+			return fmt.Errorf("edge_density must be a number between 0.0 and 1.0")
+		}
+	}
+
+	return nil
+}
+
+// SelfTest checks a hand-made DAG whose longest path is not the one with the
+// most edges, and generated DAGs against an exhaustive search
+func (lp *DAGLongestPath) SelfTest() error {
+	// 0 -> 1 -> 2 -> 3 weighs 3; 0 -> 2 -> 3 weighs 11
+	edges := []generators.Edge{
+		{From: 0, To: 1, Weight: 1}, {From: 1, To: 2, Weight: 1}, {From: 2, To: 3, Weight: 1},
+		{From: 0, To: 2, Weight: 10},
+	}
+	output, err := lp.Execute(context.Background(), edges, map[string]interface{}{"num_nodes": 4}, func(types.ExecutionStep) {})
+	if err != nil {
+		return fmt.Errorf("execution failed: %v", err)
+	}
+	result := output.(map[string]interface{})
+	if path := fmt.Sprint(result["path"]); path != "[0 2 3]" || result["weight"].(int) != 11 {
+		return fmt.Errorf("expected path [0 2 3] of weight 11, got %s of weight %d", path, result["weight"])
+	}
+
+	for seed := 1; seed <= 5; seed++ {
+		output, err := lp.Execute(context.Background(), nil, map[string]interface{}{"num_nodes": 10, "edge_density": 0.4, "seed": seed}, func(types.ExecutionStep) {})
+		if err != nil {
+			return fmt.Errorf("execution failed: %v", err)
+		}
+
+		result := output.(map[string]interface{})
+		outgoing := make([][]generators.Edge, 10)
+		for _, e := range result["edges"].([]generators.Edge) {
+			outgoing[e.From] = append(outgoing[e.From], e)
+		}
+		var heaviest func(node int) int
+		heaviest = func(node int) int {
+			best := 0
+			for _, e := range outgoing[node] {
+				if w := e.Weight + heaviest(e.To); w > best {
+					best = w
+				}
+			}
+			return best
+		}
+
+		expected := 0
+		for node := 0; node < 10; node++ {
+			if w := heaviest(node); w > expected {
+				expected = w
+			}
+		}
+		if weight := result["weight"].(int); weight != expected {
+			return fmt.Errorf("seed %d: expected longest path weight %d, got %d", seed, expected, weight)
+		}
+	}
+
+	return nil
+}
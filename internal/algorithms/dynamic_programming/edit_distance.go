@@ -0,0 +1,247 @@
+package dp
+
+import (
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"time"
+)
+
+// editOperation is one entry of an edit script. Matches are listed too, so
+// the script walks both strings in full
+type editOperation struct {
+	Op          string `json:"op"` // "match", "substitute", "insert" or "delete"
+	SourceIndex int    `json:"source_index"`
+	TargetIndex int    `json:"target_index"`
+	From        string `json:"from,omitempty"`
+	To          string `json:"to,omitempty"`
+}
+
+// EditDistance implements the Levenshtein distance between two strings
+type EditDistance struct {
+	metadata types.Algorithm
+}
+
+// NewEditDistance creates a new EditDistance instance
+func NewEditDistance() *EditDistance {
+	return &EditDistance{
+		metadata: types.Algorithm{
+			ID:          "edit_distance",
+			Name:        "Edit Distance (Levenshtein)",
+			Category:    types.CategoryDynamicProgramming,
+			Description: "Counts the fewest single-character insertions, deletions and substitutions that turn the source string into the target. Cell [i][j] of the table holds the distance from the first i source characters to the first j target characters: the diagonal when the characters match, and otherwise one more than the cheapest of substituting (diagonal), deleting (above) and inserting (left). Walking back from the last cell gives an edit script.",
+			BigO:        "Time: O(m · n), Space: O(m · n) for strings of lengths m and n",
+			Parameters: []types.Parameter{
+				{
+					Name:        "source",
+					Type:        "string",
+					Description: "String to transform, up to 25 characters",
+					Default:     "kitten",
+					Required:    true,
+				},
+				{
+					Name:        "target",
+					Type:        "string",
+					Description: "String to transform it into, up to 25 characters",
+					Default:     "sitting",
+					Required:    true,
+				},
+			},
+			RelatedIDs: []string{"lcs"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (ed *EditDistance) GetMetadata() types.Algorithm {
+	return ed.metadata
+}
+
+// Execute fills the table row by row, then walks back from the last cell to
+// build the edit script
+func (ed *EditDistance) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	sourceString := "kitten"
+	if s, ok := parameters["source"].(string); ok {
+		sourceString = s
+	}
+
+	targetString := "sitting"
+	if t, ok := parameters["target"].(string); ok {
+		targetString = t
+	}
+
+	source, target := []rune(sourceString), []rune(targetString)
+
+	// table[i][j] is the distance from source[:i] to target[:j], and ops[i][j]
+	// the operation that reached it. Row 0 inserts every target character
+	// and column 0 deletes every source character
+	table := make([][]int, len(source)+1)
+	ops := make([][]string, len(source)+1)
+	for i := range table {
+		table[i] = make([]int, len(target)+1)
+		ops[i] = make([]string, len(target)+1)
+		table[i][0] = i
+		ops[i][0] = "delete"
+	}
+	for j := range table[0] {
+		table[0][j] = j
+		ops[0][j] = "insert"
+	}
+	ops[0][0] = ""
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"source": sourceString,
+			"target": targetString,
+			"table":  partialTable(table, 0, len(target)),
+		},
+		Message:   fmt.Sprintf("Transforming %q into %q; the first row and column count insertions into and deletions from an empty string", sourceString, targetString),
+		Timestamp: time.Now(),
+	})
+
+	stepNumber := 1
+	for i := 1; i <= len(source); i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		for j := 1; j <= len(target); j++ {
+			substitute, remove, insert := table[i-1][j-1], table[i-1][j]+1, table[i][j-1]+1
+
+			var message string
+			if source[i-1] == target[j-1] {
+				ops[i][j] = "match"
+				message = fmt.Sprintf("%q matches: keeping diagonal %d", source[i-1], substitute)
+			} else {
+				substitute++
+				ops[i][j] = "substitute"
+				message = fmt.Sprintf("%q and %q differ: substituting costs %d", source[i-1], target[j-1], substitute)
+			}
+			table[i][j] = substitute
+
+			// Prefer the diagonal, then deleting, on ties
+			if remove < table[i][j] {
+				table[i][j] = remove
+				ops[i][j] = "delete"
+				message = fmt.Sprintf("Deleting %q is cheapest at %d", source[i-1], remove)
+			}
+			if insert < table[i][j] {
+				table[i][j] = insert
+				ops[i][j] = "insert"
+				message = fmt.Sprintf("Inserting %q is cheapest at %d", target[j-1], insert)
+			}
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "fill_cell",
+				Data: map[string]interface{}{
+					"row":        i,
+					"column":     j,
+					"char_from":  string(source[i-1]),
+					"char_to":    string(target[j-1]),
+					"substitute": substitute,
+					"delete":     remove,
+					"insert":     insert,
+					"operation":  ops[i][j],
+					"value":      table[i][j],
+					"table":      partialTable(table, i, j),
+				},
+				Message:   message,
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+		}
+	}
+
+	// Walk back along the recorded operations, then reverse into source order
+	script := []editOperation{}
+	for i, j := len(source), len(target); i > 0 || j > 0; {
+		op := editOperation{Op: ops[i][j], SourceIndex: i - 1, TargetIndex: j - 1}
+		switch op.Op {
+		case "match", "substitute":
+			op.From, op.To = string(source[i-1]), string(target[j-1])
+			i--
+			j--
+		case "delete":
+			op.From = string(source[i-1])
+			i--
+		case "insert":
+			op.To = string(target[j-1])
+			j--
+		}
+		script = append(script, op)
+	}
+	for l, r := 0, len(script)-1; l < r; l, r = l+1, r-1 {
+		script[l], script[r] = script[r], script[l]
+	}
+
+	distance := table[len(source)][len(target)]
+	result := map[string]interface{}{
+		"source":   sourceString,
+		"target":   targetString,
+		"distance": distance,
+		"table":    table,
+		"script":   script,
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data:       result,
+		Message:    fmt.Sprintf("%q becomes %q in %d edits", sourceString, targetString, distance),
+		Timestamp:  time.Now(),
+	})
+
+	return result, nil
+}
+
+// ValidateParameters validates the input parameters
+func (ed *EditDistance) ValidateParameters(parameters map[string]interface{}) error {
+	if err := validateTableString(parameters, "source"); err != nil {
+		return err
+	}
+	return validateTableString(parameters, "target")
+}
+
+// SelfTest checks known distances, and that applying each edit script to
+// the source gives the target in that many edits
+func (ed *EditDistance) SelfTest() error {
+	cases := []struct {
+		source, target string
+		distance       int
+	}{
+		{"kitten", "sitting", 3},
+		{"flaw", "lawn", 2},
+		{"", "abc", 3},
+		{"abc", "", 3},
+		{"same", "same", 0},
+		{"intention", "execution", 5},
+	}
+	for _, c := range cases {
+		output, err := ed.Execute(context.Background(), nil, map[string]interface{}{"source": c.source, "target": c.target}, func(types.ExecutionStep) {})
+		if err != nil {
+			return fmt.Errorf("execution failed: %v", err)
+		}
+
+		result := output.(map[string]interface{})
+		if got := result["distance"].(int); got != c.distance {
+			return fmt.Errorf("%q to %q: expected distance %d, got %d", c.source, c.target, c.distance, got)
+		}
+
+		built, edits := "", 0
+		for _, op := range result["script"].([]editOperation) {
+			built += op.To
+			if op.Op != "match" {
+				edits++
+			}
+		}
+		if built != c.target || edits != c.distance {
+			return fmt.Errorf("%q to %q: script builds %q in %d edits", c.source, c.target, built, edits)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,288 @@
+package dp
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"math"
+	"time"
+)
+
+// HeldKarp implements the Held–Karp dynamic programming algorithm for the
+// traveling salesman problem
+type HeldKarp struct {
+	metadata types.Algorithm
+}
+
+// NewHeldKarp creates a new HeldKarp instance
+func NewHeldKarp() *HeldKarp {
+	return &HeldKarp{
+		metadata: types.Algorithm{
+			ID:          "held_karp_tsp",
+			Name:        "Held–Karp TSP",
+			Category:    types.CategoryDynamicProgramming,
+			Description: "An exact dynamic programming solution to the traveling salesman problem that computes the cheapest way to reach each city having visited every subset of cities, exponential but far faster than trying every tour.",
+			BigO:        "Time: O(2^n · n²), Space: O(2^n · n)",
+			Parameters: []types.Parameter{
+				{
+					Name:        "num_cities",
+					Type:        "int",
+					Description: "Number of cities to visit",
+					Default:     8,
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(12),
+					Required:    true,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible city placement",
+					Default:     nil,
+					Required:    false,
+				},
+			},
+			InputType:  types.InputPointList,
+			RelatedIDs: []string{"hill_climbing"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (hk *HeldKarp) GetMetadata() types.Algorithm {
+	return hk.metadata
+}
+
+// Execute runs the Held–Karp algorithm
+func (hk *HeldKarp) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	numCities := 8
+	if n, ok := parameters["num_cities"].(int); ok {
+		numCities = n
+	}
+
+	// Use a copy of the supplied cities, or generate them
+	var cities []generators.Point
+	if input != nil {
+		inputPoints, ok := input.([]generators.Point)
+		if !ok {
+			return nil, fmt.Errorf("invalid input type, expected a list of points")
+		}
+		if err := generators.ValidatePoints(inputPoints, numCities); err != nil {
+			return nil, err
+		}
+		cities = append([]generators.Point{}, inputPoints...)
+	} else {
+		cities = hk.GenerateInstance(parameters).([]generators.Point)
+	}
+	distances := distanceMatrix(cities)
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"cities":     cities,
+			"distances":  distances,
+			"num_cities": numCities,
+			"table_size": (1 << numCities) * numCities,
+		},
+		Message:   fmt.Sprintf("Starting Held–Karp over %d cities from city 0", numCities),
+		Timestamp: time.Now(),
+	})
+
+	// cost[mask][j] is the cheapest path starting at city 0, visiting exactly
+	// the cities in mask and ending at city j
+	full := 1 << numCities
+	cost := make([][]float64, full)
+	parent := make([][]int, full)
+	for mask := range cost {
+		cost[mask] = make([]float64, numCities)
+		parent[mask] = make([]int, numCities)
+		for j := range cost[mask] {
+			cost[mask][j] = math.Inf(1)
+			parent[mask][j] = -1
+		}
+	}
+	cost[1][0] = 0
+
+	stepNumber := 1
+	for mask := 3; mask < full; mask++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		// Every path starts at city 0
+		if mask&1 == 0 {
+			continue
+		}
+
+		for j := 1; j < numCities; j++ {
+			if mask&(1<<j) == 0 {
+				continue
+			}
+
+			previous := mask ^ (1 << j)
+			for k := 0; k < numCities; k++ {
+				if previous&(1<<k) == 0 || math.IsInf(cost[previous][k], 1) {
+					continue
+				}
+
+				if candidate := cost[previous][k] + distances[k][j]; candidate < cost[mask][j] {
+					cost[mask][j] = candidate
+					parent[mask][j] = k
+				}
+			}
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "fill_subset",
+			Data: map[string]interface{}{
+				"subset":      mask,
+				"visited":     subsetCities(mask, numCities),
+				"row":         tableRow(cost[mask]),
+				"parents":     parent[mask],
+				"subsets_max": full - 1,
+			},
+			Message:   fmt.Sprintf("Filled minimum costs for subset %v", subsetCities(mask, numCities)),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+	}
+
+	// Close the tour by returning to city 0
+	last := full - 1
+	bestLength := math.Inf(1)
+	bestEnd := -1
+	for j := 1; j < numCities; j++ {
+		if candidate := cost[last][j] + distances[j][0]; candidate < bestLength {
+			bestLength = candidate
+			bestEnd = j
+		}
+	}
+
+	// Reconstruct the tour by walking parents back from the best final city
+	tour := []int{0}
+	mask, city := last, bestEnd
+	for city > 0 {
+		tour = append(tour, city)
+		previous := parent[mask][city]
+		mask ^= 1 << city
+		city = previous
+	}
+	tour = append(tour, 0)
+	for i, j := 0, len(tour)-1; i < j; i, j = i+1, j-1 {
+		tour[i], tour[j] = tour[j], tour[i]
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data: map[string]interface{}{
+			"cities":      cities,
+			"tour":        tour,
+			"tour_length": bestLength,
+		},
+		Message:   fmt.Sprintf("Optimal tour found with length %.2f", bestLength),
+		Timestamp: time.Now(),
+	})
+
+	return map[string]interface{}{
+		"tour":        tour,
+		"tour_length": bestLength,
+		"cities":      cities,
+	}, nil
+}
+
+// GenerateInstance generates the cities Execute runs on when given no input
+func (hk *HeldKarp) GenerateInstance(parameters map[string]interface{}) interface{} {
+	numCities := 8
+	if n, ok := parameters["num_cities"].(int); ok {
+		numCities = n
+	}
+
+	return generators.Points(generators.NewRand(parameters), numCities, 100)
+}
+
+// ValidateParameters validates the input parameters
+func (hk *HeldKarp) ValidateParameters(parameters map[string]interface{}) error {
+	if numCities, ok := parameters["num_cities"].(int); ok {
+		if numCities < 3 || numCities > 12 {
+			return fmt.Errorf("num_cities must be between 3 and 12")
+		}
+	}
+	return nil
+}
+
+// SelfTest verifies the optimal tour length against a brute-force search
+func (hk *HeldKarp) SelfTest() error {
+	output, err := hk.Execute(context.Background(), nil, map[string]interface{}{"num_cities": 7, "seed": 11}, func(types.ExecutionStep) {})
+	if err != nil {
+		return fmt.Errorf("execution failed: %v", err)
+	}
+
+	result := output.(map[string]interface{})
+	distances := distanceMatrix(result["cities"].([]generators.Point))
+
+	expected := math.Inf(1)
+	var permute func(order []int, k int)
+	permute = func(order []int, k int) {
+		if k == len(order) {
+			length := distances[0][order[0]] + distances[order[len(order)-1]][0]
+			for i := 1; i < len(order); i++ {
+				length += distances[order[i-1]][order[i]]
+			}
+			expected = math.Min(expected, length)
+			return
+		}
+		for i := k; i < len(order); i++ {
+			order[k], order[i] = order[i], order[k]
+			permute(order, k+1)
+			order[k], order[i] = order[i], order[k]
+		}
+	}
+	permute([]int{1, 2, 3, 4, 5, 6}, 0)
+
+	if length := result["tour_length"].(float64); math.Abs(length-expected) > 1e-9 {
+		return fmt.Errorf("expected tour length %.4f, got %.4f", expected, length)
+	}
+
+	return nil
+}
+
+// distanceMatrix returns the pairwise Euclidean distances between cities
+func distanceMatrix(cities []generators.Point) [][]float64 {
+	distances := make([][]float64, len(cities))
+	for i := range cities {
+		distances[i] = make([]float64, len(cities))
+		for j := range cities {
+			dx := float64(cities[i].X - cities[j].X)
+			dy := float64(cities[i].Y - cities[j].Y)
+			distances[i][j] = math.Sqrt(dx*dx + dy*dy)
+		}
+	}
+	return distances
+}
+
+// subsetCities lists the cities contained in a bitmask subset
+func subsetCities(mask, numCities int) []int {
+	cities := []int{}
+	for i := 0; i < numCities; i++ {
+		if mask&(1<<i) != 0 {
+			cities = append(cities, i)
+		}
+	}
+	return cities
+}
+
+// tableRow converts a row of the DP table into a JSON-safe form, using nil
+// for cities that cannot end a path over the subset
+func tableRow(row []float64) []interface{} {
+	values := make([]interface{}, len(row))
+	for i, v := range row {
+		if !math.IsInf(v, 1) {
+			values[i] = v
+		}
+	}
+	return values
+}
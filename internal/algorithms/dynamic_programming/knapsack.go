@@ -0,0 +1,283 @@
+package dp
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"time"
+)
+
+// knapsackItem is an item that can be packed once
+type knapsackItem struct {
+	Weight int `json:"weight"`
+	Value  int `json:"value"`
+}
+
+// Knapsack01 implements the dynamic programming solution to the 0/1
+// knapsack problem
+type Knapsack01 struct {
+	metadata types.Algorithm
+}
+
+// NewKnapsack01 creates a new Knapsack01 instance
+func NewKnapsack01() *Knapsack01 {
+	return &Knapsack01{
+		metadata: types.Algorithm{
+			ID:          "knapsack_01",
+			Name:        "0/1 Knapsack",
+			Category:    types.CategoryDynamicProgramming,
+			Description: "Packs the most valuable set of items that fits in a knapsack, each item taken whole or not at all. Cell [i][w] of the table holds the best value from the first i items within capacity w: the better of leaving item i out, the cell above, and packing it, its value plus the best for the capacity left over. The chosen items are read back by walking up from the last cell and noting where the value changes.",
+			BigO:        "Time: O(n · W), Space: O(n · W) for n items and capacity W",
+			Parameters: []types.Parameter{
+				{
+					Name:        "num_items",
+					Type:        "int",
+					Description: "Number of items to choose from",
+					Default:     6,
+					Min:         types.IntPtr(2),
+					Max:         types.IntPtr(15),
+					Required:    true,
+				},
+				{
+					Name:        "capacity",
+					Type:        "int",
+					Description: "Total weight the knapsack can hold",
+					Default:     15,
+					Min:         types.IntPtr(1),
+					Max:         types.IntPtr(50),
+					Required:    true,
+				},
+				{
+					Name:        "max_weight",
+					Type:        "int",
+					Description: "Largest weight of a generated item",
+					Default:     10,
+					Min:         types.IntPtr(1),
+					Max:         types.IntPtr(50),
+					Required:    false,
+				},
+				{
+					Name:        "max_value",
+					Type:        "int",
+					Description: "Largest value of a generated item",
+					Default:     30,
+					Min:         types.IntPtr(1),
+					Max:         types.IntPtr(100),
+					Required:    false,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible item generation",
+					Default:     nil,
+					Required:    false,
+				},
+			},
+			RelatedIDs: []string{"genetic_algorithm"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (k *Knapsack01) GetMetadata() types.Algorithm {
+	return k.metadata
+}
+
+// Execute fills the table one item and capacity at a time, then walks it
+// back to find the chosen items
+func (k *Knapsack01) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	numItems := 6
+	if n, ok := parameters["num_items"].(int); ok {
+		numItems = n
+	}
+
+	capacity := 15
+	if c, ok := parameters["capacity"].(int); ok {
+		capacity = c
+	}
+
+	maxWeight := 10
+	if w, ok := parameters["max_weight"].(int); ok {
+		maxWeight = w
+	}
+
+	maxValue := 30
+	if v, ok := parameters["max_value"].(int); ok {
+		maxValue = v
+	}
+
+	rng := generators.NewRand(parameters)
+	items := make([]knapsackItem, numItems)
+	for i := range items {
+		items[i] = knapsackItem{Weight: 1 + rng.Intn(maxWeight), Value: 1 + rng.Intn(maxValue)}
+	}
+
+	// table[i][w] is the best value from the first i items within weight w;
+	// row 0, with no items, is all zeros
+	table := make([][]int, numItems+1)
+	for i := range table {
+		table[i] = make([]int, capacity+1)
+	}
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"items":    items,
+			"capacity": capacity,
+			"table":    partialTable(table, 0, capacity),
+		},
+		Message:   fmt.Sprintf("Packing %d items into a knapsack of capacity %d", numItems, capacity),
+		Timestamp: time.Now(),
+	})
+
+	stepNumber := 1
+	for i := 1; i <= numItems; i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		item := items[i-1]
+		for w := 0; w <= capacity; w++ {
+			without := table[i-1][w]
+			table[i][w] = without
+
+			data := map[string]interface{}{
+				"item":     i - 1,
+				"weight":   item.Weight,
+				"value":    item.Value,
+				"capacity": w,
+				"without":  without,
+				"fits":     item.Weight <= w,
+				"included": false,
+			}
+			message := fmt.Sprintf("Item %d (weight %d) does not fit in capacity %d; keeping %d", i-1, item.Weight, w, without)
+
+			if item.Weight <= w {
+				with := item.Value + table[i-1][w-item.Weight]
+				data["with"] = with
+				message = fmt.Sprintf("Item %d at capacity %d: leaving it out gives %d, packing it gives %d + %d = %d", i-1, w, without, item.Value, table[i-1][w-item.Weight], with)
+				if with > without {
+					table[i][w] = with
+					data["included"] = true
+					message += ", so it is packed"
+				}
+			}
+			data["best"] = table[i][w]
+			data["table"] = partialTable(table, i, w)
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "fill_cell",
+				Data:       data,
+				Message:    message,
+				Timestamp:  time.Now(),
+			})
+			stepNumber++
+		}
+	}
+
+	// A cell that differs from the one above took its item
+	chosen := []int{}
+	totalWeight := 0
+	for i, w := numItems, capacity; i > 0; i-- {
+		if table[i][w] != table[i-1][w] {
+			chosen = append([]int{i - 1}, chosen...)
+			w -= items[i-1].Weight
+			totalWeight += items[i-1].Weight
+		}
+	}
+
+	result := map[string]interface{}{
+		"items":         items,
+		"capacity":      capacity,
+		"optimal_value": table[numItems][capacity],
+		"chosen_items":  chosen,
+		"total_weight":  totalWeight,
+		"table":         table,
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data:       result,
+		Message:    fmt.Sprintf("Best value is %d, packing items %v with total weight %d of %d", table[numItems][capacity], chosen, totalWeight, capacity),
+		Timestamp:  time.Now(),
+	})
+
+	return result, nil
+}
+
+// ValidateParameters validates the input parameters
+func (k *Knapsack01) ValidateParameters(parameters map[string]interface{}) error {
+	if numItems, ok := parameters["num_items"].(int); ok {
+		if numItems < 2 || numItems > 15 {
+			return fmt.Errorf("num_items must be between 2 and 15")
+		}
+	}
+
+	if capacity, ok := parameters["capacity"].(int); ok {
+		if capacity < 1 || capacity > 50 {
+			return fmt.Errorf("capacity must be between 1 and 50")
+		}
+	}
+
+	if maxWeight, ok := parameters["max_weight"].(int); ok {
+		if maxWeight < 1 || maxWeight > 50 {
+			return fmt.Errorf("max_weight must be between 1 and 50")
+		}
+	}
+
+	if maxValue, ok := parameters["max_value"].(int); ok {
+		if maxValue < 1 || maxValue > 100 {
+			return fmt.Errorf("max_value must be between 1 and 100")
+		}
+	}
+
+	return nil
+}
+
+// SelfTest checks the optimal value and the chosen items against every
+// subset of the items
+func (k *Knapsack01) SelfTest() error {
+	for seed := 1; seed <= 10; seed++ {
+		output, err := k.Execute(context.Background(), nil, map[string]interface{}{"num_items": 10, "capacity": 30, "seed": seed}, func(types.ExecutionStep) {})
+		if err != nil {
+			return fmt.Errorf("execution failed: %v", err)
+		}
+
+		result := output.(map[string]interface{})
+		items := result["items"].([]knapsackItem)
+
+		best := 0
+		for subset := 0; subset < 1<<len(items); subset++ {
+			weight, value := 0, 0
+			for i, item := range items {
+				if subset&(1<<i) != 0 {
+					weight += item.Weight
+					value += item.Value
+				}
+			}
+			if weight <= 30 && value > best {
+				best = value
+			}
+		}
+
+		if got := result["optimal_value"].(int); got != best {
+			return fmt.Errorf("seed %d: expected best value %d, got %d", seed, best, got)
+		}
+
+		weight, value := 0, 0
+		for _, i := range result["chosen_items"].([]int) {
+			weight += items[i].Weight
+			value += items[i].Value
+		}
+		if weight > 30 || value != best {
+			return fmt.Errorf("seed %d: chosen items weigh %d and are worth %d, expected at most 30 and %d", seed, weight, value, best)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,197 @@
+package dp
+
+import (
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"time"
+)
+
+// LCS implements the dynamic programming solution to the longest common
+// subsequence of two strings
+type LCS struct {
+	metadata types.Algorithm
+}
+
+// NewLCS creates a new LCS instance
+func NewLCS() *LCS {
+	return &LCS{
+		metadata: types.Algorithm{
+			ID:          "lcs",
+			Name:        "Longest Common Subsequence",
+			Category:    types.CategoryDynamicProgramming,
+			Description: "Finds the longest sequence of characters that appears in both strings in order, though not necessarily contiguously. Cell [i][j] of the table holds the LCS length of the first i characters of one string and the first j of the other: the diagonal plus one when those characters match, and otherwise the larger of the cells above and to the left. Backtracking from the last cell collects the matched characters.",
+			BigO:        "Time: O(m · n), Space: O(m · n) for strings of lengths m and n",
+			Parameters: []types.Parameter{
+				{
+					Name:        "string_a",
+					Type:        "string",
+					Description: "First string, up to 25 characters",
+					Default:     "ABCBDAB",
+					Required:    true,
+				},
+				{
+					Name:        "string_b",
+					Type:        "string",
+					Description: "Second string, up to 25 characters",
+					Default:     "BDCABA",
+					Required:    true,
+				},
+			},
+			RelatedIDs: []string{"knapsack_01"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (l *LCS) GetMetadata() types.Algorithm {
+	return l.metadata
+}
+
+// Execute fills the table row by row, then backtracks from the last cell
+func (l *LCS) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	stringA := "ABCBDAB"
+	if s, ok := parameters["string_a"].(string); ok {
+		stringA = s
+	}
+
+	stringB := "BDCABA"
+	if s, ok := parameters["string_b"].(string); ok {
+		stringB = s
+	}
+
+	a, b := []rune(stringA), []rune(stringB)
+
+	// table[i][j] is the LCS length of a[:i] and b[:j]; row and column 0,
+	// against an empty string, are all zeros
+	table := make([][]int, len(a)+1)
+	for i := range table {
+		table[i] = make([]int, len(b)+1)
+	}
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"string_a": stringA,
+			"string_b": stringB,
+			"table":    partialTable(table, 0, len(b)),
+		},
+		Message:   fmt.Sprintf("Finding the longest common subsequence of %q and %q", stringA, stringB),
+		Timestamp: time.Now(),
+	})
+
+	stepNumber := 1
+	for i := 1; i <= len(a); i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		for j := 1; j <= len(b); j++ {
+			match := a[i-1] == b[j-1]
+
+			var source, message string
+			switch {
+			case match:
+				table[i][j] = table[i-1][j-1] + 1
+				source = "diagonal"
+				message = fmt.Sprintf("%q matches at a[%d] and b[%d]: diagonal %d + 1 = %d", a[i-1], i-1, j-1, table[i-1][j-1], table[i][j])
+			case table[i-1][j] >= table[i][j-1]:
+				table[i][j] = table[i-1][j]
+				source = "top"
+				message = fmt.Sprintf("%q and %q differ: taking %d from above over %d from the left", a[i-1], b[j-1], table[i-1][j], table[i][j-1])
+			default:
+				table[i][j] = table[i][j-1]
+				source = "left"
+				message = fmt.Sprintf("%q and %q differ: taking %d from the left over %d from above", a[i-1], b[j-1], table[i][j-1], table[i-1][j])
+			}
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "fill_cell",
+				Data: map[string]interface{}{
+					"row":      i,
+					"column":   j,
+					"char_a":   string(a[i-1]),
+					"char_b":   string(b[j-1]),
+					"match":    match,
+					"source":   source,
+					"value":    table[i][j],
+					"string_a": stringA,
+					"string_b": stringB,
+					"table":    partialTable(table, i, j),
+				},
+				Message:   message,
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+		}
+	}
+
+	// Walk back from the last cell, collecting characters on diagonal moves
+	// and otherwise following the neighbor the value came from
+	lcs := []rune{}
+	for i, j := len(a), len(b); i > 0 && j > 0; {
+		switch {
+		case a[i-1] == b[j-1]:
+			lcs = append([]rune{a[i-1]}, lcs...)
+			i--
+			j--
+		case table[i-1][j] >= table[i][j-1]:
+			i--
+		default:
+			j--
+		}
+	}
+
+	result := map[string]interface{}{
+		"string_a": stringA,
+		"string_b": stringB,
+		"lcs":      string(lcs),
+		"length":   len(lcs),
+		"table":    table,
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data:       result,
+		Message:    fmt.Sprintf("Longest common subsequence is %q, of length %d", string(lcs), len(lcs)),
+		Timestamp:  time.Now(),
+	})
+
+	return result, nil
+}
+
+// ValidateParameters validates the input parameters
+func (l *LCS) ValidateParameters(parameters map[string]interface{}) error {
+	if err := validateTableString(parameters, "string_a"); err != nil {
+		return err
+	}
+	return validateTableString(parameters, "string_b")
+}
+
+// SelfTest checks known subsequences, including empty and disjoint strings
+func (l *LCS) SelfTest() error {
+	cases := [][3]string{
+		{"ABCBDAB", "BDCABA", "BCBA"},
+		{"AGGTAB", "GXTXAYB", "GTAB"},
+		{"", "ABC", ""},
+		{"ABC", "XYZ", ""},
+		{"héllo", "hallo", "hllo"},
+	}
+	for _, c := range cases {
+		output, err := l.Execute(context.Background(), nil, map[string]interface{}{"string_a": c[0], "string_b": c[1]}, func(types.ExecutionStep) {})
+		if err != nil {
+			return fmt.Errorf("execution failed: %v", err)
+		}
+
+		result := output.(map[string]interface{})
+		if got := result["lcs"].(string); got != c[2] {
+			return fmt.Errorf("LCS of %q and %q: expected %q, got %q", c[0], c[1], c[2], got)
+		}
+	}
+
+	return nil
+}
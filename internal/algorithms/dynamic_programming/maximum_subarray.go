@@ -0,0 +1,234 @@
+package dp
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"time"
+)
+
+// MaximumSubarray implements Kadane's algorithm for the contiguous subarray
+// with the largest sum
+type MaximumSubarray struct {
+	metadata types.Algorithm
+}
+
+// NewMaximumSubarray creates a new MaximumSubarray instance
+func NewMaximumSubarray() *MaximumSubarray {
+	return &MaximumSubarray{
+		metadata: types.Algorithm{
+			ID:          "maximum_subarray",
+			Name:        "Maximum Subarray (Kadane)",
+			Category:    types.CategoryDynamicProgramming,
+			Description: "Finds the contiguous subarray with the largest sum in one pass. The best sum ending at each element either extends the best sum ending at the one before or, when that sum is negative and can only drag the total down, starts over at the element. The largest of these running sums is the answer. With no negative values the whole array wins.",
+			BigO:        "Time: O(n), Space: O(1)",
+			Parameters: []types.Parameter{
+				{
+					Name:        "array_size",
+					Type:        "int",
+					Description: "Size of the array to scan",
+					Default:     12,
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(50),
+					Required:    true,
+				},
+				{
+					Name:        "allow_negatives",
+					Type:        "bool",
+					Description: "Generate values from -20 to 20 rather than 1 to 20",
+					Default:     true,
+					Required:    false,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible array generation",
+					Default:     nil,
+					Required:    false,
+				},
+			},
+			RelatedIDs: []string{"coin_change"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (ms *MaximumSubarray) GetMetadata() types.Algorithm {
+	return ms.metadata
+}
+
+// Execute runs Kadane's algorithm
+func (ms *MaximumSubarray) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	var arr []int
+	if input != nil {
+		if inputArr, ok := input.([]int); ok {
+			arr = inputArr
+		} else {
+			return nil, fmt.Errorf("invalid input type, expected []int")
+		}
+	} else {
+		arraySize := 12
+		if size, ok := parameters["array_size"].(int); ok {
+			arraySize = size
+		}
+
+		allowNegatives := true
+		if a, ok := parameters["allow_negatives"].(bool); ok {
+			allowNegatives = a
+		}
+
+		rng := generators.NewRand(parameters)
+		arr = make([]int, arraySize)
+		for i := range arr {
+			if allowNegatives {
+				arr[i] = rng.Intn(41) - 20
+			} else {
+				arr[i] = rng.Intn(20) + 1
+			}
+		}
+	}
+
+	if len(arr) == 0 {
+		return nil, fmt.Errorf("array must not be empty")
+	}
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"array": arr,
+		},
+		Message:   fmt.Sprintf("Scanning %d elements for the subarray with the largest sum", len(arr)),
+		Timestamp: time.Now(),
+	})
+
+	currentSum, currentStart := 0, 0
+	maxSum, bestStart, bestEnd := arr[0], 0, 0
+
+	for i, value := range arr {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		// A negative running sum only lowers whatever follows it
+		reset := i == 0 || currentSum < 0
+		if reset {
+			currentSum, currentStart = value, i
+		} else {
+			currentSum += value
+		}
+
+		improved := i == 0 || currentSum > maxSum
+		if improved {
+			maxSum, bestStart, bestEnd = currentSum, currentStart, i
+		}
+
+		message := fmt.Sprintf("Adding %d extends the running sum to %d", value, currentSum)
+		if reset {
+			message = fmt.Sprintf("Starting over at %d, since the running sum before it was negative", value)
+			if i == 0 {
+				message = fmt.Sprintf("Starting with %d", value)
+			}
+		}
+		if improved {
+			message += fmt.Sprintf("; best so far is %d over [%d, %d]", maxSum, bestStart, bestEnd)
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: i + 1,
+			Action:     "scan_element",
+			Data: map[string]interface{}{
+				"index":         i,
+				"value":         value,
+				"current_sum":   currentSum,
+				"current_start": currentStart,
+				"reset":         reset,
+				"max_sum":       maxSum,
+				"best_start":    bestStart,
+				"best_end":      bestEnd,
+			},
+			Message:   message,
+			Timestamp: time.Now(),
+		})
+	}
+
+	result := map[string]interface{}{
+		"array":    arr,
+		"max_sum":  maxSum,
+		"start":    bestStart,
+		"end":      bestEnd,
+		"subarray": arr[bestStart : bestEnd+1],
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data:       result,
+		Message:    fmt.Sprintf("Maximum subarray sum is %d, from index %d to %d", maxSum, bestStart, bestEnd),
+		Timestamp:  time.Now(),
+	})
+
+	return result, nil
+}
+
+// ValidateParameters validates the input parameters
+func (ms *MaximumSubarray) ValidateParameters(parameters map[string]interface{}) error {
+	if arraySize, ok := parameters["array_size"].(int); ok {
+		if arraySize < 3 || arraySize > 50 {
+			return fmt.Errorf("array_size must be between 3 and 50")
+		}
+	}
+
+	if allow, exists := parameters["allow_negatives"]; exists {
+		if _, ok := allow.(bool); !ok {
+			return fmt.Errorf("allow_negatives must be a boolean")
+		}
+	}
+
+	return nil
+}
+
+// SelfTest checks a known array, an all-negative one, and generated arrays
+// against every subarray
+func (ms *MaximumSubarray) SelfTest() error {
+	output, err := ms.Execute(context.Background(), []int{-2, 1, -3, 4, -1, 2, 1, -5, 4}, map[string]interface{}{}, func(types.ExecutionStep) {})
+	if err != nil {
+		return fmt.Errorf("execution failed: %v", err)
+	}
+	result := output.(map[string]interface{})
+	if result["max_sum"].(int) != 6 || result["start"].(int) != 3 || result["end"].(int) != 6 {
+		return fmt.Errorf("expected sum 6 over [3, 6], got %v over [%v, %v]", result["max_sum"], result["start"], result["end"])
+	}
+
+	output, _ = ms.Execute(context.Background(), []int{-3, -1, -2}, map[string]interface{}{}, func(types.ExecutionStep) {})
+	if sum := output.(map[string]interface{})["max_sum"].(int); sum != -1 {
+		return fmt.Errorf("expected -1 for an all-negative array, got %d", sum)
+	}
+
+	for seed := 1; seed <= 10; seed++ {
+		output, err := ms.Execute(context.Background(), nil, map[string]interface{}{"array_size": 30, "seed": seed}, func(types.ExecutionStep) {})
+		if err != nil {
+			return fmt.Errorf("execution failed: %v", err)
+		}
+		result := output.(map[string]interface{})
+		arr := result["array"].([]int)
+
+		best := arr[0]
+		for i := range arr {
+			sum := 0
+			for j := i; j < len(arr); j++ {
+				sum += arr[j]
+				if sum > best {
+					best = sum
+				}
+			}
+		}
+		if got := result["max_sum"].(int); got != best {
+			return fmt.Errorf("seed %d: expected %d, got %d", seed, best, got)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package dp
+
+import "fmt"
+
+// maxTableStringLength bounds the strings compared by the string table
+// algorithms, keeping their tables small enough to render
+const maxTableStringLength = 25
+
+// partialTable copies the rows of a table filled row by row so far, up to
+// and including cell [row][column]
+func partialTable(table [][]int, row, column int) [][]int {
+	partial := make([][]int, row+1)
+	for i := range partial {
+		partial[i] = append([]int{}, table[i]...)
+	}
+	partial[row] = partial[row][:column+1]
+	return partial
+}
+
+// validateTableString checks that a string parameter, if given, is a string
+// of at most maxTableStringLength characters
+func validateTableString(parameters map[string]interface{}, name string) error {
+	if value, exists := parameters[name]; exists {
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%s must be a string", name)
+		}
+		if len([]rune(s)) > maxTableStringLength {
+			return fmt.Errorf("%s must be at most %d characters", name, maxTableStringLength)
+		}
+	}
+	return nil
+}
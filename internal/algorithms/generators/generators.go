@@ -0,0 +1,185 @@
+package generators
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Point represents a point on the 2D plane used by geometric algorithms
+type Point struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+// Abs returns the absolute value of an int
+func Abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// NewRand returns a random source seeded from the "seed" parameter when
+// present, falling back to a time-based seed otherwise
+func NewRand(parameters map[string]interface{}) *rand.Rand {
+	if seed, ok := parameters["seed"].(int); ok {
+		return rand.New(rand.NewSource(int64(seed)))
+	}
+	return rand.New(rand.NewSource(time.Now().UnixNano()))
+}
+
+// Permutation returns the values 1 to size in a random order, shuffled by
+// rand.Perm's Fisher-Yates
+func Permutation(rng *rand.Rand, size int) []int {
+	arr := rng.Perm(size)
+	for i := range arr {
+		arr[i]++
+	}
+	return arr
+}
+
+// Points generates n random points with coordinates in [0, maxCoordinate]
+func Points(rng *rand.Rand, n, maxCoordinate int) []Point {
+	points := make([]Point, n)
+	for i := range points {
+		points[i] = Point{
+			X: rng.Intn(maxCoordinate + 1),
+			Y: rng.Intn(maxCoordinate + 1),
+		}
+	}
+	return points
+}
+
+// Graph generates a connected undirected graph as an adjacency list. Nodes
+// are linked in a chain to guarantee connectivity, with additional random
+// edges between non-adjacent nodes
+func Graph(rng *rand.Rand, size int) [][]int {
+	adjacency := make([][]bool, size)
+	for i := range adjacency {
+		adjacency[i] = make([]bool, size)
+	}
+
+	for i := 0; i+1 < size; i++ {
+		adjacency[i][i+1] = true
+		adjacency[i+1][i] = true
+	}
+
+	for i := 0; i < size; i++ {
+		for j := i + 2; j < size; j++ {
+			if rng.Intn(4) == 0 {
+				adjacency[i][j] = true
+				adjacency[j][i] = true
+			}
+		}
+	}
+
+	graph := make([][]int, size)
+	for i := range graph {
+		neighbors := []int{}
+		for j := 0; j < size; j++ {
+			if adjacency[i][j] {
+				neighbors = append(neighbors, j)
+			}
+		}
+		graph[i] = neighbors
+	}
+
+	return graph
+}
+
+// Edge is a directed, weighted edge between two graph nodes
+type Edge struct {
+	From   int `json:"from"`
+	To     int `json:"to"`
+	Weight int `json:"weight"`
+}
+
+// WeightedEdges generates a directed graph as an edge list with weights in
+// [minWeight, maxWeight]. Nodes are linked in a chain from node 0 so every
+// node is reachable from it, with additional random edges in both directions
+func WeightedEdges(rng *rand.Rand, size, minWeight, maxWeight int) []Edge {
+	weight := func() int {
+		return minWeight + rng.Intn(maxWeight-minWeight+1)
+	}
+
+	edges := []Edge{}
+	for i := 0; i+1 < size; i++ {
+		edges = append(edges, Edge{From: i, To: i + 1, Weight: weight()})
+	}
+
+	for i := 0; i < size; i++ {
+		for j := 0; j < size; j++ {
+			if i == j || j == i+1 {
+				continue
+			}
+			if rng.Intn(5) == 0 {
+				edges = append(edges, Edge{From: i, To: j, Weight: weight()})
+			}
+		}
+	}
+
+	return edges
+}
+
+// UndirectedEdges generates a connected undirected graph as an edge list
+// with weights in [minWeight, maxWeight], each edge listed once with From
+// below To. Nodes are linked in a chain to guarantee connectivity, and each
+// other pair of nodes is joined with probability density
+func UndirectedEdges(rng *rand.Rand, size int, density float64, minWeight, maxWeight int) []Edge {
+	weight := func() int {
+		return minWeight + rng.Intn(maxWeight-minWeight+1)
+	}
+
+	edges := []Edge{}
+	for i := 0; i+1 < size; i++ {
+		edges = append(edges, Edge{From: i, To: i + 1, Weight: weight()})
+	}
+
+	for i := 0; i < size; i++ {
+		for j := i + 2; j < size; j++ {
+			if rng.Float64() < density {
+				edges = append(edges, Edge{From: i, To: j, Weight: weight()})
+			}
+		}
+	}
+
+	return edges
+}
+
+// DAGEdges generates a directed acyclic graph as an edge list with weights
+// in [minWeight, maxWeight]. Nodes are placed in a random hidden order and
+// each pair is joined with probability density, always pointing forward in
+// that order, so no cycle can form
+func DAGEdges(rng *rand.Rand, size int, density float64, minWeight, maxWeight int) []Edge {
+	order := rng.Perm(size)
+
+	edges := []Edge{}
+	for i := 0; i < size; i++ {
+		for j := i + 1; j < size; j++ {
+			if rng.Float64() < density {
+				weight := minWeight + rng.Intn(maxWeight-minWeight+1)
+				edges = append(edges, Edge{From: order[i], To: order[j], Weight: weight})
+			}
+		}
+	}
+
+	return edges
+}
+
+// Grid generates a height × width grid, indexed [y][x], in which each cell
+// is an obstacle with the given probability. The top-left and bottom-right
+// corners are always left open
+func Grid(rng *rand.Rand, width, height int, density float64) [][]bool {
+	grid := make([][]bool, height)
+	for y := range grid {
+		grid[y] = make([]bool, width)
+		for x := range grid[y] {
+			grid[y][x] = rng.Float64() < density
+		}
+	}
+
+	grid[0][0] = false
+	grid[height-1][width-1] = false
+
+	return grid
+}
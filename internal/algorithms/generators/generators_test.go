@@ -0,0 +1,46 @@
+package generators
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestSeededGeneration checks that a seed fixes every generated structure,
+// and that a different seed gives a different one
+func TestSeededGeneration(t *testing.T) {
+	generate := map[string]func(map[string]interface{}) interface{}{
+		"permutation": func(parameters map[string]interface{}) interface{} {
+			return Permutation(NewRand(parameters), 20)
+		},
+		"points": func(parameters map[string]interface{}) interface{} {
+			return Points(NewRand(parameters), 20, 100)
+		},
+		"graph": func(parameters map[string]interface{}) interface{} {
+			return Graph(NewRand(parameters), 12)
+		},
+		"weighted_edges": func(parameters map[string]interface{}) interface{} {
+			return WeightedEdges(NewRand(parameters), 12, 1, 20)
+		},
+		"undirected_edges": func(parameters map[string]interface{}) interface{} {
+			return UndirectedEdges(NewRand(parameters), 12, 0.5, 1, 20)
+		},
+		"dag_edges": func(parameters map[string]interface{}) interface{} {
+			return DAGEdges(NewRand(parameters), 12, 0.5, 1, 20)
+		},
+		"grid": func(parameters map[string]interface{}) interface{} {
+			return Grid(NewRand(parameters), 12, 12, 0.3)
+		},
+	}
+
+	for name, generator := range generate {
+		first := generator(map[string]interface{}{"seed": 42})
+		second := generator(map[string]interface{}{"seed": 42})
+		if !reflect.DeepEqual(first, second) {
+			t.Errorf("%s: seed 42 gave %v and then %v", name, first, second)
+		}
+
+		if other := generator(map[string]interface{}{"seed": 43}); reflect.DeepEqual(first, other) {
+			t.Errorf("%s: seeds 42 and 43 gave the same %v", name, first)
+		}
+	}
+}
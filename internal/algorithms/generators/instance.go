@@ -0,0 +1,57 @@
+package generators
+
+import "fmt"
+
+// Supplied problem instances replace generated ones, so they must fit the
+// size parameters the rest of the execution was validated against
+
+// ValidateAdjacency checks that an adjacency list has size nodes and that
+// every neighbor is one of them
+func ValidateAdjacency(graph [][]int, size int) error {
+	if len(graph) != size {
+		return fmt.Errorf("input graph has %d nodes, expected %d", len(graph), size)
+	}
+	for node, neighbors := range graph {
+		for _, neighbor := range neighbors {
+			if neighbor < 0 || neighbor >= size {
+				return fmt.Errorf("node %d has neighbor %d outside the graph of %d nodes", node, neighbor, size)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateEdges checks that every edge joins two of size nodes
+func ValidateEdges(edges []Edge, size int) error {
+	for _, e := range edges {
+		if e.From < 0 || e.To < 0 || e.From >= size || e.To >= size {
+			return fmt.Errorf("edge %d -> %d is outside the graph of %d nodes", e.From, e.To, size)
+		}
+	}
+	return nil
+}
+
+// ValidatePoints checks that a point set has exactly n points
+func ValidatePoints(points []Point, n int) error {
+	if len(points) != n {
+		return fmt.Errorf("input has %d points, expected %d", len(points), n)
+	}
+	return nil
+}
+
+// ValidateGrid checks that a grid is height rows of width cells, with the
+// top-left and bottom-right corners open as in generated grids
+func ValidateGrid(grid [][]bool, width, height int) error {
+	if len(grid) != height {
+		return fmt.Errorf("input grid has %d rows, expected %d", len(grid), height)
+	}
+	for y, row := range grid {
+		if len(row) != width {
+			return fmt.Errorf("input grid row %d has %d cells, expected %d", y, len(row), width)
+		}
+	}
+	if grid[0][0] || grid[height-1][width-1] {
+		return fmt.Errorf("the top-left and bottom-right cells of the input grid must be open")
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package generators
+
+import (
+	"algorthmia/internal/types"
+	"fmt"
+)
+
+// RepresentationParameter selects whether a graph algorithm's first step
+// also describes the graph as matrices
+func RepresentationParameter() types.Parameter {
+	return types.Parameter{
+		Name:        "graph_representation",
+		Type:        "string",
+		Description: "Graph form in the first step: \"list\" for adjacency lists or edges only, or \"matrix\" to also include adjacency and weight matrices",
+		Default:     "list",
+		Required:    false,
+	}
+}
+
+// ValidateRepresentation checks that graph_representation, if given, is a
+// known representation
+func ValidateRepresentation(parameters map[string]interface{}) error {
+	representation, exists := parameters["graph_representation"]
+	if !exists {
+		return nil
+	}
+
+	switch representation {
+	case "list", "matrix":
+		return nil
+	}
+	return fmt.Errorf("graph_representation must be \"list\" or \"matrix\"")
+}
+
+// wantsMatrix reports whether the matrix representation was requested
+func wantsMatrix(parameters map[string]interface{}) bool {
+	representation, _ := parameters["graph_representation"].(string)
+	return representation == "matrix"
+}
+
+// AdjacencyMatrix converts an adjacency list into a matrix where entry
+// [i][j] is 1 when j is a neighbor of i and 0 otherwise
+func AdjacencyMatrix(graph [][]int) [][]int {
+	matrix := make([][]int, len(graph))
+	for i := range matrix {
+		matrix[i] = make([]int, len(graph))
+	}
+	for i, neighbors := range graph {
+		for _, j := range neighbors {
+			matrix[i][j] = 1
+		}
+	}
+	return matrix
+}
+
+// EdgeMatrices converts an edge list over size nodes into an adjacency
+// matrix and a weight matrix, with nil weights where there is no edge.
+// Undirected edges fill both [From][To] and [To][From]. Of parallel edges
+// the lightest is kept
+func EdgeMatrices(size int, edges []Edge, directed bool) ([][]int, [][]interface{}) {
+	adjacency := make([][]int, size)
+	weights := make([][]interface{}, size)
+	for i := range adjacency {
+		adjacency[i] = make([]int, size)
+		weights[i] = make([]interface{}, size)
+	}
+
+	set := func(from, to, weight int) {
+		if adjacency[from][to] == 1 && weights[from][to].(int) <= weight {
+			return
+		}
+		adjacency[from][to] = 1
+		weights[from][to] = weight
+	}
+	for _, e := range edges {
+		set(e.From, e.To, e.Weight)
+		if !directed {
+			set(e.To, e.From, e.Weight)
+		}
+	}
+
+	return adjacency, weights
+}
+
+// WithAdjacencyMatrix adds the adjacency matrix of an adjacency list to a
+// step's data when the matrix representation was requested
+func WithAdjacencyMatrix(data map[string]interface{}, parameters map[string]interface{}, graph [][]int) map[string]interface{} {
+	if wantsMatrix(parameters) {
+		data["adjacency_matrix"] = AdjacencyMatrix(graph)
+	}
+	return data
+}
+
+// WithEdgeMatrices adds the adjacency and weight matrices of an edge list
+// to a step's data when the matrix representation was requested
+func WithEdgeMatrices(data map[string]interface{}, parameters map[string]interface{}, size int, edges []Edge, directed bool) map[string]interface{} {
+	if wantsMatrix(parameters) {
+		data["adjacency_matrix"], data["weight_matrix"] = EdgeMatrices(size, edges, directed)
+	}
+	return data
+}
@@ -0,0 +1,81 @@
+package generators
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// TestAdjacencyMatrixMatchesList checks generated graphs in both forms:
+// every listed neighbor has a 1 in the matrix and no other entry does
+func TestAdjacencyMatrixMatchesList(t *testing.T) {
+	for seed := int64(1); seed <= 5; seed++ {
+		graph := Graph(rand.New(rand.NewSource(seed)), 12)
+		matrix := AdjacencyMatrix(graph)
+
+		if len(matrix) != len(graph) {
+			t.Fatalf("seed %d: matrix has %d rows for %d nodes", seed, len(matrix), len(graph))
+		}
+		for i, neighbors := range graph {
+			linked := 0
+			for _, cell := range matrix[i] {
+				linked += cell
+			}
+			if linked != len(neighbors) {
+				t.Errorf("seed %d: row %d has %d edges, but node %d has neighbors %v", seed, i, linked, i, neighbors)
+			}
+			for _, j := range neighbors {
+				// Graph is undirected, so the matrix is symmetric
+				if matrix[i][j] != 1 || matrix[j][i] != 1 {
+					t.Errorf("seed %d: edge %d-%d is missing from the matrix", seed, i, j)
+				}
+			}
+		}
+	}
+}
+
+func TestEdgeMatrices(t *testing.T) {
+	edges := []Edge{
+		{From: 0, To: 1, Weight: 5},
+		{From: 1, To: 2, Weight: -2},
+		{From: 0, To: 1, Weight: 3}, // parallel, lighter
+	}
+
+	adjacency, weights := EdgeMatrices(3, edges, true)
+	if expected := [][]int{{0, 1, 0}, {0, 0, 1}, {0, 0, 0}}; !reflect.DeepEqual(adjacency, expected) {
+		t.Errorf("directed adjacency %v, expected %v", adjacency, expected)
+	}
+	if expected := [][]interface{}{{nil, 3, nil}, {nil, nil, -2}, {nil, nil, nil}}; !reflect.DeepEqual(weights, expected) {
+		t.Errorf("directed weights %v, expected %v", weights, expected)
+	}
+
+	adjacency, weights = EdgeMatrices(3, edges, false)
+	if expected := [][]int{{0, 1, 0}, {1, 0, 1}, {0, 1, 0}}; !reflect.DeepEqual(adjacency, expected) {
+		t.Errorf("undirected adjacency %v, expected %v", adjacency, expected)
+	}
+	if expected := [][]interface{}{{nil, 3, nil}, {3, nil, -2}, {nil, -2, nil}}; !reflect.DeepEqual(weights, expected) {
+		t.Errorf("undirected weights %v, expected %v", weights, expected)
+	}
+}
+
+func TestRepresentationParameter(t *testing.T) {
+	graph := [][]int{{1}, {0}}
+
+	if data := WithAdjacencyMatrix(map[string]interface{}{}, map[string]interface{}{}, graph); data["adjacency_matrix"] != nil {
+		t.Errorf("list representation included a matrix")
+	}
+	if data := WithAdjacencyMatrix(map[string]interface{}{}, map[string]interface{}{"graph_representation": "matrix"}, graph); data["adjacency_matrix"] == nil {
+		t.Errorf("matrix representation left out the matrix")
+	}
+
+	for _, representation := range []interface{}{"list", "matrix"} {
+		if err := ValidateRepresentation(map[string]interface{}{"graph_representation": representation}); err != nil {
+			t.Errorf("rejected %v: %v", representation, err)
+		}
+	}
+	for _, representation := range []interface{}{"grid", "", 1} {
+		if err := ValidateRepresentation(map[string]interface{}{"graph_representation": representation}); err == nil {
+			t.Errorf("accepted %v", representation)
+		}
+	}
+}
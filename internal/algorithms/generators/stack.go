@@ -0,0 +1,70 @@
+package generators
+
+import (
+	"algorthmia/internal/types"
+	"fmt"
+)
+
+// EmitStackParameter is the optional flag that attaches the recursion
+// stack to the steps a recursive algorithm takes while descending
+func EmitStackParameter() types.Parameter {
+	return types.Parameter{
+		Name:        "emit_stack",
+		Type:        "bool",
+		Description: "Include the current recursion stack in step data as decision_stack",
+		Default:     false,
+		Required:    false,
+	}
+}
+
+// ValidateEmitStack checks that the emit_stack flag, if given, is a boolean
+func ValidateEmitStack(parameters map[string]interface{}) error {
+	if emit, exists := parameters["emit_stack"]; exists {
+		if _, ok := emit.(bool); !ok {
+			return fmt.Errorf("emit_stack must be a boolean")
+		}
+	}
+	return nil
+}
+
+// DecisionStack tracks the choices a recursive search has committed to.
+// A choice is pushed when the search descends and popped when it returns,
+// so the stack always mirrors the path from the root of the call tree
+type DecisionStack struct {
+	choices []interface{}
+	emit    bool
+}
+
+// NewDecisionStack creates an empty stack that is copied into step data
+// only when the emit_stack flag is set
+func NewDecisionStack(parameters map[string]interface{}) *DecisionStack {
+	emit, _ := parameters["emit_stack"].(bool)
+	return &DecisionStack{choices: []interface{}{}, emit: emit}
+}
+
+// Push records a choice the search is descending into
+func (s *DecisionStack) Push(choice interface{}) {
+	s.choices = append(s.choices, choice)
+}
+
+// Pop undoes the most recent choice when the search returns
+func (s *DecisionStack) Pop() {
+	s.choices = s.choices[:len(s.choices)-1]
+}
+
+// Top returns the most recent choice, or false if the stack is empty
+func (s *DecisionStack) Top() (interface{}, bool) {
+	if len(s.choices) == 0 {
+		return nil, false
+	}
+	return s.choices[len(s.choices)-1], true
+}
+
+// WithDecisionStack adds a snapshot of the stack to a step's data as
+// decision_stack when emit_stack is set
+func (s *DecisionStack) WithDecisionStack(data map[string]interface{}) map[string]interface{} {
+	if s.emit {
+		data["decision_stack"] = append([]interface{}{}, s.choices...)
+	}
+	return data
+}
@@ -0,0 +1,383 @@
+package graphs
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// AVLTree inserts values into a self-balancing binary search tree,
+// rotating subtrees whenever an insertion unbalances them
+type AVLTree struct {
+	metadata types.Algorithm
+}
+
+// NewAVLTree creates a new AVLTree instance
+func NewAVLTree() *AVLTree {
+	return &AVLTree{
+		metadata: types.Algorithm{
+			ID:          "avl_tree",
+			Name:        "AVL Tree",
+			Category:    types.CategoryGraphsTrees,
+			Description: "A binary search tree that keeps the heights of every node's two subtrees within one of each other. After each insertion the heights on the way back to the root are updated, and the lowest node whose balance factor reaches 2 is repaired with a single (LL, RR) or double (LR, RL) rotation. Sorted input, which degenerates a plain binary search tree into a chain, stays logarithmic in height.",
+			BigO:        "Time: O(log n) per insert, Space: O(n)",
+			Parameters: []types.Parameter{
+				{
+					Name:        "num_nodes",
+					Type:        "int",
+					Description: "Number of distinct values to insert",
+					Default:     10,
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(20),
+					Required:    true,
+				},
+				{
+					Name:        "insertion_order",
+					Type:        "string",
+					Description: "Order the values are inserted in: \"random\", or \"sorted\" to force a rotation at almost every step",
+					Default:     "random",
+					Required:    false,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible values",
+					Default:     nil,
+					Required:    false,
+				},
+			},
+			RelatedIDs: []string{"bst"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (avl *AVLTree) GetMetadata() types.Algorithm {
+	return avl.metadata
+}
+
+// avlNode is a node of an AVL tree, caching the height of its subtree
+type avlNode struct {
+	value  int
+	height int
+	left   *avlNode
+	right  *avlNode
+}
+
+// avlHeight returns the height of a subtree; an empty subtree has height 0
+func avlHeight(n *avlNode) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// update recomputes the node's height from its children
+func (n *avlNode) update() {
+	n.height = avlHeight(n.left) + 1
+	if right := avlHeight(n.right) + 1; right > n.height {
+		n.height = right
+	}
+}
+
+// balance is the left subtree's height minus the right's
+func (n *avlNode) balance() int {
+	return avlHeight(n.left) - avlHeight(n.right)
+}
+
+// snapshot copies the subtree into nested maps for a step, with nil for
+// missing children
+func (n *avlNode) snapshot() map[string]interface{} {
+	if n == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"value":   n.value,
+		"height":  n.height,
+		"balance": n.balance(),
+		"left":    n.left.snapshot(),
+		"right":   n.right.snapshot(),
+	}
+}
+
+// rotateRight lifts the left child above n and returns it
+func rotateRight(n *avlNode) *avlNode {
+	pivot := n.left
+	n.left = pivot.right
+	pivot.right = n
+	n.update()
+	pivot.update()
+	return pivot
+}
+
+// rotateLeft lifts the right child above n and returns it
+func rotateLeft(n *avlNode) *avlNode {
+	pivot := n.right
+	n.right = pivot.left
+	pivot.left = n
+	n.update()
+	pivot.update()
+	return pivot
+}
+
+// Execute inserts the values in order, rebalancing after each insertion
+func (avl *AVLTree) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	numNodes := 10
+	if n, ok := parameters["num_nodes"].(int); ok {
+		numNodes = n
+	}
+
+	order := "random"
+	if o, ok := parameters["insertion_order"].(string); ok {
+		order = o
+	}
+
+	// Use the supplied values, or draw distinct values from 1 to 99
+	var values []int
+	if input != nil {
+		inputValues, ok := input.([]int)
+		if !ok {
+			return nil, fmt.Errorf("invalid input type, expected []int")
+		}
+		values = append([]int{}, inputValues...)
+	} else {
+		for _, v := range generators.NewRand(parameters).Perm(99)[:numNodes] {
+			values = append(values, v+1)
+		}
+	}
+	if order == "sorted" {
+		sort.Ints(values)
+	}
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"values":          values,
+			"insertion_order": order,
+		},
+		Message:   fmt.Sprintf("Inserting %d values into an AVL tree in %s order", len(values), order),
+		Timestamp: time.Now(),
+	})
+
+	var root *avlNode
+	stepNumber := 1
+	rotations := map[string]int{"LL": 0, "RR": 0, "LR": 0, "RL": 0}
+	duplicates := []int{}
+
+	for _, value := range values {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		// Walk down as in a plain binary search tree, remembering the way back
+		ancestors := []*avlNode{}
+		node := root
+		for node != nil && node.value != value {
+			ancestors = append(ancestors, node)
+			if value < node.value {
+				node = node.left
+			} else {
+				node = node.right
+			}
+		}
+		if node != nil {
+			duplicates = append(duplicates, value)
+			continue
+		}
+
+		inserted := &avlNode{value: value, height: 1}
+		path := []int{}
+		for _, a := range ancestors {
+			path = append(path, a.value)
+		}
+		switch {
+		case len(ancestors) == 0:
+			root = inserted
+		case value < ancestors[len(ancestors)-1].value:
+			ancestors[len(ancestors)-1].left = inserted
+		default:
+			ancestors[len(ancestors)-1].right = inserted
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "insert",
+			Data: map[string]interface{}{
+				"tree":  root.snapshot(),
+				"value": value,
+				"path":  append(path, value),
+				"depth": len(ancestors),
+			},
+			Message:   fmt.Sprintf("Inserted %d at depth %d", value, len(ancestors)),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+
+		// Update heights back towards the root until a rotation restores the
+		// subtree to its height before the insertion
+		for i := len(ancestors) - 1; i >= 0; i-- {
+			node := ancestors[i]
+			node.update()
+			balance := node.balance()
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "update_balance",
+				Data: map[string]interface{}{
+					"tree":    root.snapshot(),
+					"node":    node.value,
+					"height":  node.height,
+					"balance": balance,
+				},
+				Message:   fmt.Sprintf("Node %d has height %d and balance %d", node.value, node.height, balance),
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+
+			if balance >= -1 && balance <= 1 {
+				continue
+			}
+
+			before := root.snapshot()
+			var rotation string
+			var subtree *avlNode
+			switch {
+			case balance > 1 && value < node.left.value:
+				rotation = "LL"
+				subtree = rotateRight(node)
+			case balance > 1:
+				rotation = "LR"
+				node.left = rotateLeft(node.left)
+				subtree = rotateRight(node)
+			case value > node.right.value:
+				rotation = "RR"
+				subtree = rotateLeft(node)
+			default:
+				rotation = "RL"
+				node.right = rotateRight(node.right)
+				subtree = rotateLeft(node)
+			}
+
+			switch {
+			case i == 0:
+				root = subtree
+			case ancestors[i-1].left == node:
+				ancestors[i-1].left = subtree
+			default:
+				ancestors[i-1].right = subtree
+			}
+			rotations[rotation]++
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "rotate",
+				Data: map[string]interface{}{
+					"rotation":    rotation,
+					"node":        node.value,
+					"pivot":       subtree.value,
+					"tree_before": before,
+					"tree_after":  root.snapshot(),
+				},
+				Message:   fmt.Sprintf("%s rotation at node %d lifts %d into its place", rotation, node.value, subtree.value),
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+			break
+		}
+	}
+
+	total := 0
+	for _, count := range rotations {
+		total += count
+	}
+
+	result := map[string]interface{}{
+		"tree":              root.snapshot(),
+		"height":            avlHeight(root),
+		"rotations":         total,
+		"rotations_by_type": rotations,
+		"duplicates":        duplicates,
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data:       result,
+		Message:    fmt.Sprintf("AVL tree of height %d built with %d rotations", avlHeight(root), total),
+		Timestamp:  time.Now(),
+	})
+
+	return result, nil
+}
+
+// ValidateParameters validates the input parameters
+func (avl *AVLTree) ValidateParameters(parameters map[string]interface{}) error {
+	if n, ok := parameters["num_nodes"].(int); ok {
+		if n < 3 || n > 20 {
+			return fmt.Errorf("num_nodes must be between 3 and 20")
+		}
+	}
+
+	if order, exists := parameters["insertion_order"]; exists {
+		if o, ok := order.(string); !ok || (o != "random" && o != "sorted") {
+			return fmt.Errorf("insertion_order must be random or sorted")
+		}
+	}
+
+	return nil
+}
+
+// SelfTest checks the rotations of known insertion sequences, one per
+// rotation type, and that sorted input still builds a balanced tree
+func (avl *AVLTree) SelfTest() error {
+	cases := []struct {
+		values   []int
+		rotation string
+		root     int
+	}{
+		{[]int{3, 2, 1}, "LL", 2},
+		{[]int{1, 2, 3}, "RR", 2},
+		{[]int{3, 1, 2}, "LR", 2},
+		{[]int{1, 3, 2}, "RL", 2},
+	}
+
+	for _, c := range cases {
+		output, err := avl.Execute(context.Background(), c.values, map[string]interface{}{"insertion_order": "random"}, func(types.ExecutionStep) {})
+		if err != nil {
+			return fmt.Errorf("execution failed: %v", err)
+		}
+		result := output.(map[string]interface{})
+		byType := result["rotations_by_type"].(map[string]int)
+		if result["rotations"].(int) != 1 || byType[c.rotation] != 1 {
+			return fmt.Errorf("inserting %v: expected one %s rotation, got %v", c.values, c.rotation, byType)
+		}
+		if root := result["tree"].(map[string]interface{})["value"].(int); root != c.root {
+			return fmt.Errorf("inserting %v: expected root %d, got %d", c.values, c.root, root)
+		}
+	}
+
+	// Inserting 1..15 in order rotates at 3, 5, 6, 7, 9, 10, 11, 12, 13, 14
+	// and 15, ending in a perfect tree
+	sorted := []int{}
+	for v := 15; v >= 1; v-- {
+		sorted = append(sorted, v)
+	}
+	output, err := avl.Execute(context.Background(), sorted, map[string]interface{}{"insertion_order": "sorted"}, func(types.ExecutionStep) {})
+	if err != nil {
+		return fmt.Errorf("execution failed: %v", err)
+	}
+	result := output.(map[string]interface{})
+	if height := result["height"].(int); height != 4 {
+		return fmt.Errorf("expected sorted insertion of 15 values to give height 4, got %d", height)
+	}
+	if rotations := result["rotations"].(int); rotations != 11 {
+		return fmt.Errorf("expected 11 rotations for sorted insertion of 15 values, got %d", rotations)
+	}
+
+	return nil
+}
@@ -0,0 +1,420 @@
+package graphs
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"math"
+	"time"
+)
+
+// BellmanFord implements single-source shortest paths with Bellman–Ford,
+// detecting and optionally extracting negative-weight cycles
+type BellmanFord struct {
+	metadata types.Algorithm
+}
+
+// NewBellmanFord creates a new BellmanFord instance
+func NewBellmanFord() *BellmanFord {
+	return &BellmanFord{
+		metadata: types.Algorithm{
+			ID:          "bellman_ford",
+			Name:        "Bellman–Ford",
+			Category:    types.CategoryGraphsTrees,
+			Description: "Finds shortest paths from a start node in a directed graph with possibly negative edge weights by relaxing every edge V-1 times. An edge that can still be relaxed afterwards proves a negative cycle, which can be recovered by walking predecessors — the basis of currency arbitrage detection.",
+			BigO:        "Time: O(V · E), Space: O(V) where V is vertices and E is edges",
+			Parameters: []types.Parameter{
+				{
+					Name:        "graph_size",
+					Type:        "int",
+					Description: "Number of nodes in the graph",
+					Default:     6,
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(20),
+					Required:    true,
+				},
+				{
+					Name:        "start_node",
+					Type:        "int",
+					Description: "Node distances are measured from",
+					Default:     0,
+					Min:         types.IntPtr(0),
+					Max:         types.IntPtr(19),
+					Required:    true,
+				},
+				{
+					Name:        "target_node",
+					Type:        "int",
+					Description: "Node to return the shortest path to when no negative cycle exists (default: the last node)",
+					Default:     nil,
+					Min:         types.IntPtr(0),
+					Max:         types.IntPtr(19),
+					Required:    false,
+				},
+				{
+					Name:        "allow_negative_weights",
+					Type:        "bool",
+					Description: "Generate edge weights from -3 to 9 rather than 1 to 9",
+					Default:     true,
+					Required:    false,
+				},
+				{
+					Name:        "find_cycle",
+					Type:        "bool",
+					Description: "Extract the nodes of a detected negative cycle by walking predecessors",
+					Default:     true,
+					Required:    false,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible graph generation",
+					Default:     nil,
+					Required:    false,
+				},
+				generators.RepresentationParameter(),
+			},
+			InputType: types.InputEdgeList,
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (bf *BellmanFord) GetMetadata() types.Algorithm {
+	return bf.metadata
+}
+
+// Execute runs the Bellman–Ford algorithm
+func (bf *BellmanFord) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	graphSize := 6
+	if size, ok := parameters["graph_size"].(int); ok {
+		graphSize = size
+	}
+
+	startNode := 0
+	if start, ok := parameters["start_node"].(int); ok {
+		startNode = start
+	}
+
+	findCycle := true
+	if f, ok := parameters["find_cycle"].(bool); ok {
+		findCycle = f
+	}
+
+	// Use the supplied edge list, or generate a graph, with some negative edges if allowed
+	var edges []generators.Edge
+	if input != nil {
+		inputEdges, ok := input.([]generators.Edge)
+		if !ok {
+			return nil, fmt.Errorf("invalid input type, expected a list of weighted edges")
+		}
+		edges = inputEdges
+		graphSize = 0
+		for _, e := range edges {
+			if e.From < 0 || e.To < 0 {
+				return nil, fmt.Errorf("edge %d -> %d has a negative node index", e.From, e.To)
+			}
+			if e.From >= 20 || e.To >= 20 {
+				return nil, fmt.Errorf("edge %d -> %d is outside the largest graph of 20 nodes", e.From, e.To)
+			}
+			if e.From >= graphSize {
+				graphSize = e.From + 1
+			}
+			if e.To >= graphSize {
+				graphSize = e.To + 1
+			}
+		}
+	} else {
+		edges = bf.GenerateInstance(parameters).([]generators.Edge)
+	}
+
+	targetNode := graphSize - 1
+	if target, ok := parameters["target_node"].(int); ok {
+		targetNode = target
+	}
+
+	if startNode < 0 || startNode >= graphSize {
+		return nil, fmt.Errorf("start_node %d is outside the graph of %d nodes", startNode, graphSize)
+	}
+	if targetNode < 0 || targetNode >= graphSize {
+		return nil, fmt.Errorf("target_node %d is outside the graph of %d nodes", targetNode, graphSize)
+	}
+
+	dist := make([]float64, graphSize)
+	pred := make([]int, graphSize)
+	for i := range dist {
+		dist[i] = math.Inf(1)
+		pred[i] = -1
+	}
+	dist[startNode] = 0
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: generators.WithEdgeMatrices(map[string]interface{}{
+			"edges":       edges,
+			"start_node":  startNode,
+			"target_node": targetNode,
+			"distances":   distanceTable(dist),
+		}, parameters, graphSize, edges, true),
+		Message:   fmt.Sprintf("Starting Bellman–Ford from node %d over %d edges", startNode, len(edges)),
+		Timestamp: time.Now(),
+	})
+
+	stepNumber := 1
+
+	// Relax every edge in each of V-1 rounds
+	for round := 1; round < graphSize; round++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		relaxedEdges := []generators.Edge{}
+		for _, e := range edges {
+			if math.IsInf(dist[e.From], 1) || dist[e.From]+float64(e.Weight) >= dist[e.To] {
+				continue
+			}
+
+			dist[e.To] = dist[e.From] + float64(e.Weight)
+			pred[e.To] = e.From
+			relaxedEdges = append(relaxedEdges, e)
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "relax_round",
+			Data: map[string]interface{}{
+				"round":         round,
+				"relaxed_edges": relaxedEdges,
+				"distances":     distanceTable(dist),
+				"predecessors":  pred,
+			},
+			Message:   fmt.Sprintf("Round %d of %d relaxed %d edges", round, graphSize-1, len(relaxedEdges)),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+	}
+
+	// One extra round: any edge that still relaxes lies on or leads from a negative cycle
+	relaxable := []generators.Edge{}
+	relaxed := -1
+	for _, e := range edges {
+		if !math.IsInf(dist[e.From], 1) && dist[e.From]+float64(e.Weight) < dist[e.To] {
+			relaxable = append(relaxable, e)
+			if relaxed == -1 {
+				pred[e.To] = e.From
+				relaxed = e.To
+			}
+		}
+	}
+	hasNegativeCycle := relaxed != -1
+
+	message := "No edge can be relaxed further, so no negative cycle is reachable"
+	if hasNegativeCycle {
+		message = fmt.Sprintf("%d edges can still be relaxed, so a negative cycle is reachable", len(relaxable))
+	}
+	stepCallback(types.ExecutionStep{
+		StepNumber: stepNumber,
+		Action:     "detect_negative_cycle",
+		Data: map[string]interface{}{
+			"distances":          distanceTable(dist),
+			"predecessors":       pred,
+			"has_negative_cycle": hasNegativeCycle,
+			"relaxable_edges":    relaxable,
+		},
+		Message:   message,
+		Timestamp: time.Now(),
+	})
+	stepNumber++
+
+	result := map[string]interface{}{
+		"edges":              edges,
+		"distances":          distanceTable(dist),
+		"predecessors":       pred,
+		"has_negative_cycle": hasNegativeCycle,
+	}
+
+	// Shortest paths are only defined when no negative cycle is reachable
+	if !hasNegativeCycle {
+		path := []int{}
+		if !math.IsInf(dist[targetNode], 1) {
+			for node := targetNode; node != -1; node = pred[node] {
+				path = append([]int{node}, path...)
+			}
+		}
+		result["target_node"] = targetNode
+		result["path"] = path
+	}
+
+	if hasNegativeCycle && findCycle {
+		cycle := negativeCycle(pred, relaxed, graphSize)
+		weights := edgeWeights(edges)
+
+		total := 0
+		for i, from := range cycle {
+			to := cycle[(i+1)%len(cycle)]
+			total += weights[[2]int{from, to}]
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "trace_negative_cycle",
+				Data: map[string]interface{}{
+					"edge":         generators.Edge{From: from, To: to, Weight: weights[[2]int{from, to}]},
+					"cycle":        cycle[:i+1],
+					"cycle_weight": total,
+				},
+				Message:   fmt.Sprintf("Cycle edge %d -> %d with weight %d, running total %d", from, to, weights[[2]int{from, to}], total),
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+		}
+
+		result["cycle"] = cycle
+		result["cycle_weight"] = total
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data:       result,
+		Message:    "Bellman–Ford completed",
+		Timestamp:  time.Now(),
+	})
+
+	return result, nil
+}
+
+// negativeCycle recovers the cycle behind a vertex relaxed in the extra pass.
+// Walking predecessors size times is guaranteed to land on the cycle itself;
+// the cycle is then collected and returned in edge direction
+func negativeCycle(pred []int, relaxed, size int) []int {
+	node := relaxed
+	for i := 0; i < size; i++ {
+		node = pred[node]
+	}
+
+	cycle := []int{node}
+	for current := pred[node]; current != node; current = pred[current] {
+		cycle = append(cycle, current)
+	}
+
+	for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+		cycle[i], cycle[j] = cycle[j], cycle[i]
+	}
+	return cycle
+}
+
+// edgeWeights indexes the lightest edge between each ordered pair of nodes
+func edgeWeights(edges []generators.Edge) map[[2]int]int {
+	weights := make(map[[2]int]int)
+	for _, e := range edges {
+		key := [2]int{e.From, e.To}
+		if w, exists := weights[key]; !exists || e.Weight < w {
+			weights[key] = e.Weight
+		}
+	}
+	return weights
+}
+
+// distanceTable converts distances into a JSON-safe form, using nil for
+// nodes not yet reached
+func distanceTable(dist []float64) []interface{} {
+	values := make([]interface{}, len(dist))
+	for i, v := range dist {
+		if !math.IsInf(v, 1) {
+			values[i] = v
+		}
+	}
+	return values
+}
+
+// GenerateInstance generates the graph Execute runs on when given no input
+func (bf *BellmanFord) GenerateInstance(parameters map[string]interface{}) interface{} {
+	graphSize := 6
+	if size, ok := parameters["graph_size"].(int); ok {
+		graphSize = size
+	}
+
+	allowNegative := true
+	if a, ok := parameters["allow_negative_weights"].(bool); ok {
+		allowNegative = a
+	}
+
+	minWeight := 1
+	if allowNegative {
+		minWeight = -3
+	}
+
+	return generators.WeightedEdges(generators.NewRand(parameters), graphSize, minWeight, 9)
+}
+
+// ValidateParameters validates the input parameters
+func (bf *BellmanFord) ValidateParameters(parameters map[string]interface{}) error {
+	graphSize := 6
+	if size, ok := parameters["graph_size"].(int); ok {
+		if size < 3 || size > 20 {
+			return fmt.Errorf("graph_size must be between 3 and 20")
+		}
+		graphSize = size
+	}
+
+	for _, name := range []string{"start_node", "target_node"} {
+		if node, ok := parameters[name].(int); ok {
+			if node < 0 || node >= graphSize {
+				return fmt.Errorf("%s must be between 0 and %d", name, graphSize-1)
+			}
+		}
+	}
+
+	for _, name := range []string{"find_cycle", "allow_negative_weights"} {
+		if flag, exists := parameters[name]; exists {
+			if _, ok := flag.(bool); !ok {
+				return fmt.Errorf("%s must be a boolean", name)
+			}
+		}
+	}
+
+	return generators.ValidateRepresentation(parameters)
+}
+
+// SelfTest checks that a known negative cycle is detected,
+// and that a path is returned once the cycle is removed
+func (bf *BellmanFord) SelfTest() error {
+	// 1 -> 2 -> 3 -> 1 weighs 1 - 4 + 2 = -1
+	edges := []generators.Edge{
+		{From: 0, To: 1, Weight: 4},
+		{From: 1, To: 2, Weight: 1},
+		{From: 2, To: 3, Weight: -4},
+		{From: 3, To: 1, Weight: 2},
+		{From: 3, To: 4, Weight: 3},
+	}
+
+	output, err := bf.Execute(context.Background(), edges, map[string]interface{}{"start_node": 0}, func(types.ExecutionStep) {})
+	if err != nil {
+		return fmt.Errorf("execution failed: %v", err)
+	}
+
+	result := output.(map[string]interface{})
+	if hasCycle, _ := result["has_negative_cycle"].(bool); !hasCycle {
+		return fmt.Errorf("negative cycle was not detected")
+	}
+
+	// Without 3 -> 1 there is no cycle, and 0 -> 1 -> 2 -> 3 -> 4 weighs 4
+	acyclic := []generators.Edge{edges[0], edges[1], edges[2], edges[4]}
+	output, err = bf.Execute(context.Background(), acyclic, map[string]interface{}{"start_node": 0, "target_node": 4}, func(types.ExecutionStep) {})
+	if err != nil {
+		return fmt.Errorf("execution failed: %v", err)
+	}
+
+	result = output.(map[string]interface{})
+	if path, _ := result["path"].([]int); fmt.Sprint(path) != "[0 1 2 3 4]" {
+		return fmt.Errorf("expected path [0 1 2 3 4], got %v", result["path"])
+	}
+	if distances, _ := result["distances"].([]interface{}); distances[4] != 4.0 {
+		return fmt.Errorf("expected distance 4 to node 4, got %v", distances[4])
+	}
+
+	return nil
+}
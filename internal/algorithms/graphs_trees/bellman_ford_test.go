@@ -0,0 +1,78 @@
+package graphs
+
+import (
+	"context"
+	"testing"
+
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+)
+
+// negativeCycleEdges has the cycle 1 -> 2 -> 3 -> 1 weighing 1 - 4 + 2 = -1,
+// reachable from node 0
+var negativeCycleEdges = []generators.Edge{
+	{From: 0, To: 1, Weight: 4},
+	{From: 1, To: 2, Weight: 1},
+	{From: 2, To: 3, Weight: -4},
+	{From: 3, To: 1, Weight: 2},
+	{From: 3, To: 4, Weight: 3},
+}
+
+func TestBellmanFordExtractsNegativeCycle(t *testing.T) {
+	var traced []generators.Edge
+	output, err := NewBellmanFord().Execute(context.Background(), negativeCycleEdges, map[string]interface{}{"start_node": 0, "find_cycle": true}, func(step types.ExecutionStep) {
+		if step.Action == "trace_negative_cycle" {
+			traced = append(traced, step.Data["edge"].(generators.Edge))
+		}
+	})
+	if err != nil {
+		t.Fatalf("executing: %v", err)
+	}
+
+	result := output.(map[string]interface{})
+	if hasCycle, _ := result["has_negative_cycle"].(bool); !hasCycle {
+		t.Fatalf("negative cycle was not detected")
+	}
+	if _, ok := result["path"]; ok {
+		t.Errorf("returned a path %v although a negative cycle is reachable", result["path"])
+	}
+
+	cycle, _ := result["cycle"].([]int)
+	if len(cycle) != 3 {
+		t.Fatalf("cycle %v, expected nodes 1, 2 and 3", cycle)
+	}
+	next := map[int]int{1: 2, 2: 3, 3: 1}
+	for i, from := range cycle {
+		if to := cycle[(i+1)%len(cycle)]; next[from] != to {
+			t.Errorf("cycle %v follows %d with %d, which is not an edge of the cycle", cycle, from, to)
+		}
+	}
+	if weight, _ := result["cycle_weight"].(int); weight != -1 {
+		t.Errorf("cycle weight %d, expected -1", weight)
+	}
+
+	if len(traced) != len(cycle) {
+		t.Fatalf("traced %d cycle edges, expected %d", len(traced), len(cycle))
+	}
+	for i, edge := range traced {
+		if edge.From != cycle[i] || edge.To != cycle[(i+1)%len(cycle)] {
+			t.Errorf("traced edge %d is %d -> %d, expected %d -> %d", i, edge.From, edge.To, cycle[i], cycle[(i+1)%len(cycle)])
+		}
+	}
+}
+
+func TestBellmanFordIgnoresUnreachableCycle(t *testing.T) {
+	// Node 4 is the start, and nothing leads from it back into the cycle
+	output, err := NewBellmanFord().Execute(context.Background(), negativeCycleEdges, map[string]interface{}{"start_node": 4, "find_cycle": true}, func(types.ExecutionStep) {})
+	if err != nil {
+		t.Fatalf("executing: %v", err)
+	}
+
+	result := output.(map[string]interface{})
+	if hasCycle, _ := result["has_negative_cycle"].(bool); hasCycle {
+		t.Errorf("detected a negative cycle that is not reachable from the start")
+	}
+	if _, ok := result["cycle"]; ok {
+		t.Errorf("extracted cycle %v that is not reachable from the start", result["cycle"])
+	}
+}
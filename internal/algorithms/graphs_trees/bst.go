@@ -0,0 +1,339 @@
+package graphs
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// BST builds a binary search tree by insertion and then searches it
+type BST struct {
+	metadata types.Algorithm
+}
+
+// NewBST creates a new BST instance
+func NewBST() *BST {
+	return &BST{
+		metadata: types.Algorithm{
+			ID:          "bst",
+			Name:        "Binary Search Tree",
+			Category:    types.CategoryGraphsTrees,
+			Description: "Inserts a sequence of values into a binary search tree, each one walking down from the root, going left when smaller and right when larger, until it reaches an empty spot. The tree is then searched for a target along the same kind of path. Inserting values in sorted order builds a degenerate tree that is just a chain, the worst case for both operations.",
+			BigO:        "Time: O(h) per insert or search, where h is the height (O(log n) when balanced, O(n) when degenerate), Space: O(n)",
+			Parameters: []types.Parameter{
+				{
+					Name:        "num_nodes",
+					Type:        "int",
+					Description: "Number of distinct values to insert",
+					Default:     10,
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(20),
+					Required:    true,
+				},
+				{
+					Name:        "target",
+					Type:        "int",
+					Description: "Value to search for (default: one of the inserted values)",
+					Default:     nil,
+					Min:         types.IntPtr(1),
+					Max:         types.IntPtr(99),
+					Required:    false,
+				},
+				{
+					Name:        "insertion_order",
+					Type:        "string",
+					Description: "Order the values are inserted in: \"random\", or \"sorted\" for a degenerate tree",
+					Default:     "random",
+					Required:    false,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible values",
+					Default:     nil,
+					Required:    false,
+				},
+			},
+			RelatedIDs: []string{"binary_search", "avl_tree"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (b *BST) GetMetadata() types.Algorithm {
+	return b.metadata
+}
+
+// bstNode is a node of a binary search tree
+type bstNode struct {
+	value int
+	left  *bstNode
+	right *bstNode
+}
+
+// snapshot copies the subtree into nested maps for a step, with nil for
+// missing children
+func (n *bstNode) snapshot() map[string]interface{} {
+	if n == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"value": n.value,
+		"left":  n.left.snapshot(),
+		"right": n.right.snapshot(),
+	}
+}
+
+// height counts the levels of the subtree; an empty tree has height 0
+func (n *bstNode) height() int {
+	if n == nil {
+		return 0
+	}
+	left, right := n.left.height(), n.right.height()
+	if left > right {
+		return left + 1
+	}
+	return right + 1
+}
+
+// Execute inserts the values in order and then searches for the target
+func (b *BST) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	numNodes := 10
+	if n, ok := parameters["num_nodes"].(int); ok {
+		numNodes = n
+	}
+
+	order := "random"
+	if o, ok := parameters["insertion_order"].(string); ok {
+		order = o
+	}
+
+	// Use the supplied values, or draw distinct values from 1 to 99
+	rng := generators.NewRand(parameters)
+	var values []int
+	if input != nil {
+		inputValues, ok := input.([]int)
+		if !ok {
+			return nil, fmt.Errorf("invalid input type, expected []int")
+		}
+		values = append([]int{}, inputValues...)
+	} else {
+		for _, v := range rng.Perm(99)[:numNodes] {
+			values = append(values, v+1)
+		}
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("no values to insert")
+	}
+	if order == "sorted" {
+		sort.Ints(values)
+	}
+
+	target := values[rng.Intn(len(values))]
+	if t, ok := parameters["target"].(int); ok {
+		target = t
+	}
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"values":          values,
+			"target":          target,
+			"insertion_order": order,
+		},
+		Message:   fmt.Sprintf("Inserting %d values in %s order, then searching for %d", len(values), order, target),
+		Timestamp: time.Now(),
+	})
+
+	var root *bstNode
+	stepNumber := 1
+	comparisons := 0
+
+	// compare emits a comparison of value against node on the way down
+	compare := func(phase string, value int, node *bstNode, path []int) {
+		comparisons++
+		direction := "left"
+		if value > node.value {
+			direction = "right"
+		} else if value == node.value {
+			direction = "equal"
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "compare_node",
+			Data: map[string]interface{}{
+				"phase":     phase,
+				"tree":      root.snapshot(),
+				"value":     value,
+				"node":      node.value,
+				"direction": direction,
+				"path":      append([]int{}, path...),
+			},
+			Message:   fmt.Sprintf("Comparing %d with node %d: going %s", value, node.value, direction),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+	}
+
+	duplicates := []int{}
+	for _, value := range values {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		path := []int{}
+		var parent *bstNode
+		node := root
+		for node != nil && node.value != value {
+			path = append(path, node.value)
+			compare("insert", value, node, path)
+			parent = node
+			if value < node.value {
+				node = node.left
+			} else {
+				node = node.right
+			}
+		}
+
+		// Values already in the tree are not inserted again
+		if node != nil {
+			path = append(path, node.value)
+			compare("insert", value, node, path)
+			duplicates = append(duplicates, value)
+			continue
+		}
+
+		inserted := &bstNode{value: value}
+		switch {
+		case parent == nil:
+			root = inserted
+		case value < parent.value:
+			parent.left = inserted
+		default:
+			parent.right = inserted
+		}
+		path = append(path, value)
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "insert_node",
+			Data: map[string]interface{}{
+				"tree":   root.snapshot(),
+				"value":  value,
+				"path":   path,
+				"depth":  len(path) - 1,
+				"height": root.height(),
+			},
+			Message:   fmt.Sprintf("Inserted %d at depth %d", value, len(path)-1),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+	}
+
+	insertComparisons := comparisons
+	searchPath := []int{}
+	node := root
+	for node != nil {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		searchPath = append(searchPath, node.value)
+		compare("search", target, node, searchPath)
+		if target == node.value {
+			break
+		}
+		if target < node.value {
+			node = node.left
+		} else {
+			node = node.right
+		}
+	}
+	found := node != nil
+
+	result := map[string]interface{}{
+		"tree":               root.snapshot(),
+		"height":             root.height(),
+		"target":             target,
+		"found":              found,
+		"search_path":        searchPath,
+		"insert_comparisons": insertComparisons,
+		"search_comparisons": comparisons - insertComparisons,
+		"duplicates":         duplicates,
+	}
+
+	message := fmt.Sprintf("%d is not in the tree of height %d", target, root.height())
+	if found {
+		message = fmt.Sprintf("Found %d after %d comparisons in a tree of height %d", target, len(searchPath), root.height())
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data:       result,
+		Message:    message,
+		Timestamp:  time.Now(),
+	})
+
+	return result, nil
+}
+
+// ValidateParameters validates the input parameters
+func (b *BST) ValidateParameters(parameters map[string]interface{}) error {
+	if n, ok := parameters["num_nodes"].(int); ok {
+		if n < 3 || n > 20 {
+			return fmt.Errorf("num_nodes must be between 3 and 20")
+		}
+	}
+
+	if target, ok := parameters["target"].(int); ok {
+		if target < 1 || target > 99 {
+			return fmt.Errorf("target must be between 1 and 99")
+		}
+	}
+
+	if order, exists := parameters["insertion_order"]; exists {
+		if o, ok := order.(string); !ok || (o != "random" && o != "sorted") {
+			return fmt.Errorf("insertion_order must be random or sorted")
+		}
+	}
+
+	return nil
+}
+
+// SelfTest checks the height and search path of a known tree, and that
+// sorted insertion degenerates into a chain
+func (b *BST) SelfTest() error {
+	values := []int{50, 30, 70, 20, 40, 60, 80}
+
+	output, err := b.Execute(context.Background(), values, map[string]interface{}{"target": 60, "insertion_order": "random"}, func(types.ExecutionStep) {})
+	if err != nil {
+		return fmt.Errorf("execution failed: %v", err)
+	}
+	result := output.(map[string]interface{})
+	if height := result["height"].(int); height != 3 {
+		return fmt.Errorf("expected height 3, got %d", height)
+	}
+	if path := fmt.Sprint(result["search_path"]); !result["found"].(bool) || path != "[50 70 60]" {
+		return fmt.Errorf("expected to find 60 along [50 70 60], got %s", path)
+	}
+
+	output, err = b.Execute(context.Background(), values, map[string]interface{}{"target": 65, "insertion_order": "sorted"}, func(types.ExecutionStep) {})
+	if err != nil {
+		return fmt.Errorf("execution failed: %v", err)
+	}
+	result = output.(map[string]interface{})
+	if height := result["height"].(int); height != len(values) {
+		return fmt.Errorf("expected sorted insertion to give height %d, got %d", len(values), height)
+	}
+	if result["found"].(bool) {
+		return fmt.Errorf("found 65, which was never inserted")
+	}
+
+	return nil
+}
@@ -0,0 +1,263 @@
+package graphs
+
+import (
+	stringalgorithms "algorthmia/internal/algorithms/strings"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ExpressionTree builds a binary expression tree from an infix expression
+// using Dijkstra's two-stack method
+type ExpressionTree struct {
+	metadata types.Algorithm
+}
+
+// NewExpressionTree creates a new ExpressionTree instance
+func NewExpressionTree() *ExpressionTree {
+	return &ExpressionTree{
+		metadata: types.Algorithm{
+			ID:          "expression_tree",
+			Name:        "Expression Tree Builder",
+			Category:    types.CategoryGraphsTrees,
+			Description: "Builds a binary expression tree from an infix expression with an operand stack of subtrees and an operator stack, combining two subtrees under an operator whenever precedence allows, then evaluates and traverses the tree.",
+			BigO:        "Time: O(n), Space: O(n) where n is the number of tokens",
+			Parameters: []types.Parameter{
+				{
+					Name:        "expression",
+					Type:        "string",
+					Description: "Infix arithmetic expression using numbers, + - * / ^ and parentheses",
+					Default:     "(3 + 4) * 2 - 6 / 3",
+					Required:    true,
+				},
+			},
+			RelatedIDs: []string{"shunting_yard"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (et *ExpressionTree) GetMetadata() types.Algorithm {
+	return et.metadata
+}
+
+// ExpressionNode is a node of a binary expression tree
+type ExpressionNode struct {
+	Value string          `json:"value"`
+	Left  *ExpressionNode `json:"left,omitempty"`
+	Right *ExpressionNode `json:"right,omitempty"`
+}
+
+// Execute builds, evaluates and traverses the expression tree
+func (et *ExpressionTree) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	expression := "(3 + 4) * 2 - 6 / 3"
+	if e, ok := parameters["expression"].(string); ok {
+		expression = e
+	}
+
+	tokens, err := stringalgorithms.ParseExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"expression": expression,
+			"tokens":     tokens,
+		},
+		Message:   fmt.Sprintf("Building expression tree for %q", expression),
+		Timestamp: time.Now(),
+	})
+
+	operands := []*ExpressionNode{}
+	operators := []string{}
+	stepNumber := 1
+
+	// combine pops an operator and its two operand subtrees into a new subtree
+	combine := func() {
+		operator := operators[len(operators)-1]
+		operators = operators[:len(operators)-1]
+
+		right := operands[len(operands)-1]
+		left := operands[len(operands)-2]
+		operands = operands[:len(operands)-2]
+
+		node := &ExpressionNode{Value: operator, Left: left, Right: right}
+		operands = append(operands, node)
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "combine_operator",
+			Data: map[string]interface{}{
+				"forest":         append([]*ExpressionNode{}, operands...),
+				"operator_stack": append([]string{}, operators...),
+				"combined":       node,
+			},
+			Message:   fmt.Sprintf("Combined two subtrees under %s", operator),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+	}
+
+	for _, t := range tokens {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		switch t.Kind {
+		case "number":
+			operands = append(operands, &ExpressionNode{Value: t.Value})
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "push_operand",
+				Data: map[string]interface{}{
+					"forest":         append([]*ExpressionNode{}, operands...),
+					"operator_stack": append([]string{}, operators...),
+					"token":          t,
+				},
+				Message:   fmt.Sprintf("Pushed operand %s as a leaf", t.Value),
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+
+		case "operator":
+			for len(operators) > 0 {
+				top := operators[len(operators)-1]
+				if top == "(" {
+					break
+				}
+				if stringalgorithms.Precedence[top] < stringalgorithms.Precedence[t.Value] ||
+					(stringalgorithms.Precedence[top] == stringalgorithms.Precedence[t.Value] && stringalgorithms.RightAssociative[t.Value]) {
+					break
+				}
+				combine()
+			}
+			operators = append(operators, t.Value)
+
+		case "left_paren":
+			operators = append(operators, t.Value)
+
+		case "right_paren":
+			for operators[len(operators)-1] != "(" {
+				combine()
+			}
+			operators = operators[:len(operators)-1]
+		}
+	}
+
+	for len(operators) > 0 {
+		combine()
+	}
+
+	root := operands[0]
+
+	value, err := evaluateExpressionNode(root)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := []string{}
+	postfix := []string{}
+	traverseExpression(root, &prefix, &postfix)
+
+	stepCallback(types.ExecutionStep{
+		StepNumber: stepNumber,
+		Action:     "finalize",
+		Data: map[string]interface{}{
+			"tree":    root,
+			"value":   value,
+			"prefix":  prefix,
+			"postfix": postfix,
+		},
+		Message:   fmt.Sprintf("Expression tree complete; evaluates to %g", value),
+		Timestamp: time.Now(),
+	})
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data: map[string]interface{}{
+			"tree":  root,
+			"value": value,
+		},
+		Message:   "Expression Tree Builder completed",
+		Timestamp: time.Now(),
+	})
+
+	return map[string]interface{}{
+		"tree":    root,
+		"value":   value,
+		"prefix":  prefix,
+		"postfix": postfix,
+	}, nil
+}
+
+// ValidateParameters validates the input parameters
+func (et *ExpressionTree) ValidateParameters(parameters map[string]interface{}) error {
+	if expression, ok := parameters["expression"].(string); ok {
+		if len(expression) > 200 {
+			return fmt.Errorf("expression must be at most 200 characters")
+		}
+		if _, err := stringalgorithms.ParseExpression(expression); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SelfTest verifies the tree shape and value of a known expression
+func (et *ExpressionTree) SelfTest() error {
+	output, err := et.Execute(context.Background(), nil, map[string]interface{}{"expression": "2 ^ 3 ^ 2 - (10 - 4) / 3 * 2"}, func(types.ExecutionStep) {})
+	if err != nil {
+		return fmt.Errorf("execution failed: %v", err)
+	}
+
+	result := output.(map[string]interface{})
+	if value := result["value"].(float64); value != 508 {
+		return fmt.Errorf("expected 508, got %g", value)
+	}
+
+	expected := "2 3 2 ^ ^ 10 4 - 3 / 2 * -"
+	if postfix := strings.Join(result["postfix"].([]string), " "); postfix != expected {
+		return fmt.Errorf("expected postfix %q, got %q", expected, postfix)
+	}
+
+	return nil
+}
+
+// evaluateExpressionNode evaluates the subtree rooted at node
+func evaluateExpressionNode(node *ExpressionNode) (float64, error) {
+	if node.Left == nil && node.Right == nil {
+		return strconv.ParseFloat(node.Value, 64)
+	}
+
+	left, err := evaluateExpressionNode(node.Left)
+	if err != nil {
+		return 0, err
+	}
+	right, err := evaluateExpressionNode(node.Right)
+	if err != nil {
+		return 0, err
+	}
+
+	return stringalgorithms.ApplyOperator(node.Value, left, right)
+}
+
+// traverseExpression collects the prefix and postfix orderings of the subtree
+func traverseExpression(node *ExpressionNode, prefix, postfix *[]string) {
+	if node == nil {
+		return
+	}
+
+	*prefix = append(*prefix, node.Value)
+	traverseExpression(node.Left, prefix, postfix)
+	traverseExpression(node.Right, prefix, postfix)
+	*postfix = append(*postfix, node.Value)
+}
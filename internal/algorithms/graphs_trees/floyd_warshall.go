@@ -0,0 +1,328 @@
+package graphs
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"math"
+	"time"
+)
+
+// FloydWarshall implements all-pairs shortest paths with the Floyd–Warshall
+// algorithm, keeping a next-hop matrix for path reconstruction
+type FloydWarshall struct {
+	metadata types.Algorithm
+}
+
+// NewFloydWarshall creates a new FloydWarshall instance
+func NewFloydWarshall() *FloydWarshall {
+	return &FloydWarshall{
+		metadata: types.Algorithm{
+			ID:          "floyd_warshall",
+			Name:        "Floyd–Warshall",
+			Category:    types.CategoryGraphsTrees,
+			Description: "Computes shortest paths between every pair of nodes. For each node k in turn, every distance i → j is replaced by i → k → j when that is shorter. A next-hop matrix records the first step of each shortest path, and a negative distance on the diagonal reveals a negative cycle.",
+			BigO:        "Time: O(V³), Space: O(V²) where V is vertices",
+			Parameters: []types.Parameter{
+				{
+					Name:        "graph_size",
+					Type:        "int",
+					Description: "Number of nodes in the graph",
+					Default:     5,
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(12),
+					Required:    true,
+				},
+				{
+					Name:        "start_node",
+					Type:        "int",
+					Description: "Node the reconstructed path starts from",
+					Default:     0,
+					Min:         types.IntPtr(0),
+					Max:         types.IntPtr(11),
+					Required:    true,
+				},
+				{
+					Name:        "target_node",
+					Type:        "int",
+					Description: "Node the reconstructed path leads to (default: the last node)",
+					Default:     nil,
+					Min:         types.IntPtr(0),
+					Max:         types.IntPtr(11),
+					Required:    false,
+				},
+				{
+					Name:        "allow_negative_weights",
+					Type:        "bool",
+					Description: "Generate edge weights from -3 to 9 rather than 1 to 9",
+					Default:     false,
+					Required:    false,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible graph generation",
+					Default:     nil,
+					Required:    false,
+				},
+				generators.RepresentationParameter(),
+			},
+			InputType:  types.InputEdgeList,
+			RelatedIDs: []string{"bellman_ford", "dijkstra"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (fw *FloydWarshall) GetMetadata() types.Algorithm {
+	return fw.metadata
+}
+
+// Execute runs the Floyd–Warshall algorithm
+func (fw *FloydWarshall) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	graphSize := 5
+	if size, ok := parameters["graph_size"].(int); ok {
+		graphSize = size
+	}
+
+	// Use the supplied edge list, or generate a graph, with some negative edges if allowed
+	var edges []generators.Edge
+	if input != nil {
+		inputEdges, ok := input.([]generators.Edge)
+		if !ok {
+			return nil, fmt.Errorf("invalid input type, expected a list of weighted edges")
+		}
+		edges = inputEdges
+		graphSize = 0
+		for _, e := range edges {
+			if e.From < 0 || e.To < 0 {
+				return nil, fmt.Errorf("edge %d -> %d has a negative node index", e.From, e.To)
+			}
+			if e.From >= 12 || e.To >= 12 {
+				return nil, fmt.Errorf("edge %d -> %d is outside the largest graph of 12 nodes", e.From, e.To)
+			}
+			if e.From >= graphSize {
+				graphSize = e.From + 1
+			}
+			if e.To >= graphSize {
+				graphSize = e.To + 1
+			}
+		}
+	} else {
+		edges = fw.GenerateInstance(parameters).([]generators.Edge)
+	}
+
+	startNode := 0
+	if start, ok := parameters["start_node"].(int); ok {
+		startNode = start
+	}
+
+	targetNode := graphSize - 1
+	if target, ok := parameters["target_node"].(int); ok {
+		targetNode = target
+	}
+
+	if startNode < 0 || startNode >= graphSize {
+		return nil, fmt.Errorf("start_node %d is outside the graph of %d nodes", startNode, graphSize)
+	}
+	if targetNode < 0 || targetNode >= graphSize {
+		return nil, fmt.Errorf("target_node %d is outside the graph of %d nodes", targetNode, graphSize)
+	}
+
+	// dist[i][j] starts as the lightest direct edge; next[i][j] is the node
+	// after i on the best known path to j, or -1 if there is none
+	dist := make([][]float64, graphSize)
+	next := make([][]int, graphSize)
+	for i := range dist {
+		dist[i] = make([]float64, graphSize)
+		next[i] = make([]int, graphSize)
+		for j := range dist[i] {
+			dist[i][j] = math.Inf(1)
+			next[i][j] = -1
+		}
+		dist[i][i] = 0
+		next[i][i] = i
+	}
+	for _, e := range edges {
+		if float64(e.Weight) < dist[e.From][e.To] {
+			dist[e.From][e.To] = float64(e.Weight)
+			next[e.From][e.To] = e.To
+		}
+	}
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: generators.WithEdgeMatrices(map[string]interface{}{
+			"edges":     edges,
+			"distances": distanceMatrix(dist),
+			"next_hop":  next,
+		}, parameters, graphSize, edges, true),
+		Message:   fmt.Sprintf("Starting Floyd–Warshall over %d nodes and %d edges", graphSize, len(edges)),
+		Timestamp: time.Now(),
+	})
+
+	for k := 0; k < graphSize; k++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		updated := [][2]int{}
+		for i := 0; i < graphSize; i++ {
+			if math.IsInf(dist[i][k], 1) {
+				continue
+			}
+			for j := 0; j < graphSize; j++ {
+				if math.IsInf(dist[k][j], 1) || dist[i][k]+dist[k][j] >= dist[i][j] {
+					continue
+				}
+				dist[i][j] = dist[i][k] + dist[k][j]
+				next[i][j] = next[i][k]
+				updated = append(updated, [2]int{i, j})
+			}
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: k + 1,
+			Action:     "intermediate_vertex",
+			Data: map[string]interface{}{
+				"k":         k,
+				"updated":   updated,
+				"distances": distanceMatrix(dist),
+				"next_hop":  next,
+			},
+			Message:   fmt.Sprintf("Routing through node %d shortened %d paths", k, len(updated)),
+			Timestamp: time.Now(),
+		})
+	}
+
+	// A node that can reach itself at negative cost lies on a negative cycle
+	cycleNodes := []int{}
+	for i := 0; i < graphSize; i++ {
+		if dist[i][i] < 0 {
+			cycleNodes = append(cycleNodes, i)
+		}
+	}
+	hasNegativeCycle := len(cycleNodes) > 0
+
+	result := map[string]interface{}{
+		"edges":              edges,
+		"distances":          distanceMatrix(dist),
+		"next_hop":           next,
+		"has_negative_cycle": hasNegativeCycle,
+		"start_node":         startNode,
+		"target_node":        targetNode,
+	}
+
+	message := "Floyd–Warshall completed"
+	if hasNegativeCycle {
+		result["negative_cycle_nodes"] = cycleNodes
+		message = fmt.Sprintf("Nodes %v lie on negative cycles, so shortest paths are undefined", cycleNodes)
+	} else {
+		// Follow next hops from the start until the target is reached
+		path := []int{}
+		if next[startNode][targetNode] != -1 {
+			path = append(path, startNode)
+			for node := startNode; node != targetNode; {
+				node = next[node][targetNode]
+				path = append(path, node)
+			}
+		}
+		result["path"] = path
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data:       result,
+		Message:    message,
+		Timestamp:  time.Now(),
+	})
+
+	return result, nil
+}
+
+// distanceMatrix converts each row of a distance matrix into a JSON-safe form
+func distanceMatrix(dist [][]float64) [][]interface{} {
+	rows := make([][]interface{}, len(dist))
+	for i, row := range dist {
+		rows[i] = distanceTable(row)
+	}
+	return rows
+}
+
+// GenerateInstance generates the graph Execute runs on when given no input
+func (fw *FloydWarshall) GenerateInstance(parameters map[string]interface{}) interface{} {
+	graphSize := 5
+	if size, ok := parameters["graph_size"].(int); ok {
+		graphSize = size
+	}
+
+	allowNegative := false
+	if a, ok := parameters["allow_negative_weights"].(bool); ok {
+		allowNegative = a
+	}
+
+	minWeight := 1
+	if allowNegative {
+		minWeight = -3
+	}
+
+	return generators.WeightedEdges(generators.NewRand(parameters), graphSize, minWeight, 9)
+}
+
+// ValidateParameters validates the input parameters
+func (fw *FloydWarshall) ValidateParameters(parameters map[string]interface{}) error {
+	graphSize := 5
+	if size, ok := parameters["graph_size"].(int); ok {
+		if size < 3 || size > 12 {
+			return fmt.Errorf("graph_size must be between 3 and 12")
+		}
+		graphSize = size
+	}
+
+	for _, name := range []string{"start_node", "target_node"} {
+		if node, ok := parameters[name].(int); ok {
+			if node < 0 || node >= graphSize {
+				return fmt.Errorf("%s must be between 0 and %d", name, graphSize-1)
+			}
+		}
+	}
+
+	if allow, exists := parameters["allow_negative_weights"]; exists {
+		if _, ok := allow.(bool); !ok {
+			return fmt.Errorf("allow_negative_weights must be a boolean")
+		}
+	}
+
+	return generators.ValidateRepresentation(parameters)
+}
+
+// SelfTest checks the distances and the next-hop path on a small graph
+// whose shortest route uses a negative edge
+func (fw *FloydWarshall) SelfTest() error {
+	// 0 -> 2 -> 1 -> 3 weighs 5 - 3 + 1 = 3, beating 0 -> 1 -> 3 at 5
+	edges := []generators.Edge{
+		{From: 0, To: 1, Weight: 4},
+		{From: 0, To: 2, Weight: 5},
+		{From: 2, To: 1, Weight: -3},
+		{From: 1, To: 3, Weight: 1},
+	}
+
+	output, err := fw.Execute(context.Background(), edges, map[string]interface{}{"start_node": 0, "target_node": 3}, func(types.ExecutionStep) {})
+	if err != nil {
+		return fmt.Errorf("execution failed: %v", err)
+	}
+
+	result := output.(map[string]interface{})
+	if path, _ := result["path"].([]int); fmt.Sprint(path) != "[0 2 1 3]" {
+		return fmt.Errorf("expected path [0 2 1 3], got %v", result["path"])
+	}
+	if distances, _ := result["distances"].([][]interface{}); distances[0][3] != 3.0 {
+		return fmt.Errorf("expected distance 3 from 0 to 3, got %v", distances[0][3])
+	}
+
+	return nil
+}
@@ -0,0 +1,254 @@
+package graphs
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// KruskalMST implements Kruskal's algorithm for a minimum spanning tree
+type KruskalMST struct {
+	metadata types.Algorithm
+}
+
+// NewKruskalMST creates a new KruskalMST instance
+func NewKruskalMST() *KruskalMST {
+	return &KruskalMST{
+		metadata: types.Algorithm{
+			ID:          "kruskal_mst",
+			Name:        "Kruskal's Minimum Spanning Tree",
+			Category:    types.CategoryGraphsTrees,
+			Description: "Builds a minimum spanning tree by taking edges from lightest to heaviest and keeping each one that joins two different components. A disjoint-set forest with union by rank and path compression tracks the components.",
+			BigO:        "Time: O(E log E), Space: O(V + E) where V is vertices and E is edges",
+			Parameters: []types.Parameter{
+				{
+					Name:        "graph_size",
+					Type:        "int",
+					Description: "Number of nodes in the graph",
+					Default:     6,
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(20),
+					Required:    true,
+				},
+				{
+					Name:        "edge_density",
+					Type:        "float",
+					Description: "Probability that two nodes not already chained are joined, from 0.0 to 1.0",
+					Default:     0.35,
+					Required:    false,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible graph generation",
+					Default:     nil,
+					Required:    false,
+				},
+				generators.RepresentationParameter(),
+			},
+			InputType:  types.InputEdgeList,
+			RelatedIDs: []string{"prim_mst"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (k *KruskalMST) GetMetadata() types.Algorithm {
+	return k.metadata
+}
+
+// Execute runs Kruskal's algorithm on the supplied edges or a generated
+// connected graph
+func (k *KruskalMST) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	graphSize := 6
+	if size, ok := parameters["graph_size"].(int); ok {
+		graphSize = size
+	}
+
+	// Use the supplied undirected edges, or generate a connected graph, whose
+	// tree spans every node; a disconnected graph gives a spanning forest
+	var edges []generators.Edge
+	if input != nil {
+		inputEdges, ok := input.([]generators.Edge)
+		if !ok {
+			return nil, fmt.Errorf("invalid input type, expected a list of weighted edges")
+		}
+		if err := generators.ValidateEdges(inputEdges, graphSize); err != nil {
+			return nil, err
+		}
+		edges = inputEdges
+	} else {
+		edges = k.GenerateInstance(parameters).([]generators.Edge)
+	}
+
+	sorted := append([]generators.Edge{}, edges...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Weight < sorted[j].Weight
+	})
+
+	ds := newDisjointSet(graphSize, true, true)
+	treeEdges := []generators.Edge{}
+	totalWeight := 0
+
+	// Send initial state
+	parent, rank := ds.snapshot()
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: generators.WithEdgeMatrices(map[string]interface{}{
+			"edges":        edges,
+			"sorted_edges": sorted,
+			"parent":       parent,
+			"rank":         rank,
+		}, parameters, graphSize, edges, false),
+		Message:   fmt.Sprintf("Sorted %d edges by weight; every node starts as its own component", len(edges)),
+		Timestamp: time.Now(),
+	})
+
+	stepNumber := 1
+
+	for _, edge := range sorted {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if len(treeEdges) == graphSize-1 {
+			break
+		}
+
+		rootFrom, rootTo := ds.find(edge.From), ds.find(edge.To)
+		formsCycle := rootFrom == rootTo
+
+		message := fmt.Sprintf("Edge %d - %d (weight %d) joins components %d and %d", edge.From, edge.To, edge.Weight, rootFrom, rootTo)
+		if formsCycle {
+			message = fmt.Sprintf("Edge %d - %d (weight %d) would form a cycle inside component %d", edge.From, edge.To, edge.Weight, rootFrom)
+		}
+		parent, rank := ds.snapshot()
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "consider_edge",
+			Data: map[string]interface{}{
+				"edge":        edge,
+				"root_from":   rootFrom,
+				"root_to":     rootTo,
+				"forms_cycle": formsCycle,
+				"parent":      parent,
+				"rank":        rank,
+				"tree_edges":  treeEdges,
+			},
+			Message:   message,
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+
+		if formsCycle {
+			continue
+		}
+
+		root := ds.union(rootFrom, rootTo)
+		treeEdges = append(treeEdges, edge)
+		totalWeight += edge.Weight
+
+		parent, rank = ds.snapshot()
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "union",
+			Data: map[string]interface{}{
+				"edge":         edge,
+				"merged":       []int{rootFrom, rootTo},
+				"root":         root,
+				"parent":       parent,
+				"rank":         rank,
+				"components":   ds.count,
+				"tree_edges":   treeEdges,
+				"total_weight": totalWeight,
+			},
+			Message:   fmt.Sprintf("Merged components %d and %d under root %d; %d components remain", rootFrom, rootTo, root, ds.count),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+	}
+
+	parent, rank = ds.snapshot()
+	result := map[string]interface{}{
+		"edges":        edges,
+		"tree_edges":   treeEdges,
+		"total_weight": totalWeight,
+		"parent":       parent,
+		"rank":         rank,
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "mst_complete",
+		Data:       result,
+		Message:    fmt.Sprintf("Minimum spanning tree of %d edges weighs %d", len(treeEdges), totalWeight),
+		Timestamp:  time.Now(),
+	})
+
+	return result, nil
+}
+
+// GenerateInstance generates the graph Execute runs on when given no input
+func (k *KruskalMST) GenerateInstance(parameters map[string]interface{}) interface{} {
+	graphSize := 6
+	if size, ok := parameters["graph_size"].(int); ok {
+		graphSize = size
+	}
+
+	density := 0.35
+	if d, ok := parameters["edge_density"].(float64); ok {
+		density = d
+	}
+
+	return generators.UndirectedEdges(generators.NewRand(parameters), graphSize, density, 1, 9)
+}
+
+// ValidateParameters validates the input parameters
+func (k *KruskalMST) ValidateParameters(parameters map[string]interface{}) error {
+	if size, ok := parameters["graph_size"].(int); ok {
+		if size < 3 || size > 20 {
+			return fmt.Errorf("graph_size must be between 3 and 20")
+		}
+	}
+
+	if density, exists := parameters["edge_density"]; exists {
+		d, ok := density.(float64)
+		if !ok || d < 0 || d > 1 {
+			return fmt.Errorf("edge_density must be a number between 0.0 and 1.0")
+		}
+	}
+
+	return generators.ValidateRepresentation(parameters)
+}
+
+// SelfTest checks that Kruskal's tree weighs the same as Prim's on the same graphs
+func (k *KruskalMST) SelfTest() error {
+	prim := NewPrimMST()
+	for seed := 1; seed <= 5; seed++ {
+		parameters := map[string]interface{}{"graph_size": 10, "edge_density": 0.35, "seed": seed}
+
+		output, err := k.Execute(context.Background(), nil, parameters, func(types.ExecutionStep) {})
+		if err != nil {
+			return fmt.Errorf("execution failed: %v", err)
+		}
+		expected, err := prim.Execute(context.Background(), nil, parameters, func(types.ExecutionStep) {})
+		if err != nil {
+			return fmt.Errorf("prim execution failed: %v", err)
+		}
+
+		result := output.(map[string]interface{})
+		if tree := result["tree_edges"].([]generators.Edge); len(tree) != 9 {
+			return fmt.Errorf("seed %d: expected 9 tree edges, got %d", seed, len(tree))
+		}
+		weight := result["total_weight"].(int)
+		if want := expected.(map[string]interface{})["total_weight"].(int); weight != want {
+			return fmt.Errorf("seed %d: tree weighs %d, Prim's weighs %d", seed, weight, want)
+		}
+	}
+
+	return nil
+}
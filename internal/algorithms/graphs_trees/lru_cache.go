@@ -0,0 +1,374 @@
+package graphs
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limits on the operations parameter
+const (
+	maxLRUOperations = 50
+	maxLRUKey        = 99
+)
+
+// LRUCache simulates a least-recently-used cache built from a hash map and
+// a doubly linked list
+type LRUCache struct {
+	metadata types.Algorithm
+}
+
+// NewLRUCache creates a new LRUCache instance
+func NewLRUCache() *LRUCache {
+	return &LRUCache{
+		metadata: types.Algorithm{
+			ID:          "lru_cache",
+			Name:        "LRU Cache",
+			Category:    types.CategoryGraphsTrees,
+			Description: "A fixed-capacity cache that evicts the entry used least recently. A hash map finds each key's node in a doubly linked list ordered from most to least recently used. Every hit moves its node to the front, new keys are added at the front, and when the cache is full the node at the back is evicted, all in constant time.",
+			BigO:        "Time: O(1) per get or put, Space: O(capacity)",
+			Parameters: []types.Parameter{
+				{
+					Name:        "capacity",
+					Type:        "int",
+					Description: "Maximum number of entries the cache holds",
+					Default:     3,
+					Min:         types.IntPtr(1),
+					Max:         types.IntPtr(10),
+					Required:    true,
+				},
+				{
+					Name:        "operations",
+					Type:        "string",
+					Description: fmt.Sprintf("Comma-separated operations such as \"put 1 10, get 1\", up to %d, with keys from 0 to %d (default: generated)", maxLRUOperations, maxLRUKey),
+					Default:     nil,
+					Required:    false,
+				},
+				{
+					Name:        "num_operations",
+					Type:        "int",
+					Description: "Number of operations to generate when none are given",
+					Default:     15,
+					Min:         types.IntPtr(1),
+					Max:         types.IntPtr(maxLRUOperations),
+					Required:    false,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible operations",
+					Default:     nil,
+					Required:    false,
+				},
+			},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (lru *LRUCache) GetMetadata() types.Algorithm {
+	return lru.metadata
+}
+
+// lruOperation is a get, or a put of Value under Key
+type lruOperation struct {
+	Op    string `json:"op"`
+	Key   int    `json:"key"`
+	Value int    `json:"value,omitempty"`
+}
+
+// parseLRUOperations parses operations such as "put 1 10, get 1"
+func parseLRUOperations(value string) ([]lruOperation, error) {
+	operations := []lruOperation{}
+	for _, item := range strings.Split(value, ",") {
+		fields := strings.Fields(item)
+		if len(fields) == 0 {
+			continue
+		}
+
+		numbers := []int{}
+		for _, field := range fields[1:] {
+			n, err := strconv.Atoi(field)
+			if err != nil {
+				return nil, fmt.Errorf("operation %q: %q is not an integer", strings.TrimSpace(item), field)
+			}
+			numbers = append(numbers, n)
+		}
+
+		var op lruOperation
+		switch {
+		case fields[0] == "get" && len(numbers) == 1:
+			op = lruOperation{Op: "get", Key: numbers[0]}
+		case fields[0] == "put" && len(numbers) == 2:
+			op = lruOperation{Op: "put", Key: numbers[0], Value: numbers[1]}
+		default:
+			return nil, fmt.Errorf("operation %q must be \"get <key>\" or \"put <key> <value>\"", strings.TrimSpace(item))
+		}
+		if op.Key < 0 || op.Key > maxLRUKey {
+			return nil, fmt.Errorf("operation %q: key must be between 0 and %d", strings.TrimSpace(item), maxLRUKey)
+		}
+		operations = append(operations, op)
+	}
+
+	if len(operations) == 0 {
+		return nil, fmt.Errorf("operations must contain at least one operation")
+	}
+	if len(operations) > maxLRUOperations {
+		return nil, fmt.Errorf("operations must contain at most %d operations", maxLRUOperations)
+	}
+	return operations, nil
+}
+
+// lruEntry is a node of the cache's recency list
+type lruEntry struct {
+	key, value int
+	prev, next *lruEntry
+}
+
+// lruList is a doubly linked list between sentinel nodes, most recently
+// used first
+type lruList struct {
+	head, tail *lruEntry
+}
+
+// newLRUList creates an empty list
+func newLRUList() *lruList {
+	list := &lruList{head: &lruEntry{}, tail: &lruEntry{}}
+	list.head.next = list.tail
+	list.tail.prev = list.head
+	return list
+}
+
+// remove unlinks the entry from the list
+func (l *lruList) remove(e *lruEntry) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+}
+
+// pushFront links the entry in as the most recently used
+func (l *lruList) pushFront(e *lruEntry) {
+	e.prev = l.head
+	e.next = l.head.next
+	l.head.next.prev = e
+	l.head.next = e
+}
+
+// back returns the least recently used entry, or nil when empty
+func (l *lruList) back() *lruEntry {
+	if l.tail.prev == l.head {
+		return nil
+	}
+	return l.tail.prev
+}
+
+// keys lists the keys from most to least recently used
+func (l *lruList) keys() []int {
+	keys := []int{}
+	for e := l.head.next; e != l.tail; e = e.next {
+		keys = append(keys, e.key)
+	}
+	return keys
+}
+
+// Execute applies the operations to an empty cache
+func (lru *LRUCache) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	capacity := 3
+	if c, ok := parameters["capacity"].(int); ok {
+		capacity = c
+	}
+
+	// Use the given operations, or generate puts and gets over a key range
+	// a little wider than the cache so both hits and evictions happen
+	var operations []lruOperation
+	if ops, ok := parameters["operations"].(string); ok {
+		parsed, err := parseLRUOperations(ops)
+		if err != nil {
+			return nil, err
+		}
+		operations = parsed
+	} else {
+		numOperations := 15
+		if n, ok := parameters["num_operations"].(int); ok {
+			numOperations = n
+		}
+		rng := generators.NewRand(parameters)
+		keyRange := capacity*2 - capacity/2
+		for i := 0; i < numOperations; i++ {
+			op := lruOperation{Op: "get", Key: rng.Intn(keyRange)}
+			if rng.Intn(2) == 0 {
+				op.Op = "put"
+				op.Value = 10 + rng.Intn(90)
+			}
+			operations = append(operations, op)
+		}
+	}
+
+	list := newLRUList()
+	entries := map[int]*lruEntry{}
+
+	// values copies the hash map's contents for a step
+	values := func() map[int]int {
+		snapshot := make(map[int]int, len(entries))
+		for key, e := range entries {
+			snapshot[key] = e.value
+		}
+		return snapshot
+	}
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"capacity":   capacity,
+			"operations": operations,
+			"order":      list.keys(),
+			"map":        values(),
+		},
+		Message:   fmt.Sprintf("Applying %d operations to an empty cache of capacity %d", len(operations), capacity),
+		Timestamp: time.Now(),
+	})
+
+	// emit sends a step showing the recency order and map during operation i
+	stepNumber := 1
+	emit := func(action string, i int, key int, message string) {
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     action,
+			Data: map[string]interface{}{
+				"operation": i,
+				"key":       key,
+				"order":     list.keys(),
+				"map":       values(),
+			},
+			Message:   message,
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+	}
+
+	hits, misses, evictions := 0, 0, 0
+	gets := []interface{}{}
+
+	for i, op := range operations {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		e, cached := entries[op.Key]
+
+		if cached {
+			hits++
+			if op.Op == "put" {
+				e.value = op.Value
+				emit("cache_hit", i, op.Key, fmt.Sprintf("put %d: key is cached, value updated to %d", op.Key, op.Value))
+			} else {
+				gets = append(gets, e.value)
+				emit("cache_hit", i, op.Key, fmt.Sprintf("get %d: hit, value %d", op.Key, e.value))
+			}
+
+			list.remove(e)
+			list.pushFront(e)
+			emit("promote_to_front", i, op.Key, fmt.Sprintf("Moved key %d to the front as the most recently used", op.Key))
+			continue
+		}
+
+		misses++
+		if op.Op == "get" {
+			gets = append(gets, nil)
+			emit("cache_miss", i, op.Key, fmt.Sprintf("get %d: miss", op.Key))
+			continue
+		}
+		emit("cache_miss", i, op.Key, fmt.Sprintf("put %d: key is not cached", op.Key))
+
+		if len(entries) == capacity {
+			victim := list.back()
+			list.remove(victim)
+			delete(entries, victim.key)
+			evictions++
+			emit("evict_lru", i, victim.key, fmt.Sprintf("Cache full: evicted key %d, the least recently used", victim.key))
+		}
+
+		e = &lruEntry{key: op.Key, value: op.Value}
+		entries[op.Key] = e
+		list.pushFront(e)
+		emit("insert", i, op.Key, fmt.Sprintf("Added key %d with value %d at the front", op.Key, op.Value))
+	}
+
+	result := map[string]interface{}{
+		"capacity":    capacity,
+		"hits":        hits,
+		"misses":      misses,
+		"evictions":   evictions,
+		"get_results": gets,
+		"order":       list.keys(),
+		"map":         values(),
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data:       result,
+		Message:    fmt.Sprintf("%d hits, %d misses and %d evictions over %d operations", hits, misses, evictions, len(operations)),
+		Timestamp:  time.Now(),
+	})
+
+	return result, nil
+}
+
+// ValidateParameters validates the input parameters
+func (lru *LRUCache) ValidateParameters(parameters map[string]interface{}) error {
+	if c, ok := parameters["capacity"].(int); ok {
+		if c < 1 || c > 10 {
+			return fmt.Errorf("capacity must be between 1 and 10")
+		}
+	}
+
+	if n, ok := parameters["num_operations"].(int); ok {
+		if n < 1 || n > maxLRUOperations {
+			return fmt.Errorf("num_operations must be between 1 and %d", maxLRUOperations)
+		}
+	}
+
+	if operations, exists := parameters["operations"]; exists {
+		ops, ok := operations.(string)
+		if !ok {
+			return fmt.Errorf("operations must be a string")
+		}
+		if _, err := parseLRUOperations(ops); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SelfTest replays the classic capacity-2 example and checks the gets,
+// counts and final order
+func (lru *LRUCache) SelfTest() error {
+	parameters := map[string]interface{}{
+		"capacity":   2,
+		"operations": "put 1 1, put 2 2, get 1, put 3 3, get 2, put 4 4, get 1, get 3, get 4",
+	}
+	output, err := lru.Execute(context.Background(), nil, parameters, func(types.ExecutionStep) {})
+	if err != nil {
+		return fmt.Errorf("execution failed: %v", err)
+	}
+
+	result := output.(map[string]interface{})
+	if gets := fmt.Sprint(result["get_results"]); gets != "[1 <nil> <nil> 3 4]" {
+		return fmt.Errorf("expected gets [1 <nil> <nil> 3 4], got %s", gets)
+	}
+	if hits, misses := result["hits"].(int), result["misses"].(int); hits != 3 || misses != 6 {
+		return fmt.Errorf("expected 3 hits and 6 misses, got %d and %d", hits, misses)
+	}
+	if order := fmt.Sprint(result["order"]); order != "[4 3]" {
+		return fmt.Errorf("expected final order [4 3], got %s", order)
+	}
+
+	return nil
+}
@@ -0,0 +1,299 @@
+package graphs
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"container/heap"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// PrimMST implements Prim's algorithm for a minimum spanning tree
+type PrimMST struct {
+	metadata types.Algorithm
+}
+
+// NewPrimMST creates a new PrimMST instance
+func NewPrimMST() *PrimMST {
+	return &PrimMST{
+		metadata: types.Algorithm{
+			ID:          "prim_mst",
+			Name:        "Prim's Minimum Spanning Tree",
+			Category:    types.CategoryGraphsTrees,
+			Description: "Grows a minimum spanning tree of a connected weighted graph from a start node. The frontier holds every edge leaving the tree; the lightest one whose far end is not yet in the tree is taken, and that node's edges join the frontier.",
+			BigO:        "Time: O(E log E), Space: O(E) where E is edges",
+			Parameters: []types.Parameter{
+				{
+					Name:        "graph_size",
+					Type:        "int",
+					Description: "Number of nodes in the graph",
+					Default:     6,
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(20),
+					Required:    true,
+				},
+				{
+					Name:        "start_node",
+					Type:        "int",
+					Description: "Node the tree is grown from",
+					Default:     0,
+					Min:         types.IntPtr(0),
+					Max:         types.IntPtr(19),
+					Required:    true,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible graph generation",
+					Default:     nil,
+					Required:    false,
+				},
+				generators.RepresentationParameter(),
+			},
+			InputType: types.InputEdgeList,
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (p *PrimMST) GetMetadata() types.Algorithm {
+	return p.metadata
+}
+
+// edgeQueue is a min-heap of edges ordered by weight
+type edgeQueue []generators.Edge
+
+func (q edgeQueue) Len() int { return len(q) }
+func (q edgeQueue) Less(i, j int) bool {
+	if q[i].Weight != q[j].Weight {
+		return q[i].Weight < q[j].Weight
+	}
+	if q[i].From != q[j].From {
+		return q[i].From < q[j].From
+	}
+	return q[i].To < q[j].To
+}
+func (q edgeQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *edgeQueue) Push(x interface{}) { *q = append(*q, x.(generators.Edge)) }
+func (q *edgeQueue) Pop() interface{} {
+	old := *q
+	edge := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return edge
+}
+
+// Execute runs Prim's algorithm on the supplied edges or a generated
+// connected graph
+func (p *PrimMST) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	graphSize := 6
+	if size, ok := parameters["graph_size"].(int); ok {
+		graphSize = size
+	}
+
+	startNode := 0
+	if start, ok := parameters["start_node"].(int); ok {
+		startNode = start
+	}
+
+	if startNode < 0 || startNode >= graphSize {
+		return nil, fmt.Errorf("start_node %d is outside the graph of %d nodes", startNode, graphSize)
+	}
+
+	// Use the supplied undirected edges, or generate a connected graph, whose
+	// tree spans every node; otherwise the tree spans start_node's component
+	var edges []generators.Edge
+	if input != nil {
+		inputEdges, ok := input.([]generators.Edge)
+		if !ok {
+			return nil, fmt.Errorf("invalid input type, expected a list of weighted edges")
+		}
+		if err := generators.ValidateEdges(inputEdges, graphSize); err != nil {
+			return nil, err
+		}
+		edges = inputEdges
+	} else {
+		edges = p.GenerateInstance(parameters).([]generators.Edge)
+	}
+
+	// Index each undirected edge from both ends, oriented away from the node
+	adjacency := make([][]generators.Edge, graphSize)
+	for _, e := range edges {
+		adjacency[e.From] = append(adjacency[e.From], e)
+		adjacency[e.To] = append(adjacency[e.To], generators.Edge{From: e.To, To: e.From, Weight: e.Weight})
+	}
+
+	inTree := make([]bool, graphSize)
+	treeEdges := []generators.Edge{}
+	totalWeight := 0
+	frontier := &edgeQueue{}
+
+	// frontierEdges lists the candidate edges in weight order
+	frontierEdges := func() []generators.Edge {
+		sorted := append(edgeQueue{}, *frontier...)
+		sort.Sort(sorted)
+		return sorted
+	}
+
+	// join adds a node to the tree and its outgoing edges to the frontier
+	join := func(node int) {
+		inTree[node] = true
+		for _, e := range adjacency[node] {
+			if !inTree[e.To] {
+				heap.Push(frontier, e)
+			}
+		}
+	}
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: generators.WithEdgeMatrices(map[string]interface{}{
+			"edges":      edges,
+			"start_node": startNode,
+		}, parameters, graphSize, edges, false),
+		Message:   fmt.Sprintf("Growing a spanning tree from node %d over %d edges", startNode, len(edges)),
+		Timestamp: time.Now(),
+	})
+
+	join(startNode)
+	stepCallback(types.ExecutionStep{
+		StepNumber: 1,
+		Action:     "add_to_tree",
+		Data: map[string]interface{}{
+			"node":         startNode,
+			"in_tree":      inTree,
+			"tree_edges":   treeEdges,
+			"frontier":     frontierEdges(),
+			"total_weight": totalWeight,
+		},
+		Message:   fmt.Sprintf("Node %d starts the tree", startNode),
+		Timestamp: time.Now(),
+	})
+	stepNumber := 2
+
+	for frontier.Len() > 0 && len(treeEdges) < graphSize-1 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		edge := heap.Pop(frontier).(generators.Edge)
+		accepted := !inTree[edge.To]
+
+		message := fmt.Sprintf("Edge %d - %d (weight %d) is the lightest candidate", edge.From, edge.To, edge.Weight)
+		if !accepted {
+			message = fmt.Sprintf("Edge %d - %d would close a cycle; node %d is already in the tree", edge.From, edge.To, edge.To)
+		}
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "consider_edge",
+			Data: map[string]interface{}{
+				"edge":       edge,
+				"accepted":   accepted,
+				"tree_edges": treeEdges,
+				"frontier":   frontierEdges(),
+			},
+			Message:   message,
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+
+		if !accepted {
+			continue
+		}
+
+		treeEdges = append(treeEdges, edge)
+		totalWeight += edge.Weight
+		join(edge.To)
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "add_to_tree",
+			Data: map[string]interface{}{
+				"node":         edge.To,
+				"edge":         edge,
+				"in_tree":      inTree,
+				"tree_edges":   treeEdges,
+				"frontier":     frontierEdges(),
+				"total_weight": totalWeight,
+			},
+			Message:   fmt.Sprintf("Node %d joins the tree via %d, total weight %d", edge.To, edge.From, totalWeight),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+	}
+
+	result := map[string]interface{}{
+		"edges":        edges,
+		"tree_edges":   treeEdges,
+		"total_weight": totalWeight,
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data:       result,
+		Message:    fmt.Sprintf("Minimum spanning tree of %d edges weighs %d", len(treeEdges), totalWeight),
+		Timestamp:  time.Now(),
+	})
+
+	return result, nil
+}
+
+// GenerateInstance generates the graph Execute runs on when given no input
+func (p *PrimMST) GenerateInstance(parameters map[string]interface{}) interface{} {
+	graphSize := 6
+	if size, ok := parameters["graph_size"].(int); ok {
+		graphSize = size
+	}
+
+	return generators.UndirectedEdges(generators.NewRand(parameters), graphSize, 0.35, 1, 9)
+}
+
+// ValidateParameters validates the input parameters
+func (p *PrimMST) ValidateParameters(parameters map[string]interface{}) error {
+	graphSize := 6
+	if size, ok := parameters["graph_size"].(int); ok {
+		if size < 3 || size > 20 {
+			return fmt.Errorf("graph_size must be between 3 and 20")
+		}
+		graphSize = size
+	}
+
+	if startNode, ok := parameters["start_node"].(int); ok {
+		if startNode < 0 || startNode >= graphSize {
+			return fmt.Errorf("start_node must be between 0 and %d", graphSize-1)
+		}
+	}
+
+	return generators.ValidateRepresentation(parameters)
+}
+
+// SelfTest checks that the tree spans the graph and that every start node
+// yields the same total weight
+func (p *PrimMST) SelfTest() error {
+	expected := -1
+	for start := 0; start < 8; start++ {
+		parameters := map[string]interface{}{"graph_size": 8, "start_node": start, "seed": 42}
+		output, err := p.Execute(context.Background(), nil, parameters, func(types.ExecutionStep) {})
+		if err != nil {
+			return fmt.Errorf("execution failed: %v", err)
+		}
+
+		result := output.(map[string]interface{})
+		if tree := result["tree_edges"].([]generators.Edge); len(tree) != 7 {
+			return fmt.Errorf("expected 7 tree edges from node %d, got %d", start, len(tree))
+		}
+
+		weight := result["total_weight"].(int)
+		if expected == -1 {
+			expected = weight
+		} else if weight != expected {
+			return fmt.Errorf("tree from node %d weighs %d, but from node 0 it weighs %d", start, weight, expected)
+		}
+	}
+
+	return nil
+}
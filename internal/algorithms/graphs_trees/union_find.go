@@ -0,0 +1,346 @@
+package graphs
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"time"
+)
+
+// disjointSet is a union-find forest with optional union by rank and path
+// compression
+type disjointSet struct {
+	parent   []int
+	rank     []int
+	count    int // Number of components
+	compress bool
+	byRank   bool
+}
+
+// newDisjointSet creates a forest of size singleton components
+func newDisjointSet(size int, compress, byRank bool) *disjointSet {
+	ds := &disjointSet{
+		parent:   make([]int, size),
+		rank:     make([]int, size),
+		count:    size,
+		compress: compress,
+		byRank:   byRank,
+	}
+	for i := range ds.parent {
+		ds.parent[i] = i
+	}
+	return ds
+}
+
+// find returns the root of x's component
+func (ds *disjointSet) find(x int) int {
+	root, _ := ds.findPath(x)
+	return root
+}
+
+// findPath returns the root of x's component and the nodes walked from x to
+// it. With path compression every node on the way is pointed at the root
+func (ds *disjointSet) findPath(x int) (int, []int) {
+	path := []int{x}
+	for ds.parent[path[len(path)-1]] != path[len(path)-1] {
+		path = append(path, ds.parent[path[len(path)-1]])
+	}
+
+	root := path[len(path)-1]
+	if ds.compress {
+		for _, node := range path {
+			ds.parent[node] = root
+		}
+	}
+	return root, path
+}
+
+// union merges the components rooted at rootA and rootB and returns the new
+// root. By rank the shallower tree goes under the deeper one; otherwise
+// rootB always goes under rootA
+func (ds *disjointSet) union(rootA, rootB int) int {
+	if ds.byRank && ds.rank[rootA] < ds.rank[rootB] {
+		rootA, rootB = rootB, rootA
+	}
+	ds.parent[rootB] = rootA
+	if ds.rank[rootA] == ds.rank[rootB] {
+		ds.rank[rootA]++
+	}
+	ds.count--
+	return rootA
+}
+
+// snapshot copies the parent and rank arrays for a step
+func (ds *disjointSet) snapshot() ([]int, []int) {
+	return append([]int{}, ds.parent...), append([]int{}, ds.rank...)
+}
+
+// UnionFind visualizes a disjoint-set forest processing a sequence of union
+// and find operations
+type UnionFind struct {
+	metadata types.Algorithm
+}
+
+// NewUnionFind creates a new UnionFind instance
+func NewUnionFind() *UnionFind {
+	return &UnionFind{
+		metadata: types.Algorithm{
+			ID:          "union_find",
+			Name:        "Union-Find (Disjoint Set)",
+			Category:    types.CategoryGraphsTrees,
+			Description: "Tracks a partition of elements into disjoint sets as a forest of parent pointers. Find follows pointers to a set's root; union hangs one root under the other. Union by rank keeps the trees shallow and path compression flattens every path that find walks, together giving nearly constant time per operation.",
+			BigO:        "Time: O(α(n)) amortized per operation with both optimizations, O(n) without, Space: O(n)",
+			Parameters: []types.Parameter{
+				{
+					Name:        "num_elements",
+					Type:        "int",
+					Description: "Number of elements, each starting in its own set",
+					Default:     10,
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(30),
+					Required:    true,
+				},
+				{
+					Name:        "use_path_compression",
+					Type:        "bool",
+					Description: "Point every node on a find path directly at the root",
+					Default:     true,
+					Required:    false,
+				},
+				{
+					Name:        "use_union_by_rank",
+					Type:        "bool",
+					Description: "Hang the shallower tree under the deeper one on union",
+					Default:     true,
+					Required:    false,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for a reproducible sequence of operations",
+					Default:     nil,
+					Required:    false,
+				},
+			},
+			RelatedIDs: []string{"kruskal_mst"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (uf *UnionFind) GetMetadata() types.Algorithm {
+	return uf.metadata
+}
+
+// unionFindOperation is a union of two elements, or a find when B is -1
+type unionFindOperation struct {
+	A int `json:"a"`
+	B int `json:"b"`
+}
+
+// Execute applies a generated sequence of operations to a disjoint-set forest
+func (uf *UnionFind) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	n := 10
+	if size, ok := parameters["num_elements"].(int); ok {
+		n = size
+	}
+
+	compress := true
+	if c, ok := parameters["use_path_compression"].(bool); ok {
+		compress = c
+	}
+
+	byRank := true
+	if r, ok := parameters["use_union_by_rank"].(bool); ok {
+		byRank = r
+	}
+
+	// Use the supplied operations, or generate about three unions to every two finds
+	var operations []unionFindOperation
+	if input != nil {
+		inputOperations, ok := input.([]unionFindOperation)
+		if !ok {
+			return nil, fmt.Errorf("invalid input type, expected a list of union-find operations")
+		}
+		operations = inputOperations
+	} else {
+		rng := generators.NewRand(parameters)
+		for i := 0; i < 2*n; i++ {
+			op := unionFindOperation{A: rng.Intn(n), B: -1}
+			if rng.Intn(5) < 3 {
+				op.B = rng.Intn(n)
+			}
+			operations = append(operations, op)
+		}
+	}
+	for _, op := range operations {
+		if op.A < 0 || op.A >= n || op.B < -1 || op.B >= n {
+			return nil, fmt.Errorf("operation on %d and %d is outside the %d elements", op.A, op.B, n)
+		}
+	}
+
+	ds := newDisjointSet(n, compress, byRank)
+
+	// Send initial state
+	parent, rank := ds.snapshot()
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"operations":           operations,
+			"parent":               parent,
+			"rank":                 rank,
+			"use_path_compression": compress,
+			"use_union_by_rank":    byRank,
+		},
+		Message:   fmt.Sprintf("%d elements in their own sets, %d operations to apply", n, len(operations)),
+		Timestamp: time.Now(),
+	})
+
+	longestPath := 0
+	for i, op := range operations {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if op.B == -1 {
+			before, _ := ds.snapshot()
+			root, path := ds.findPath(op.A)
+			if len(path) > longestPath {
+				longestPath = len(path)
+			}
+
+			parent, rank := ds.snapshot()
+			data := map[string]interface{}{
+				"operation": i,
+				"element":   op.A,
+				"root":      root,
+				"path":      path,
+				"parent":    parent,
+				"rank":      rank,
+			}
+			if compress {
+				data["parent_before"] = before
+			}
+			stepCallback(types.ExecutionStep{
+				StepNumber: i + 1,
+				Action:     "find",
+				Data:       data,
+				Message:    fmt.Sprintf("find(%d) walked %v to root %d", op.A, path, root),
+				Timestamp:  time.Now(),
+			})
+			continue
+		}
+
+		rootA, pathA := ds.findPath(op.A)
+		rootB, pathB := ds.findPath(op.B)
+		for _, path := range [][]int{pathA, pathB} {
+			if len(path) > longestPath {
+				longestPath = len(path)
+			}
+		}
+
+		merged := rootA != rootB
+		root := rootA
+		message := fmt.Sprintf("%d and %d are already in the set rooted at %d", op.A, op.B, rootA)
+		if merged {
+			root = ds.union(rootA, rootB)
+			message = fmt.Sprintf("union(%d, %d) merged the sets rooted at %d and %d under %d", op.A, op.B, rootA, rootB, root)
+		}
+
+		parent, rank := ds.snapshot()
+		stepCallback(types.ExecutionStep{
+			StepNumber: i + 1,
+			Action:     "union",
+			Data: map[string]interface{}{
+				"operation":  i,
+				"a":          op.A,
+				"b":          op.B,
+				"path_a":     pathA,
+				"path_b":     pathB,
+				"merged":     merged,
+				"root":       root,
+				"parent":     parent,
+				"rank":       rank,
+				"components": ds.count,
+			},
+			Message:   message,
+			Timestamp: time.Now(),
+		})
+	}
+
+	parent, rank = ds.snapshot()
+	result := map[string]interface{}{
+		"components":   ds.count,
+		"parent":       parent,
+		"rank":         rank,
+		"longest_path": longestPath,
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data:       result,
+		Message:    fmt.Sprintf("%d disjoint sets remain; the longest find path visited %d nodes", ds.count, longestPath),
+		Timestamp:  time.Now(),
+	})
+
+	return result, nil
+}
+
+// ValidateParameters validates the input parameters
+func (uf *UnionFind) ValidateParameters(parameters map[string]interface{}) error {
+	if size, ok := parameters["num_elements"].(int); ok {
+		if size < 3 || size > 30 {
+			return fmt.Errorf("num_elements must be between 3 and 30")
+		}
+	}
+
+	for _, name := range []string{"use_path_compression", "use_union_by_rank"} {
+		if flag, exists := parameters[name]; exists {
+			if _, ok := flag.(bool); !ok {
+				return fmt.Errorf("%s must be a boolean", name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// SelfTest checks the component count of a chain of unions with every
+// combination of optimizations, and that compression flattens a long path
+func (uf *UnionFind) SelfTest() error {
+	// Linking 0-1, 1-2, ..., 6-7 leaves 8 and 9 alone: 3 sets
+	operations := []unionFindOperation{}
+	for i := 0; i < 7; i++ {
+		operations = append(operations, unionFindOperation{A: i, B: i + 1})
+	}
+	operations = append(operations, unionFindOperation{A: 0, B: -1})
+
+	for _, compress := range []bool{false, true} {
+		for _, byRank := range []bool{false, true} {
+			parameters := map[string]interface{}{"num_elements": 10, "use_path_compression": compress, "use_union_by_rank": byRank}
+			output, err := uf.Execute(context.Background(), operations, parameters, func(types.ExecutionStep) {})
+			if err != nil {
+				return fmt.Errorf("execution failed: %v", err)
+			}
+
+			result := output.(map[string]interface{})
+			if components := result["components"].(int); components != 3 {
+				return fmt.Errorf("compression %t, by rank %t: expected 3 sets, got %d", compress, byRank, components)
+			}
+
+			if compress {
+				root := result["parent"].([]int)[0]
+				for i := 0; i < 8; i++ {
+					if parent := result["parent"].([]int)[i]; parent != root {
+						return fmt.Errorf("compression %t, by rank %t: element %d points at %d, not root %d", compress, byRank, i, parent, root)
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
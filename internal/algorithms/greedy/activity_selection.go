@@ -0,0 +1,219 @@
+package greedy
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// activity is an interval [Start, Finish) that needs a shared resource
+type activity struct {
+	ID     int `json:"id"`
+	Start  int `json:"start"`
+	Finish int `json:"finish"`
+}
+
+// ActivitySelection implements the greedy earliest-finish-first schedule of
+// non-overlapping activities
+type ActivitySelection struct {
+	metadata types.Algorithm
+}
+
+// NewActivitySelection creates a new ActivitySelection instance
+func NewActivitySelection() *ActivitySelection {
+	return &ActivitySelection{
+		metadata: types.Algorithm{
+			ID:          "activity_selection",
+			Name:        "Activity Selection",
+			Category:    types.CategoryGreedy,
+			Description: "Schedules as many non-overlapping activities as possible on one resource. Activities are sorted by finish time, and each is taken if it starts no earlier than the last chosen one finished. Finishing first leaves the most room for the rest, so the greedy choice is always part of some optimal schedule.",
+			BigO:        "Time: O(n log n) for the sort, then O(n), Space: O(n)",
+			Parameters: []types.Parameter{
+				{
+					Name:        "num_activities",
+					Type:        "int",
+					Description: "Number of activities to schedule",
+					Default:     10,
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(20),
+					Required:    true,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible activity generation",
+					Default:     nil,
+					Required:    false,
+				},
+			},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (as *ActivitySelection) GetMetadata() types.Algorithm {
+	return as.metadata
+}
+
+// Execute sorts the activities by finish time and takes each one that fits
+func (as *ActivitySelection) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	numActivities := 10
+	if n, ok := parameters["num_activities"].(int); ok {
+		numActivities = n
+	}
+
+	// Activities start within a 24-hour day and last 1 to 8 hours
+	rng := generators.NewRand(parameters)
+	activities := make([]activity, numActivities)
+	for i := range activities {
+		start := rng.Intn(24)
+		activities[i] = activity{ID: i, Start: start, Finish: start + 1 + rng.Intn(8)}
+	}
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"activities": activities,
+		},
+		Message:   fmt.Sprintf("Scheduling %d activities on one resource", numActivities),
+		Timestamp: time.Now(),
+	})
+
+	// Ties on finish time go to the earlier start, then the lower ID
+	sorted := append([]activity{}, activities...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Finish != sorted[j].Finish {
+			return sorted[i].Finish < sorted[j].Finish
+		}
+		return sorted[i].Start < sorted[j].Start
+	})
+
+	stepCallback(types.ExecutionStep{
+		StepNumber: 1,
+		Action:     "sort_by_finish",
+		Data: map[string]interface{}{
+			"sorted": sorted,
+		},
+		Message:   "Sorted activities by finish time",
+		Timestamp: time.Now(),
+	})
+
+	selected := []activity{}
+	lastFinish := 0
+	stepNumber := 2
+
+	for _, a := range sorted {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		fits := len(selected) == 0 || a.Start >= lastFinish
+
+		action := "skip"
+		message := fmt.Sprintf("Activity %d [%d, %d) starts before %d, when the last chosen activity finishes", a.ID, a.Start, a.Finish, lastFinish)
+		if fits {
+			action = "select"
+			message = fmt.Sprintf("Activity %d [%d, %d) fits after %d and is selected", a.ID, a.Start, a.Finish, lastFinish)
+			if len(selected) == 0 {
+				message = fmt.Sprintf("Activity %d [%d, %d) finishes first and is selected", a.ID, a.Start, a.Finish)
+			}
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     action,
+			Data: map[string]interface{}{
+				"activity":    a,
+				"last_finish": lastFinish,
+				"fits":        fits,
+				"selected":    append([]activity{}, selected...),
+			},
+			Message:   message,
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+
+		if fits {
+			selected = append(selected, a)
+			lastFinish = a.Finish
+		}
+	}
+
+	result := map[string]interface{}{
+		"activities": activities,
+		"selected":   selected,
+		"count":      len(selected),
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data:       result,
+		Message:    fmt.Sprintf("Selected %d of %d activities", len(selected), numActivities),
+		Timestamp:  time.Now(),
+	})
+
+	return result, nil
+}
+
+// ValidateParameters validates the input parameters
+func (as *ActivitySelection) ValidateParameters(parameters map[string]interface{}) error {
+	if numActivities, ok := parameters["num_activities"].(int); ok {
+		if numActivities < 3 || numActivities > 20 {
+			return fmt.Errorf("num_activities must be between 3 and 20")
+		}
+	}
+
+	return nil
+}
+
+// SelfTest checks that the selection never overlaps and is as large as
+// the best subset found by brute force
+func (as *ActivitySelection) SelfTest() error {
+	for seed := 1; seed <= 10; seed++ {
+		output, err := as.Execute(context.Background(), nil, map[string]interface{}{"num_activities": 12, "seed": seed}, func(types.ExecutionStep) {})
+		if err != nil {
+			return fmt.Errorf("execution failed: %v", err)
+		}
+
+		result := output.(map[string]interface{})
+		activities := result["activities"].([]activity)
+		selected := result["selected"].([]activity)
+		for i := 1; i < len(selected); i++ {
+			if selected[i].Start < selected[i-1].Finish {
+				return fmt.Errorf("seed %d: activities %d and %d overlap", seed, selected[i-1].ID, selected[i].ID)
+			}
+		}
+
+		best := 0
+		for subset := 1; subset < 1<<len(activities); subset++ {
+			count, compatible := 0, true
+			for i := 0; i < len(activities) && compatible; i++ {
+				if subset&(1<<i) == 0 {
+					continue
+				}
+				count++
+				for j := i + 1; j < len(activities); j++ {
+					a, b := activities[i], activities[j]
+					if subset&(1<<j) != 0 && a.Start < b.Finish && b.Start < a.Finish {
+						compatible = false
+						break
+					}
+				}
+			}
+			if compatible && count > best {
+				best = count
+			}
+		}
+		if len(selected) != best {
+			return fmt.Errorf("seed %d: selected %d activities, but %d fit", seed, len(selected), best)
+		}
+	}
+
+	return nil
+}
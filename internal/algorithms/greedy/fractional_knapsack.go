@@ -0,0 +1,267 @@
+package greedy
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// knapsackItem is an item that can be packed whole or in part
+type knapsackItem struct {
+	ID     int `json:"id"`
+	Weight int `json:"weight"`
+	Value  int `json:"value"`
+}
+
+// ratio is the value the item adds per unit of weight
+func (item knapsackItem) ratio() float64 {
+	return float64(item.Value) / float64(item.Weight)
+}
+
+// FractionalKnapsack implements the greedy solution to the knapsack problem
+// when items can be split
+type FractionalKnapsack struct {
+	metadata types.Algorithm
+}
+
+// NewFractionalKnapsack creates a new FractionalKnapsack instance
+func NewFractionalKnapsack() *FractionalKnapsack {
+	return &FractionalKnapsack{
+		metadata: types.Algorithm{
+			ID:          "fractional_knapsack",
+			Name:        "Fractional Knapsack",
+			Category:    types.CategoryGreedy,
+			Description: "Packs the most value into a knapsack when any fraction of an item may be taken. Items are sorted by value per unit of weight and taken whole, best first, until the next one no longer fits; that one is cut to fill the remaining capacity. Splitting makes the greedy choice optimal, which it is not for the 0/1 problem. Items are generated as for 0/1 Knapsack, so the same seed compares the two.",
+			BigO:        "Time: O(n log n) for the sort, then O(n), Space: O(n)",
+			Parameters: []types.Parameter{
+				{
+					Name:        "num_items",
+					Type:        "int",
+					Description: "Number of items to choose from",
+					Default:     6,
+					Min:         types.IntPtr(2),
+					Max:         types.IntPtr(15),
+					Required:    true,
+				},
+				{
+					Name:        "capacity",
+					Type:        "int",
+					Description: "Total weight the knapsack can hold",
+					Default:     15,
+					Min:         types.IntPtr(1),
+					Max:         types.IntPtr(50),
+					Required:    true,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible item generation",
+					Default:     nil,
+					Required:    false,
+				},
+			},
+			RelatedIDs: []string{"knapsack_01"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (fk *FractionalKnapsack) GetMetadata() types.Algorithm {
+	return fk.metadata
+}
+
+// Execute sorts the items by value per unit of weight and packs them in
+// that order, splitting the first one that does not fit
+func (fk *FractionalKnapsack) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	numItems := 6
+	if n, ok := parameters["num_items"].(int); ok {
+		numItems = n
+	}
+
+	capacity := 15
+	if c, ok := parameters["capacity"].(int); ok {
+		capacity = c
+	}
+
+	// Weights of 1 to 10 and values of 1 to 30, as 0/1 Knapsack draws them
+	rng := generators.NewRand(parameters)
+	items := make([]knapsackItem, numItems)
+	for i := range items {
+		items[i] = knapsackItem{ID: i, Weight: 1 + rng.Intn(10), Value: 1 + rng.Intn(30)}
+	}
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"items":    items,
+			"capacity": capacity,
+		},
+		Message:   fmt.Sprintf("Packing %d divisible items into a knapsack of capacity %d", numItems, capacity),
+		Timestamp: time.Now(),
+	})
+
+	// Compare ratios by cross-multiplying to keep ties exact; ties keep ID order
+	sorted := append([]knapsackItem{}, items...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Value*sorted[j].Weight > sorted[j].Value*sorted[i].Weight
+	})
+
+	ratios := make([]float64, len(sorted))
+	for i, item := range sorted {
+		ratios[i] = item.ratio()
+	}
+
+	stepCallback(types.ExecutionStep{
+		StepNumber: 1,
+		Action:     "sort_by_ratio",
+		Data: map[string]interface{}{
+			"sorted": sorted,
+			"ratios": ratios,
+		},
+		Message:   "Sorted items by value per unit of weight, best first",
+		Timestamp: time.Now(),
+	})
+
+	// fractions[id] is how much of each item is packed, from 0 to 1
+	fractions := make([]float64, numItems)
+	remaining := float64(capacity)
+	totalValue := 0.0
+	stepNumber := 2
+
+	for _, item := range sorted {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if remaining <= 0 {
+			break
+		}
+
+		action := "take_full"
+		fraction := 1.0
+		if float64(item.Weight) > remaining {
+			action = "take_fraction"
+			fraction = remaining / float64(item.Weight)
+		}
+
+		fractions[item.ID] = fraction
+		remaining -= fraction * float64(item.Weight)
+		totalValue += fraction * float64(item.Value)
+
+		message := fmt.Sprintf("Item %d (weight %d, value %d) fits whole; %.4g capacity left", item.ID, item.Weight, item.Value, remaining)
+		if action == "take_fraction" {
+			message = fmt.Sprintf("Item %d (weight %d, value %d) does not fit; taking %.4g of it fills the knapsack", item.ID, item.Weight, item.Value, fraction)
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     action,
+			Data: map[string]interface{}{
+				"item":               item,
+				"ratio":              item.ratio(),
+				"fraction":           fraction,
+				"remaining_capacity": remaining,
+				"total_value":        totalValue,
+				"fractions":          append([]float64{}, fractions...),
+			},
+			Message:   message,
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+	}
+
+	result := map[string]interface{}{
+		"items":              items,
+		"capacity":           capacity,
+		"max_value":          totalValue,
+		"fractions":          fractions,
+		"remaining_capacity": remaining,
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data:       result,
+		Message:    fmt.Sprintf("Packed a value of %.4g into capacity %d", totalValue, capacity),
+		Timestamp:  time.Now(),
+	})
+
+	return result, nil
+}
+
+// ValidateParameters validates the input parameters
+func (fk *FractionalKnapsack) ValidateParameters(parameters map[string]interface{}) error {
+	if numItems, ok := parameters["num_items"].(int); ok {
+		if numItems < 2 || numItems > 15 {
+			return fmt.Errorf("num_items must be between 2 and 15")
+		}
+	}
+
+	if capacity, ok := parameters["capacity"].(int); ok {
+		if capacity < 1 || capacity > 50 {
+			return fmt.Errorf("capacity must be between 1 and 50")
+		}
+	}
+
+	return nil
+}
+
+// SelfTest checks that the packing fits, splits at most one item, fills the
+// knapsack when the items allow, and is worth at least the best 0/1 packing
+func (fk *FractionalKnapsack) SelfTest() error {
+	for seed := 1; seed <= 10; seed++ {
+		output, err := fk.Execute(context.Background(), nil, map[string]interface{}{"num_items": 10, "capacity": 25, "seed": seed}, func(types.ExecutionStep) {})
+		if err != nil {
+			return fmt.Errorf("execution failed: %v", err)
+		}
+
+		result := output.(map[string]interface{})
+		items := result["items"].([]knapsackItem)
+		fractions := result["fractions"].([]float64)
+
+		weight, value, split, totalWeight := 0.0, 0.0, 0, 0
+		for i, item := range items {
+			if fractions[i] < 0 || fractions[i] > 1 {
+				return fmt.Errorf("seed %d: item %d has fraction %g", seed, i, fractions[i])
+			}
+			if fractions[i] > 0 && fractions[i] < 1 {
+				split++
+			}
+			weight += fractions[i] * float64(item.Weight)
+			value += fractions[i] * float64(item.Value)
+			totalWeight += item.Weight
+		}
+
+		if split > 1 {
+			return fmt.Errorf("seed %d: %d items were split", seed, split)
+		}
+		if weight > 25+1e-9 || (totalWeight >= 25 && math.Abs(weight-25) > 1e-9) {
+			return fmt.Errorf("seed %d: packed weight %g into capacity 25", seed, weight)
+		}
+		if math.Abs(value-result["max_value"].(float64)) > 1e-9 {
+			return fmt.Errorf("seed %d: fractions are worth %g, reported %g", seed, value, result["max_value"])
+		}
+
+		// Splitting can only help, so no whole-item packing is worth more
+		for subset := 0; subset < 1<<len(items); subset++ {
+			w, v := 0, 0
+			for i, item := range items {
+				if subset&(1<<i) != 0 {
+					w += item.Weight
+					v += item.Value
+				}
+			}
+			if w <= 25 && float64(v) > value+1e-9 {
+				return fmt.Errorf("seed %d: whole items are worth %d, more than %g", seed, v, value)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package algorithms
+
+import (
+	"reflect"
+	"testing"
+
+	"algorthmia/internal/types"
+)
+
+// TestSeededInstances checks that every algorithm that generates its own
+// graph, grid or point set generates the same one for the same seed
+func TestSeededInstances(t *testing.T) {
+	registry := NewRegistry()
+
+	for _, metadata := range registry.GetAllAlgorithms() {
+		algorithm, _ := registry.GetAlgorithm(metadata.ID)
+		generator, ok := algorithm.(types.InstanceGenerator)
+		if !ok {
+			continue
+		}
+
+		first := generator.GenerateInstance(map[string]interface{}{"seed": 42})
+		second := generator.GenerateInstance(map[string]interface{}{"seed": 42})
+		if !reflect.DeepEqual(first, second) {
+			t.Errorf("%s: seed 42 generated %v and then %v", metadata.ID, first, second)
+		}
+	}
+}
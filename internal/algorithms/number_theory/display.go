@@ -0,0 +1,67 @@
+package numbertheory
+
+import (
+	"algorthmia/internal/types"
+	"fmt"
+	"strconv"
+)
+
+// displayBases are the bases number-theory steps can be formatted in
+var displayBases = map[int]string{2: "0b", 10: "", 16: "0x"}
+
+// displayBaseParameter is the optional base that step values are formatted in
+func displayBaseParameter() types.Parameter {
+	return types.Parameter{
+		Name:        "display_base",
+		Type:        "int",
+		Description: "Base for the formatted values in each step's display map: 2, 10 or 16",
+		Default:     10,
+		Required:    false,
+	}
+}
+
+// validateDisplayBase checks that display_base, if given, is a supported base
+func validateDisplayBase(parameters map[string]interface{}) error {
+	if base, exists := parameters["display_base"]; exists {
+		b, ok := base.(int)
+		if _, supported := displayBases[b]; !ok || !supported {
+			return fmt.Errorf("display_base must be 2, 10 or 16")
+		}
+	}
+	return nil
+}
+
+// displayBase returns the requested display base, defaulting to 10
+func displayBase(parameters map[string]interface{}) int {
+	if base, ok := parameters["display_base"].(int); ok {
+		if _, supported := displayBases[base]; supported {
+			return base
+		}
+	}
+	return 10
+}
+
+// formatInBase formats a value in base 2, 10 or 16 with a 0b or 0x prefix
+func formatInBase(value, base int) string {
+	sign := ""
+	if value < 0 {
+		sign = "-"
+		value = -value
+	}
+	return sign + displayBases[base] + strconv.FormatInt(int64(value), base)
+}
+
+// withDisplay adds the display base and a display map holding the named
+// integer values of the data formatted in that base. The raw values stay in
+// the data for computation
+func withDisplay(data map[string]interface{}, base int, keys ...string) map[string]interface{} {
+	display := make(map[string]string, len(keys))
+	for _, key := range keys {
+		if value, ok := data[key].(int); ok {
+			display[key] = formatInBase(value, base)
+		}
+	}
+	data["display_base"] = base
+	data["display"] = display
+	return data
+}
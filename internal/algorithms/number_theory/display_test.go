@@ -0,0 +1,73 @@
+package numbertheory
+
+import (
+	"context"
+	"testing"
+
+	"algorthmia/internal/types"
+)
+
+func TestFormatInBase(t *testing.T) {
+	cases := []struct {
+		value, base int
+		expected    string
+	}{
+		{21, 10, "21"},
+		{21, 16, "0x15"},
+		{21, 2, "0b10101"},
+		{-21, 16, "-0x15"},
+		{0, 2, "0b0"},
+	}
+	for _, c := range cases {
+		if formatted := formatInBase(c.value, c.base); formatted != c.expected {
+			t.Errorf("formatInBase(%d, %d) = %q, expected %q", c.value, c.base, formatted, c.expected)
+		}
+	}
+}
+
+// TestGCDDisplayBase checks that GCD steps keep their raw values and add
+// them formatted in the requested base
+func TestGCDDisplayBase(t *testing.T) {
+	gcd := NewEuclideanGCD()
+	parameters := map[string]interface{}{"a": 1071, "b": 462, "display_base": 16}
+	if err := gcd.ValidateParameters(parameters); err != nil {
+		t.Fatalf("validating: %v", err)
+	}
+
+	var steps []types.ExecutionStep
+	output, err := gcd.Execute(context.Background(), nil, parameters, func(step types.ExecutionStep) {
+		steps = append(steps, step)
+	})
+	if err != nil {
+		t.Fatalf("executing: %v", err)
+	}
+
+	for _, step := range steps {
+		if step.Data["display_base"] != 16 {
+			t.Errorf("%s step has display_base %v", step.Action, step.Data["display_base"])
+		}
+		display := step.Data["display"].(map[string]string)
+		for key, formatted := range display {
+			if expected := formatInBase(step.Data[key].(int), 16); formatted != expected {
+				t.Errorf("%s step shows %s as %q, expected %q", step.Action, key, formatted, expected)
+			}
+		}
+	}
+
+	// 1071 = 2 × 462 + 147
+	first := steps[1].Data["display"].(map[string]string)
+	if first["a"] != "0x42f" || first["b"] != "0x1ce" || first["remainder"] != "0x93" {
+		t.Errorf("first division shows %v", first)
+	}
+
+	result := output.(map[string]interface{})
+	if result["gcd"] != 21 || result["display"].(map[string]string)["gcd"] != "0x15" {
+		t.Errorf("result gcd %v shown as %v", result["gcd"], result["display"])
+	}
+
+	for _, base := range []interface{}{8, "16", 0} {
+		if err := gcd.ValidateParameters(map[string]interface{}{"display_base": base}); err == nil {
+			t.Errorf("accepted display_base %v", base)
+		}
+	}
+}
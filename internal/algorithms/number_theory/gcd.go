@@ -0,0 +1,218 @@
+package numbertheory
+
+import (
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"time"
+)
+
+// maxGCDOperand bounds a and b
+const maxGCDOperand = 1000000000
+
+// EuclideanGCD implements Euclid's algorithm for the greatest common
+// divisor, optionally extended with Bézout coefficients
+type EuclideanGCD struct {
+	metadata types.Algorithm
+}
+
+// NewEuclideanGCD creates a new EuclideanGCD instance
+func NewEuclideanGCD() *EuclideanGCD {
+	return &EuclideanGCD{
+		metadata: types.Algorithm{
+			ID:          "euclidean_gcd",
+			Name:        "Euclidean GCD",
+			Category:    types.CategoryNumberTheory,
+			Description: "Replaces (a, b) with (b, a mod b) until b is 0; a is then the greatest common divisor, since every common divisor of a and b also divides a mod b. The remainders fall fastest when quotients are large, and slowest for consecutive Fibonacci numbers, where every quotient is 1. The extended version also tracks x and y with a·x + b·y = gcd(a, b).",
+			BigO:        "Time: O(log min(a, b)), Space: O(1)",
+			Parameters: []types.Parameter{
+				{
+					Name:        "a",
+					Type:        "int",
+					Description: "First number",
+					Default:     1071,
+					Min:         types.IntPtr(1),
+					Max:         types.IntPtr(maxGCDOperand),
+					Required:    true,
+				},
+				{
+					Name:        "b",
+					Type:        "int",
+					Description: "Second number",
+					Default:     462,
+					Min:         types.IntPtr(1),
+					Max:         types.IntPtr(maxGCDOperand),
+					Required:    true,
+				},
+				{
+					Name:        "extended",
+					Type:        "bool",
+					Description: "Also track the Bézout coefficients x and y with a·x + b·y = gcd(a, b)",
+					Default:     false,
+					Required:    false,
+				},
+				displayBaseParameter(),
+			},
+			RelatedIDs: []string{"modular_exponentiation"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (g *EuclideanGCD) GetMetadata() types.Algorithm {
+	return g.metadata
+}
+
+// Execute divides repeatedly until the remainder is 0
+func (g *EuclideanGCD) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	a := 1071
+	if v, ok := parameters["a"].(int); ok {
+		a = v
+	}
+
+	b := 462
+	if v, ok := parameters["b"].(int); ok {
+		b = v
+	}
+
+	extended, _ := parameters["extended"].(bool)
+	base := displayBase(parameters)
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: withDisplay(map[string]interface{}{
+			"a":        a,
+			"b":        b,
+			"extended": extended,
+		}, base, "a", "b"),
+		Message:   fmt.Sprintf("Finding gcd(%s, %s)", formatInBase(a, base), formatInBase(b, base)),
+		Timestamp: time.Now(),
+	})
+
+	// Each remainder r is a combination a·x + b·y of the inputs; the previous
+	// and current coefficients are carried along with the remainders
+	originalA, originalB := a, b
+	previousX, x := 1, 0
+	previousY, y := 0, 1
+
+	remainders := []int{}
+	stepNumber := 1
+
+	for b != 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		quotient, remainder := a/b, a%b
+		remainders = append(remainders, remainder)
+
+		data := map[string]interface{}{
+			"a":         a,
+			"b":         b,
+			"quotient":  quotient,
+			"remainder": remainder,
+			"iteration": stepNumber,
+		}
+		keys := []string{"a", "b", "quotient", "remainder"}
+
+		previousX, x = x, previousX-quotient*x
+		previousY, y = y, previousY-quotient*y
+		if extended {
+			// The remainder as a combination of the original inputs
+			data["x"] = x
+			data["y"] = y
+			keys = append(keys, "x", "y")
+		}
+
+		message := fmt.Sprintf("%s = %s × %s + %s", formatInBase(a, base), formatInBase(quotient, base), formatInBase(b, base), formatInBase(remainder, base))
+		if extended {
+			message += fmt.Sprintf(", and %s = %s × %s + %s × %s", formatInBase(remainder, base), formatInBase(originalA, base), formatInBase(x, base), formatInBase(originalB, base), formatInBase(y, base))
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "divide",
+			Data:       withDisplay(data, base, keys...),
+			Message:    message,
+			Timestamp:  time.Now(),
+		})
+		stepNumber++
+
+		a, b = b, remainder
+	}
+
+	result := map[string]interface{}{
+		"gcd":        a,
+		"remainders": remainders,
+		"iterations": len(remainders),
+	}
+	keys := []string{"gcd"}
+	message := fmt.Sprintf("gcd is %s after %d divisions", formatInBase(a, base), len(remainders))
+	if extended {
+		result["x"] = previousX
+		result["y"] = previousY
+		keys = append(keys, "x", "y")
+		message += fmt.Sprintf("; %s × %s + %s × %s = %s", formatInBase(originalA, base), formatInBase(previousX, base), formatInBase(originalB, base), formatInBase(previousY, base), formatInBase(a, base))
+	}
+	result = withDisplay(result, base, keys...)
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data:       result,
+		Message:    message,
+		Timestamp:  time.Now(),
+	})
+
+	return result, nil
+}
+
+// ValidateParameters validates the input parameters
+func (g *EuclideanGCD) ValidateParameters(parameters map[string]interface{}) error {
+	for _, name := range []string{"a", "b"} {
+		if value, ok := parameters[name].(int); ok {
+			if value < 1 || value > maxGCDOperand {
+				return fmt.Errorf("%s must be between 1 and %d", name, maxGCDOperand)
+			}
+		}
+	}
+
+	if extended, exists := parameters["extended"]; exists {
+		if _, ok := extended.(bool); !ok {
+			return fmt.Errorf("extended must be a boolean")
+		}
+	}
+
+	return validateDisplayBase(parameters)
+}
+
+// SelfTest checks known divisors and the Bézout identity, and that
+// consecutive Fibonacci numbers take the most divisions for their size
+func (g *EuclideanGCD) SelfTest() error {
+	cases := [][3]int{{1071, 462, 21}, {462, 1071, 21}, {17, 5, 1}, {12, 12, 12}, {1, maxGCDOperand, 1}}
+	for _, c := range cases {
+		output, err := g.Execute(context.Background(), nil, map[string]interface{}{"a": c[0], "b": c[1], "extended": true}, func(types.ExecutionStep) {})
+		if err != nil {
+			return fmt.Errorf("execution failed: %v", err)
+		}
+
+		result := output.(map[string]interface{})
+		gcd, x, y := result["gcd"].(int), result["x"].(int), result["y"].(int)
+		if gcd != c[2] {
+			return fmt.Errorf("gcd(%d, %d): expected %d, got %d", c[0], c[1], c[2], gcd)
+		}
+		if c[0]*x+c[1]*y != gcd {
+			return fmt.Errorf("gcd(%d, %d): %d × %d + %d × %d is not %d", c[0], c[1], c[0], x, c[1], y, gcd)
+		}
+	}
+
+	// F(30) and F(29) need 28 divisions, each with quotient 1 but the last
+	output, _ := g.Execute(context.Background(), nil, map[string]interface{}{"a": 832040, "b": 514229}, func(types.ExecutionStep) {})
+	if iterations := output.(map[string]interface{})["iterations"].(int); iterations != 28 {
+		return fmt.Errorf("expected 28 divisions for consecutive Fibonacci numbers, got %d", iterations)
+	}
+
+	return nil
+}
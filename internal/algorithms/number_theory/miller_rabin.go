@@ -0,0 +1,278 @@
+package numbertheory
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"time"
+)
+
+// maxMillerRabinN bounds n, keeping every product of two residues within int64
+const maxMillerRabinN = 1000000000
+
+// MillerRabin implements the Miller–Rabin probabilistic primality test
+type MillerRabin struct {
+	metadata types.Algorithm
+}
+
+// NewMillerRabin creates a new MillerRabin instance
+func NewMillerRabin() *MillerRabin {
+	return &MillerRabin{
+		metadata: types.Algorithm{
+			ID:          "miller_rabin",
+			Name:        "Miller–Rabin Primality Test",
+			Category:    types.CategoryNumberTheory,
+			Description: "Writes n - 1 as 2^s · d with d odd, then picks random witnesses a and squares a^d mod n up to s - 1 times. For a prime n the sequence starts at 1 or reaches n - 1, since 1 has no other square roots mod a prime. A witness whose sequence does neither proves n composite. Each witness catches a composite with probability at least 3/4, so a number that survives every round is probably prime. Carmichael numbers, which fool Fermat's test, are caught too.",
+			BigO:        "Time: O(k log³ n) for k rounds, Space: O(1)",
+			Parameters: []types.Parameter{
+				{
+					Name:        "n",
+					Type:        "int",
+					Description: "Number to test",
+					Default:     561,
+					Min:         types.IntPtr(2),
+					Max:         types.IntPtr(maxMillerRabinN),
+					Required:    true,
+				},
+				{
+					Name:        "rounds",
+					Type:        "int",
+					Description: "Number of random witnesses to try",
+					Default:     5,
+					Min:         types.IntPtr(1),
+					Max:         types.IntPtr(20),
+					Required:    true,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible witness choice",
+					Default:     nil,
+					Required:    false,
+				},
+				displayBaseParameter(),
+			},
+			RelatedIDs: []string{"modular_exponentiation", "sieve_of_eratosthenes"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (mr *MillerRabin) GetMetadata() types.Algorithm {
+	return mr.metadata
+}
+
+// Execute tests n against random witnesses until one proves it composite
+func (mr *MillerRabin) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	n := 561
+	if v, ok := parameters["n"].(int); ok {
+		n = v
+	}
+
+	rounds := 5
+	if r, ok := parameters["rounds"].(int); ok {
+		rounds = r
+	}
+
+	base := displayBase(parameters)
+	rng := generators.NewRand(parameters)
+
+	// n - 1 = 2^s · d with d odd
+	d, s := n-1, 0
+	for d > 0 && d%2 == 0 {
+		d /= 2
+		s++
+	}
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: withDisplay(map[string]interface{}{
+			"n":      n,
+			"rounds": rounds,
+			"d":      d,
+			"s":      s,
+		}, base, "n", "d"),
+		Message:   fmt.Sprintf("Testing %s with %d rounds: n - 1 = 2^%d × %s", formatInBase(n, base), rounds, s, formatInBase(d, base)),
+		Timestamp: time.Now(),
+	})
+
+	witnesses := []int{}
+	compositeWitness := 0
+	probablyPrime := true
+	reason := ""
+	stepNumber := 1
+
+	switch {
+	case n < 4:
+		// 2 and 3 leave no witness between 2 and n - 2
+		reason = fmt.Sprintf("%s is prime", formatInBase(n, base))
+	case n%2 == 0:
+		probablyPrime = false
+		reason = fmt.Sprintf("%s is even", formatInBase(n, base))
+	}
+
+	for round := 1; reason == "" && round <= rounds; round++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		witness := 2 + rng.Intn(n-3)
+		witnesses = append(witnesses, witness)
+
+		x := modPow(witness, d, n)
+		sequence := []int{x}
+		passes := x == 1 || x == n-1
+		found := !passes && s == 1
+
+		message := fmt.Sprintf("Round %d: witness %s gives %s^%s mod %s = %s", round, formatInBase(witness, base), formatInBase(witness, base), formatInBase(d, base), formatInBase(n, base), formatInBase(x, base))
+		switch {
+		case passes:
+			message += ", so n passes this round"
+		case found:
+			message += fmt.Sprintf(" with no squarings left to reach %s, so n is composite", formatInBase(n-1, base))
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "choose_witness",
+			Data: withDisplay(map[string]interface{}{
+				"round":             round,
+				"witness":           witness,
+				"x":                 x,
+				"sequence":          append([]int{}, sequence...),
+				"passes":            passes,
+				"composite_witness": found,
+			}, base, "witness", "x"),
+			Message:   message,
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+
+		// Square up to s - 1 times looking for n - 1
+		for r := 1; !passes && !found && r < s; r++ {
+			x = x * x % n
+			sequence = append(sequence, x)
+			passes = x == n-1
+			found = !passes && (x == 1 || r == s-1)
+
+			message := fmt.Sprintf("Squaring %d gives %s", r, formatInBase(x, base))
+			switch {
+			case passes:
+				message += ", which is n - 1, so n passes this round"
+			case x == 1:
+				message += fmt.Sprintf(", a square root of 1 other than ±1 came before it, so %s proves n composite", formatInBase(witness, base))
+			case found:
+				message += fmt.Sprintf(" without reaching n - 1, so %s proves n composite", formatInBase(witness, base))
+			}
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "square",
+				Data: withDisplay(map[string]interface{}{
+					"round":             round,
+					"witness":           witness,
+					"squaring":          r,
+					"x":                 x,
+					"sequence":          append([]int{}, sequence...),
+					"passes":            passes,
+					"composite_witness": found,
+				}, base, "witness", "x"),
+				Message:   message,
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+		}
+
+		// One witness is proof, so the remaining rounds are skipped
+		if found {
+			probablyPrime = false
+			compositeWitness = witness
+			reason = fmt.Sprintf("%s is composite, proven by witness %s", formatInBase(n, base), formatInBase(witness, base))
+		}
+	}
+
+	if reason == "" {
+		reason = fmt.Sprintf("%s is probably prime after %d rounds", formatInBase(n, base), len(witnesses))
+	}
+
+	result := map[string]interface{}{
+		"n":              n,
+		"probably_prime": probablyPrime,
+		"witnesses":      witnesses,
+	}
+	keys := []string{"n"}
+	if compositeWitness != 0 {
+		result["composite_witness"] = compositeWitness
+		keys = append(keys, "composite_witness")
+	}
+	result = withDisplay(result, base, keys...)
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data:       result,
+		Message:    reason,
+		Timestamp:  time.Now(),
+	})
+
+	return result, nil
+}
+
+// ValidateParameters validates the input parameters
+func (mr *MillerRabin) ValidateParameters(parameters map[string]interface{}) error {
+	if n, ok := parameters["n"].(int); ok {
+		if n < 2 || n > maxMillerRabinN {
+			return fmt.Errorf("n must be between 2 and %d", maxMillerRabinN)
+		}
+	}
+
+	if rounds, ok := parameters["rounds"].(int); ok {
+		if rounds < 1 || rounds > 20 {
+			return fmt.Errorf("rounds must be between 1 and 20")
+		}
+	}
+
+	return validateDisplayBase(parameters)
+}
+
+// SelfTest checks every n below 2000 against trial division; primes must
+// pass every round and, with 10 rounds, composites including the Carmichael
+// numbers must be caught
+func (mr *MillerRabin) SelfTest() error {
+	for n := 2; n < 2000; n++ {
+		prime := true
+		for f := 2; f*f <= n; f++ {
+			if n%f == 0 {
+				prime = false
+				break
+			}
+		}
+
+		output, err := mr.Execute(context.Background(), nil, map[string]interface{}{"n": n, "rounds": 10, "seed": n}, func(types.ExecutionStep) {})
+		if err != nil {
+			return fmt.Errorf("execution failed: %v", err)
+		}
+
+		if got := output.(map[string]interface{})["probably_prime"].(bool); got != prime {
+			return fmt.Errorf("%d: expected probably_prime %t, got %t", n, prime, got)
+		}
+	}
+
+	return nil
+}
+
+// modPow computes base^exponent mod modulus by repeated squaring
+func modPow(base, exponent, modulus int) int {
+	result := 1 % modulus
+	base %= modulus
+	for ; exponent > 0; exponent >>= 1 {
+		if exponent&1 == 1 {
+			result = result * base % modulus
+		}
+		base = base * base % modulus
+	}
+	return result
+}
@@ -0,0 +1,215 @@
+package numbertheory
+
+import (
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"time"
+)
+
+// maxModExpOperand bounds the base, exponent and modulus, keeping every
+// product of two residues within int64
+const maxModExpOperand = 1000000000
+
+// ModularExponentiation computes base^exponent mod modulus by binary
+// exponentiation
+type ModularExponentiation struct {
+	metadata types.Algorithm
+}
+
+// NewModularExponentiation creates a new ModularExponentiation instance
+func NewModularExponentiation() *ModularExponentiation {
+	return &ModularExponentiation{
+		metadata: types.Algorithm{
+			ID:          "modular_exponentiation",
+			Name:        "Modular Exponentiation",
+			Category:    types.CategoryNumberTheory,
+			Description: "Computes base^exponent mod modulus from the exponent's bits, lowest first. The base is squared once per bit, so it runs through base^1, base^2, base^4, and so on, and it is multiplied into the result wherever the bit is set. Reducing after every product keeps the numbers small, and the work grows with the number of bits rather than with the exponent.",
+			BigO:        "Time: O(log e) multiplications for exponent e, Space: O(1)",
+			Parameters: []types.Parameter{
+				{
+					Name:        "base",
+					Type:        "int",
+					Description: "Number to raise to the power",
+					Default:     7,
+					Min:         types.IntPtr(0),
+					Max:         types.IntPtr(maxModExpOperand),
+					Required:    true,
+				},
+				{
+					Name:        "exponent",
+					Type:        "int",
+					Description: "Power to raise the base to",
+					Default:     560,
+					Min:         types.IntPtr(0),
+					Max:         types.IntPtr(maxModExpOperand),
+					Required:    true,
+				},
+				{
+					Name:        "modulus",
+					Type:        "int",
+					Description: "Modulus the result is reduced by",
+					Default:     561,
+					Min:         types.IntPtr(1),
+					Max:         types.IntPtr(maxModExpOperand),
+					Required:    true,
+				},
+				displayBaseParameter(),
+			},
+			RelatedIDs: []string{"euclidean_gcd"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (me *ModularExponentiation) GetMetadata() types.Algorithm {
+	return me.metadata
+}
+
+// Execute walks the exponent's bits from the lowest
+func (me *ModularExponentiation) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	base := 7
+	if v, ok := parameters["base"].(int); ok {
+		base = v
+	}
+
+	exponent := 560
+	if v, ok := parameters["exponent"].(int); ok {
+		exponent = v
+	}
+
+	modulus := 561
+	if v, ok := parameters["modulus"].(int); ok {
+		modulus = v
+	}
+
+	display := displayBase(parameters)
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: withDisplay(map[string]interface{}{
+			"base":     base,
+			"exponent": exponent,
+			"modulus":  modulus,
+			"bits":     formatInBase(exponent, 2),
+		}, display, "base", "exponent", "modulus"),
+		Message:   fmt.Sprintf("Computing %s^%s mod %s from the exponent's bits %s", formatInBase(base, display), formatInBase(exponent, display), formatInBase(modulus, display), formatInBase(exponent, 2)),
+		Timestamp: time.Now(),
+	})
+
+	// Every x mod 1 is 0, including x^0
+	result := 1 % modulus
+	power := base % modulus // base^(2^i) for the current bit i
+	multiplications := 0
+	squarings := 0
+	stepNumber := 1
+
+	for bit, remaining := 0, exponent; remaining > 0; bit, remaining = bit+1, remaining>>1 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		set := remaining&1 == 1
+		if bit > 0 {
+			power = power * power % modulus
+			squarings++
+		}
+
+		message := fmt.Sprintf("Bit %d is 0: base squared to %s, result stays %s", bit, formatInBase(power, display), formatInBase(result, display))
+		if set {
+			result = result * power % modulus
+			multiplications++
+			message = fmt.Sprintf("Bit %d is 1: base squared to %s and multiplied in, result is %s", bit, formatInBase(power, display), formatInBase(result, display))
+		}
+		if bit == 0 {
+			message = fmt.Sprintf("Bit 0 is %d: base reduced to %s, result is %s", remaining&1, formatInBase(power, display), formatInBase(result, display))
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "process_bit",
+			Data: withDisplay(map[string]interface{}{
+				"bit_index":       bit,
+				"bit_set":         set,
+				"base":            power,
+				"result":          result,
+				"multiplications": multiplications,
+				"squarings":       squarings,
+			}, display, "base", "result"),
+			Message:   message,
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+	}
+
+	// Multiplying the base in exponent - 1 times is the naive cost
+	naive := 0
+	if exponent > 1 {
+		naive = exponent - 1
+	}
+	total := multiplications + squarings
+
+	output := withDisplay(map[string]interface{}{
+		"result":                 result,
+		"multiplications":        total,
+		"squarings":              squarings,
+		"result_multiplications": multiplications,
+		"naive_multiplications":  naive,
+	}, display, "result")
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data:       output,
+		Message:    fmt.Sprintf("%s^%s mod %s = %s with %d multiplications instead of %d", formatInBase(base, display), formatInBase(exponent, display), formatInBase(modulus, display), formatInBase(result, display), total, naive),
+		Timestamp:  time.Now(),
+	})
+
+	return output, nil
+}
+
+// ValidateParameters validates the input parameters
+func (me *ModularExponentiation) ValidateParameters(parameters map[string]interface{}) error {
+	for _, name := range []string{"base", "exponent"} {
+		if value, ok := parameters[name].(int); ok {
+			if value < 0 || value > maxModExpOperand {
+				return fmt.Errorf("%s must be between 0 and %d", name, maxModExpOperand)
+			}
+		}
+	}
+
+	if modulus, ok := parameters["modulus"].(int); ok {
+		if modulus < 1 || modulus > maxModExpOperand {
+			return fmt.Errorf("modulus must be between 1 and %d", maxModExpOperand)
+		}
+	}
+
+	return validateDisplayBase(parameters)
+}
+
+// SelfTest checks results against repeated multiplication, including the
+// Carmichael number 561 fooling Fermat's test and the edge cases of a zero
+// exponent and a modulus of 1
+func (me *ModularExponentiation) SelfTest() error {
+	cases := [][3]int{{7, 560, 561}, {2, 10, 1000}, {5, 0, 13}, {5, 3, 1}, {0, 0, 7}, {123456789, 1000, 999999937}}
+	for _, c := range cases {
+		output, err := me.Execute(context.Background(), nil, map[string]interface{}{"base": c[0], "exponent": c[1], "modulus": c[2]}, func(types.ExecutionStep) {})
+		if err != nil {
+			return fmt.Errorf("execution failed: %v", err)
+		}
+
+		expected := 1 % c[2]
+		for i := 0; i < c[1]; i++ {
+			expected = expected * (c[0] % c[2]) % c[2]
+		}
+
+		result := output.(map[string]interface{})
+		if got := result["result"].(int); got != expected {
+			return fmt.Errorf("%d^%d mod %d: expected %d, got %d", c[0], c[1], c[2], expected, got)
+		}
+	}
+
+	return nil
+}
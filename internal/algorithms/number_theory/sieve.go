@@ -0,0 +1,200 @@
+package numbertheory
+
+import (
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"time"
+)
+
+// SieveOfEratosthenes finds every prime up to a limit by crossing out the
+// multiples of each prime found
+type SieveOfEratosthenes struct {
+	metadata types.Algorithm
+}
+
+// NewSieveOfEratosthenes creates a new SieveOfEratosthenes instance
+func NewSieveOfEratosthenes() *SieveOfEratosthenes {
+	return &SieveOfEratosthenes{
+		metadata: types.Algorithm{
+			ID:          "sieve_of_eratosthenes",
+			Name:        "Sieve of Eratosthenes",
+			Category:    types.CategoryNumberTheory,
+			Description: "Lists the numbers from 2 to the limit and walks them in order. The next number not yet crossed out is prime, and its multiples from its square upward are crossed out; smaller multiples were already crossed out by smaller primes. Once the square passes the limit, every number left is prime.",
+			BigO:        "Time: O(n log log n), Space: O(n) where n is the limit",
+			Parameters: []types.Parameter{
+				{
+					Name:        "limit",
+					Type:        "int",
+					Description: "Largest number to test",
+					Default:     50,
+					Min:         types.IntPtr(10),
+					Max:         types.IntPtr(200),
+					Required:    true,
+				},
+				displayBaseParameter(),
+			},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (s *SieveOfEratosthenes) GetMetadata() types.Algorithm {
+	return s.metadata
+}
+
+// Execute sieves the numbers up to the limit
+func (s *SieveOfEratosthenes) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	limit := 50
+	if l, ok := parameters["limit"].(int); ok {
+		limit = l
+	}
+
+	base := displayBase(parameters)
+
+	// sieve[i] reports whether i may still be prime; 0 and 1 never are
+	sieve := make([]bool, limit+1)
+	for i := 2; i <= limit; i++ {
+		sieve[i] = true
+	}
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: withDisplay(map[string]interface{}{
+			"limit": limit,
+			"sieve": sieve,
+		}, base, "limit"),
+		Message:   fmt.Sprintf("Listing the numbers from 2 to %s as possible primes", formatInBase(limit, base)),
+		Timestamp: time.Now(),
+	})
+
+	primes := []int{}
+	crossings := 0
+	stepNumber := 1
+
+	for p := 2; p <= limit; p++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if !sieve[p] {
+			continue
+		}
+		primes = append(primes, p)
+
+		message := fmt.Sprintf("%s was not crossed out, so it is prime; crossing out its multiples from %s", formatInBase(p, base), formatInBase(p*p, base))
+		if p*p > limit {
+			message = fmt.Sprintf("%s was not crossed out, so it is prime; its square is past the limit", formatInBase(p, base))
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "mark_prime",
+			Data: withDisplay(map[string]interface{}{
+				"prime":  p,
+				"primes": primes,
+				"sieve":  sieve,
+			}, base, "prime"),
+			Message:   message,
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+
+		for multiple := p * p; multiple <= limit; multiple += p {
+			// A multiple of a smaller prime is crossed out again, unchanged
+			already := !sieve[multiple]
+			sieve[multiple] = false
+			crossings++
+
+			message := fmt.Sprintf("Crossed out %s = %s × %s", formatInBase(multiple, base), formatInBase(p, base), formatInBase(multiple/p, base))
+			if already {
+				message = fmt.Sprintf("%s = %s × %s was already crossed out", formatInBase(multiple, base), formatInBase(p, base), formatInBase(multiple/p, base))
+			}
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "cross_out",
+				Data: withDisplay(map[string]interface{}{
+					"prime":           p,
+					"multiple":        multiple,
+					"already_crossed": already,
+					"sieve":           sieve,
+				}, base, "prime", "multiple"),
+				Message:   message,
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+		}
+	}
+
+	result := map[string]interface{}{
+		"primes":    primes,
+		"count":     len(primes),
+		"crossings": crossings,
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data: withDisplay(map[string]interface{}{
+			"primes":    primes,
+			"count":     len(primes),
+			"crossings": crossings,
+			"sieve":     sieve,
+		}, base, "count"),
+		Message:   fmt.Sprintf("Found %d primes up to %s with %d crossings", len(primes), formatInBase(limit, base), crossings),
+		Timestamp: time.Now(),
+	})
+
+	return result, nil
+}
+
+// ValidateParameters validates the input parameters
+func (s *SieveOfEratosthenes) ValidateParameters(parameters map[string]interface{}) error {
+	if limit, ok := parameters["limit"].(int); ok {
+		if limit < 10 || limit > 200 {
+			return fmt.Errorf("limit must be between 10 and 200")
+		}
+	}
+	return validateDisplayBase(parameters)
+}
+
+// SelfTest checks the prime counts at both ends of the limit range and the
+// primes up to 30 against trial division
+func (s *SieveOfEratosthenes) SelfTest() error {
+	for limit, expected := range map[int]int{10: 4, 30: 10, 100: 25, 200: 46} {
+		output, err := s.Execute(context.Background(), nil, map[string]interface{}{"limit": limit}, func(types.ExecutionStep) {})
+		if err != nil {
+			return fmt.Errorf("execution failed: %v", err)
+		}
+
+		result := output.(map[string]interface{})
+		if count := result["count"].(int); count != expected {
+			return fmt.Errorf("limit %d: expected %d primes, got %d", limit, expected, count)
+		}
+
+		if limit != 30 {
+			continue
+		}
+		expectedPrimes := []int{}
+		for n := 2; n <= limit; n++ {
+			prime := true
+			for d := 2; d*d <= n; d++ {
+				if n%d == 0 {
+					prime = false
+					break
+				}
+			}
+			if prime {
+				expectedPrimes = append(expectedPrimes, n)
+			}
+		}
+		if primes := fmt.Sprint(result["primes"]); primes != fmt.Sprint(expectedPrimes) {
+			return fmt.Errorf("limit 30: expected primes %v, got %s", expectedPrimes, primes)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,303 @@
+package optimization
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// ClosestPair implements the divide-and-conquer closest pair of points algorithm
+// using Manhattan distance
+type ClosestPair struct {
+	metadata types.Algorithm
+}
+
+// NewClosestPair creates a new ClosestPair instance
+func NewClosestPair() *ClosestPair {
+	return &ClosestPair{
+		metadata: types.Algorithm{
+			ID:          "closest_pair",
+			Name:        "Closest Pair of Points",
+			Category:    types.CategoryOptimization,
+			Description: "A divide-and-conquer algorithm that splits the points at the median x-coordinate, solves each half recursively and then scans a narrow strip around the split for closer pairs, using Manhattan distance.",
+			BigO:        "Time: O(n log² n), Space: O(n)",
+			Parameters: []types.Parameter{
+				{
+					Name:        "num_points",
+					Type:        "int",
+					Description: "Number of points to generate",
+					Default:     16,
+					Min:         types.IntPtr(2),
+					Max:         types.IntPtr(100),
+					Required:    true,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible point generation",
+					Default:     nil,
+					Required:    false,
+				},
+			},
+			InputType:  types.InputPointList,
+			RelatedIDs: []string{"graham_scan"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (cp *ClosestPair) GetMetadata() types.Algorithm {
+	return cp.metadata
+}
+
+// closestPairState carries the shared state of the recursive search
+type closestPairState struct {
+	ctx          context.Context
+	points       []generators.Point
+	stepCallback func(types.ExecutionStep)
+	stepNumber   int
+	best         []generators.Point
+	bestDistance int
+}
+
+// Execute runs the closest pair algorithm
+func (cp *ClosestPair) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	numPoints := 16
+	if n, ok := parameters["num_points"].(int); ok {
+		numPoints = n
+	}
+
+	// Use a copy of the supplied points, or generate them
+	var points []generators.Point
+	if input != nil {
+		inputPoints, ok := input.([]generators.Point)
+		if !ok {
+			return nil, fmt.Errorf("invalid input type, expected a list of points")
+		}
+		if err := generators.ValidatePoints(inputPoints, numPoints); err != nil {
+			return nil, err
+		}
+		points = append([]generators.Point{}, inputPoints...)
+	} else {
+		points = cp.GenerateInstance(parameters).([]generators.Point)
+	}
+
+	// Sort points by x-coordinate so each half can be split at the median
+	sort.Slice(points, func(i, j int) bool {
+		if points[i].X == points[j].X {
+			return points[i].Y < points[j].Y
+		}
+		return points[i].X < points[j].X
+	})
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"points":     points,
+			"num_points": numPoints,
+		},
+		Message:   fmt.Sprintf("Starting Closest Pair search over %d points sorted by x", numPoints),
+		Timestamp: time.Now(),
+	})
+
+	state := &closestPairState{
+		ctx:          ctx,
+		points:       points,
+		stepCallback: stepCallback,
+		stepNumber:   1,
+		bestDistance: math.MaxInt,
+	}
+
+	state.closest(0, len(points), 0)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data: map[string]interface{}{
+			"points":   points,
+			"pair":     state.best,
+			"distance": state.bestDistance,
+		},
+		Message:   fmt.Sprintf("Closest pair found with Manhattan distance %d", state.bestDistance),
+		Timestamp: time.Now(),
+	})
+
+	return map[string]interface{}{
+		"pair":     state.best,
+		"distance": state.bestDistance,
+		"points":   points,
+	}, nil
+}
+
+// closest finds the closest pair among points[low:high], updating the shared best pair
+func (s *closestPairState) closest(low, high, depth int) {
+	if s.ctx.Err() != nil {
+		return
+	}
+	s.stepCallback(types.ExecutionStep{
+		StepNumber: s.stepNumber,
+		Action:     "recurse",
+		Data: map[string]interface{}{
+			"points":         s.points,
+			"low":            low,
+			"high":           high - 1,
+			"depth":          depth,
+			"candidate_pair": s.best,
+		},
+		Message:   fmt.Sprintf("Solving points %d to %d at depth %d", low, high-1, depth),
+		Timestamp: time.Now(),
+	})
+	s.stepNumber++
+
+	// Brute force small ranges
+	if high-low <= 3 {
+		for i := low; i < high; i++ {
+			for j := i + 1; j < high; j++ {
+				s.consider(s.points[i], s.points[j], depth)
+			}
+		}
+		return
+	}
+
+	mid := low + (high-low)/2
+	medianX := s.points[mid].X
+
+	s.stepCallback(types.ExecutionStep{
+		StepNumber: s.stepNumber,
+		Action:     "divide_by_median_x",
+		Data: map[string]interface{}{
+			"points":         s.points,
+			"low":            low,
+			"mid":            mid,
+			"high":           high - 1,
+			"median_x":       medianX,
+			"depth":          depth,
+			"candidate_pair": s.best,
+		},
+		Message:   fmt.Sprintf("Dividing points at median x = %d", medianX),
+		Timestamp: time.Now(),
+	})
+	s.stepNumber++
+
+	s.closest(low, mid, depth+1)
+	s.closest(mid, high, depth+1)
+
+	// Collect points close enough to the dividing line to beat the current best
+	strip := []generators.Point{}
+	for i := low; i < high; i++ {
+		if generators.Abs(s.points[i].X-medianX) < s.bestDistance {
+			strip = append(strip, s.points[i])
+		}
+	}
+	sort.Slice(strip, func(i, j int) bool {
+		return strip[i].Y < strip[j].Y
+	})
+
+	s.stepCallback(types.ExecutionStep{
+		StepNumber: s.stepNumber,
+		Action:     "check_strip",
+		Data: map[string]interface{}{
+			"points":         s.points,
+			"strip":          strip,
+			"median_x":       medianX,
+			"depth":          depth,
+			"candidate_pair": s.best,
+			"best_distance":  s.bestDistance,
+		},
+		Message:   fmt.Sprintf("Checking %d points in the strip around x = %d", len(strip), medianX),
+		Timestamp: time.Now(),
+	})
+	s.stepNumber++
+
+	for i := 0; i < len(strip); i++ {
+		for j := i + 1; j < len(strip) && strip[j].Y-strip[i].Y < s.bestDistance; j++ {
+			s.consider(strip[i], strip[j], depth)
+		}
+	}
+}
+
+// consider records the pair as the new best if it is closer than the current best
+func (s *closestPairState) consider(a, b generators.Point, depth int) {
+	distance := manhattan(a, b)
+	if distance >= s.bestDistance {
+		return
+	}
+
+	s.best = []generators.Point{a, b}
+	s.bestDistance = distance
+
+	s.stepCallback(types.ExecutionStep{
+		StepNumber: s.stepNumber,
+		Action:     "update_closest",
+		Data: map[string]interface{}{
+			"points":         s.points,
+			"candidate_pair": s.best,
+			"best_distance":  distance,
+			"depth":          depth,
+		},
+		Message:   fmt.Sprintf("New closest pair (%d,%d)-(%d,%d) with distance %d", a.X, a.Y, b.X, b.Y, distance),
+		Timestamp: time.Now(),
+	})
+	s.stepNumber++
+}
+
+// GenerateInstance generates the points Execute runs on when given no input
+func (cp *ClosestPair) GenerateInstance(parameters map[string]interface{}) interface{} {
+	numPoints := 16
+	if n, ok := parameters["num_points"].(int); ok {
+		numPoints = n
+	}
+
+	return generators.Points(generators.NewRand(parameters), numPoints, 100)
+}
+
+// ValidateParameters validates the input parameters
+func (cp *ClosestPair) ValidateParameters(parameters map[string]interface{}) error {
+	if numPoints, ok := parameters["num_points"].(int); ok {
+		if numPoints < 2 || numPoints > 100 {
+			return fmt.Errorf("num_points must be between 2 and 100")
+		}
+	}
+	return nil
+}
+
+// SelfTest verifies the divide-and-conquer result against a brute-force scan
+func (cp *ClosestPair) SelfTest() error {
+	output, err := cp.Execute(context.Background(), nil, map[string]interface{}{"num_points": 40, "seed": 7}, func(types.ExecutionStep) {})
+	if err != nil {
+		return fmt.Errorf("execution failed: %v", err)
+	}
+
+	result := output.(map[string]interface{})
+	points := result["points"].([]generators.Point)
+
+	expected := math.MaxInt
+	for i := 0; i < len(points); i++ {
+		for j := i + 1; j < len(points); j++ {
+			if d := manhattan(points[i], points[j]); d < expected {
+				expected = d
+			}
+		}
+	}
+
+	if result["distance"] != expected {
+		return fmt.Errorf("expected distance %d, got %v", expected, result["distance"])
+	}
+
+	return nil
+}
+
+// manhattan returns the Manhattan distance between two points
+func manhattan(a, b generators.Point) int {
+	return generators.Abs(a.X-b.X) + generators.Abs(a.Y-b.Y)
+}
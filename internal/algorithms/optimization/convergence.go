@@ -0,0 +1,105 @@
+package optimization
+
+import (
+	"algorthmia/internal/types"
+	"fmt"
+	"time"
+)
+
+// Stop reasons reported by iterative optimizers
+const (
+	stopReasonConverged    = "converged"
+	stopReasonIterationCap = "iteration_cap"
+	stopReasonFrozen       = "frozen"
+)
+
+// maxNoImproveParameter describes the shared early-stopping parameter for
+// iterative optimizers
+func maxNoImproveParameter() types.Parameter {
+	return types.Parameter{
+		Name:        "max_no_improve",
+		Type:        "int",
+		Description: "Stop early once the best solution has not improved for this many iterations (0 disables early stopping)",
+		Default:     0,
+		Min:         types.IntPtr(0),
+		Max:         types.IntPtr(10000),
+		Required:    false,
+	}
+}
+
+// validateMaxNoImprove validates the shared early-stopping parameter
+func validateMaxNoImprove(parameters map[string]interface{}) error {
+	if maxNoImprove, ok := parameters["max_no_improve"].(int); ok {
+		if maxNoImprove < 0 || maxNoImprove > 10000 {
+			return fmt.Errorf("max_no_improve must be between 0 and 10000")
+		}
+	}
+	return nil
+}
+
+// convergenceTracker detects when an iterative optimizer has stopped making
+// progress on its best solution
+type convergenceTracker struct {
+	maxNoImprove     int
+	maximize         bool
+	best             float64
+	hasBest          bool
+	sinceImprovement int
+}
+
+// newConvergenceTracker creates a tracker from the max_no_improve parameter.
+// maximize selects whether larger or smaller objective values are better
+func newConvergenceTracker(parameters map[string]interface{}, maximize bool) *convergenceTracker {
+	maxNoImprove := 0
+	if m, ok := parameters["max_no_improve"].(int); ok {
+		maxNoImprove = m
+	}
+
+	return &convergenceTracker{
+		maxNoImprove: maxNoImprove,
+		maximize:     maximize,
+	}
+}
+
+// observe records the best objective value after an iteration and reports
+// whether it improved on the previous best
+func (c *convergenceTracker) observe(value float64) bool {
+	improved := !c.hasBest || (c.maximize && value > c.best) || (!c.maximize && value < c.best)
+	if improved {
+		c.best = value
+		c.hasBest = true
+		c.sinceImprovement = 0
+	} else {
+		c.sinceImprovement++
+	}
+	return improved
+}
+
+// converged reports whether early stopping is enabled and the best value has
+// not improved for max_no_improve iterations
+func (c *convergenceTracker) converged() bool {
+	return c.maxNoImprove > 0 && c.sinceImprovement >= c.maxNoImprove
+}
+
+// stopReason describes why the optimizer stopped
+func (c *convergenceTracker) stopReason() string {
+	if c.converged() {
+		return stopReasonConverged
+	}
+	return stopReasonIterationCap
+}
+
+// emitConverged sends the converged step shared by all iterative optimizers
+func (c *convergenceTracker) emitConverged(stepCallback func(types.ExecutionStep), stepNumber, iteration int, data map[string]interface{}) {
+	data["iteration"] = iteration
+	data["best_value"] = c.best
+	data["max_no_improve"] = c.maxNoImprove
+
+	stepCallback(types.ExecutionStep{
+		StepNumber: stepNumber,
+		Action:     "converged",
+		Data:       data,
+		Message:    fmt.Sprintf("Converged at iteration %d: no improvement for %d iterations", iteration, c.maxNoImprove),
+		Timestamp:  time.Now(),
+	})
+}
@@ -0,0 +1,244 @@
+package optimization
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ConvexHull implements the Graham scan convex hull algorithm
+type ConvexHull struct {
+	metadata types.Algorithm
+}
+
+// NewConvexHull creates a new ConvexHull instance
+func NewConvexHull() *ConvexHull {
+	return &ConvexHull{
+		metadata: types.Algorithm{
+			ID:          "graham_scan",
+			Name:        "Convex Hull (Graham Scan)",
+			Category:    types.CategoryOptimization,
+			Description: "Sorts points by polar angle around the lowest point, then walks them with a stack, popping any point that would make a non-left turn so only the convex hull remains.",
+			BigO:        "Time: O(n log n), Space: O(n)",
+			Parameters: []types.Parameter{
+				{
+					Name:        "num_points",
+					Type:        "int",
+					Description: "Number of points to generate",
+					Default:     20,
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(100),
+					Required:    true,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible point generation",
+					Default:     nil,
+					Required:    false,
+				},
+			},
+			InputType:  types.InputPointList,
+			RelatedIDs: []string{"closest_pair"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (ch *ConvexHull) GetMetadata() types.Algorithm {
+	return ch.metadata
+}
+
+// Execute runs the Graham scan algorithm
+func (ch *ConvexHull) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	numPoints := 20
+	if n, ok := parameters["num_points"].(int); ok {
+		numPoints = n
+	}
+
+	// Use a copy of the supplied points, or generate them
+	var points []generators.Point
+	if input != nil {
+		inputPoints, ok := input.([]generators.Point)
+		if !ok {
+			return nil, fmt.Errorf("invalid input type, expected a list of points")
+		}
+		if err := generators.ValidatePoints(inputPoints, numPoints); err != nil {
+			return nil, err
+		}
+		points = append([]generators.Point{}, inputPoints...)
+	} else {
+		points = ch.GenerateInstance(parameters).([]generators.Point)
+	}
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"points":     points,
+			"num_points": numPoints,
+		},
+		Message:   fmt.Sprintf("Starting Graham Scan over %d points", numPoints),
+		Timestamp: time.Now(),
+	})
+
+	// The lowest point (leftmost on ties) is always on the hull
+	pivot := 0
+	for i, p := range points {
+		if p.Y < points[pivot].Y || (p.Y == points[pivot].Y && p.X < points[pivot].X) {
+			pivot = i
+		}
+	}
+	points[0], points[pivot] = points[pivot], points[0]
+	anchor := points[0]
+
+	// Sort the remaining points by polar angle around the anchor, nearest first on ties
+	rest := points[1:]
+	sort.Slice(rest, func(i, j int) bool {
+		turn := cross(anchor, rest[i], rest[j])
+		if turn == 0 {
+			return manhattan(anchor, rest[i]) < manhattan(anchor, rest[j])
+		}
+		return turn > 0
+	})
+
+	stepCallback(types.ExecutionStep{
+		StepNumber: 1,
+		Action:     "sort_by_angle",
+		Data: map[string]interface{}{
+			"points": points,
+			"anchor": anchor,
+		},
+		Message:   fmt.Sprintf("Sorted points by polar angle around (%d,%d)", anchor.X, anchor.Y),
+		Timestamp: time.Now(),
+	})
+
+	stack := []generators.Point{}
+	stepNumber := 2
+
+	for i, p := range points {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		// Remove points that would make a clockwise or collinear turn
+		for len(stack) >= 2 && cross(stack[len(stack)-2], stack[len(stack)-1], p) <= 0 {
+			popped := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "pop_concave",
+				Data: map[string]interface{}{
+					"points":  points,
+					"stack":   stack,
+					"popped":  popped,
+					"current": p,
+					"index":   i,
+				},
+				Message:   fmt.Sprintf("Popped (%d,%d): turn towards (%d,%d) is not a left turn", popped.X, popped.Y, p.X, p.Y),
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+		}
+
+		stack = append(stack, p)
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "push_point",
+			Data: map[string]interface{}{
+				"points":  points,
+				"stack":   stack,
+				"current": p,
+				"index":   i,
+			},
+			Message:   fmt.Sprintf("Pushed (%d,%d) onto the hull stack", p.X, p.Y),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+	}
+
+	stepCallback(types.ExecutionStep{
+		StepNumber: stepNumber,
+		Action:     "hull_complete",
+		Data: map[string]interface{}{
+			"points": points,
+			"stack":  stack,
+			"hull":   stack,
+		},
+		Message:   fmt.Sprintf("Convex hull complete with %d vertices", len(stack)),
+		Timestamp: time.Now(),
+	})
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data: map[string]interface{}{
+			"points": points,
+			"hull":   stack,
+		},
+		Message:   "Graham Scan completed",
+		Timestamp: time.Now(),
+	})
+
+	return map[string]interface{}{
+		"hull":       stack,
+		"hull_size":  len(stack),
+		"points":     points,
+		"num_points": numPoints,
+	}, nil
+}
+
+// GenerateInstance generates the points Execute runs on when given no input
+func (ch *ConvexHull) GenerateInstance(parameters map[string]interface{}) interface{} {
+	numPoints := 20
+	if n, ok := parameters["num_points"].(int); ok {
+		numPoints = n
+	}
+
+	return generators.Points(generators.NewRand(parameters), numPoints, 100)
+}
+
+// ValidateParameters validates the input parameters
+func (ch *ConvexHull) ValidateParameters(parameters map[string]interface{}) error {
+	if numPoints, ok := parameters["num_points"].(int); ok {
+		if numPoints < 3 || numPoints > 100 {
+			return fmt.Errorf("num_points must be between 3 and 100")
+		}
+	}
+	return nil
+}
+
+// SelfTest verifies that every generated point lies inside or on the returned hull
+func (ch *ConvexHull) SelfTest() error {
+	output, err := ch.Execute(context.Background(), nil, map[string]interface{}{"num_points": 40, "seed": 7}, func(types.ExecutionStep) {})
+	if err != nil {
+		return fmt.Errorf("execution failed: %v", err)
+	}
+
+	result := output.(map[string]interface{})
+	hull := result["hull"].([]generators.Point)
+	if len(hull) < 3 {
+		return fmt.Errorf("expected at least 3 hull vertices, got %d", len(hull))
+	}
+
+	for _, p := range result["points"].([]generators.Point) {
+		for i := range hull {
+			if cross(hull[i], hull[(i+1)%len(hull)], p) < 0 {
+				return fmt.Errorf("point (%d,%d) lies outside the hull", p.X, p.Y)
+			}
+		}
+	}
+
+	return nil
+}
+
+// cross returns the z-component of (b - a) × (c - a); positive for a counter-clockwise turn
+func cross(a, b, c generators.Point) int {
+	return (b.X-a.X)*(c.Y-a.Y) - (b.Y-a.Y)*(c.X-a.X)
+}
@@ -0,0 +1,377 @@
+package optimization
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"time"
+)
+
+// flowEdge is an edge of the network with the flow it carries
+type flowEdge struct {
+	From     int `json:"from"`
+	To       int `json:"to"`
+	Capacity int `json:"capacity"`
+	Flow     int `json:"flow"`
+}
+
+// FordFulkerson implements the Ford-Fulkerson maximum flow method with
+// depth-first search for augmenting paths
+type FordFulkerson struct {
+	metadata types.Algorithm
+}
+
+// NewFordFulkerson creates a new FordFulkerson instance
+func NewFordFulkerson() *FordFulkerson {
+	return &FordFulkerson{
+		metadata: types.Algorithm{
+			ID:          "ford_fulkerson",
+			Name:        "Ford-Fulkerson Maximum Flow",
+			Category:    types.CategoryOptimization,
+			Description: "Finds the largest flow from node 0, the source, to the last node, the sink, with each edge weight as its capacity. Depth-first search looks for a path in the residual graph, where an edge has room left or carries flow that can be sent back, and pushes its bottleneck along it. When no path remains the flow is maximal, and the nodes the search still reaches form the source side of a minimum cut.",
+			BigO:        "Time: O(E · f) for E edges and maximum flow f, Space: O(V²) for the residual matrix",
+			Parameters: []types.Parameter{
+				{
+					Name:        "graph_size",
+					Type:        "int",
+					Description: "Number of nodes in the network",
+					Default:     6,
+					Min:         types.IntPtr(4),
+					Max:         types.IntPtr(12),
+					Required:    true,
+				},
+				{
+					Name:        "max_capacity",
+					Type:        "int",
+					Description: "Largest capacity of a generated edge",
+					Default:     10,
+					Min:         types.IntPtr(1),
+					Max:         types.IntPtr(50),
+					Required:    false,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible network generation",
+					Default:     nil,
+					Required:    false,
+				},
+				generators.RepresentationParameter(),
+			},
+			InputType: types.InputEdgeList,
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (ff *FordFulkerson) GetMetadata() types.Algorithm {
+	return ff.metadata
+}
+
+// Execute augments along depth-first paths in the residual graph until the
+// sink can no longer be reached
+func (ff *FordFulkerson) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	graphSize := 6
+	if size, ok := parameters["graph_size"].(int); ok {
+		graphSize = size
+	}
+
+	var edges []generators.Edge
+	if input != nil {
+		inputEdges, ok := input.([]generators.Edge)
+		if !ok {
+			return nil, fmt.Errorf("invalid input type, expected a list of weighted edges")
+		}
+		if err := generators.ValidateEdges(inputEdges, graphSize); err != nil {
+			return nil, err
+		}
+		for _, e := range inputEdges {
+			if e.Weight < 0 {
+				return nil, fmt.Errorf("edge %d -> %d has negative capacity %d", e.From, e.To, e.Weight)
+			}
+		}
+		edges = inputEdges
+	} else {
+		edges = ff.GenerateInstance(parameters).([]generators.Edge)
+	}
+
+	source, sink := 0, graphSize-1
+
+	// capacity[u][v] sums the edges from u to v, and flow[u][v] is the net
+	// flow, with flow[v][u] = -flow[u][v], so residual room is the difference
+	capacity := make([][]int, graphSize)
+	flow := make([][]int, graphSize)
+	for i := range capacity {
+		capacity[i] = make([]int, graphSize)
+		flow[i] = make([]int, graphSize)
+	}
+	for _, e := range edges {
+		if e.From != e.To {
+			capacity[e.From][e.To] += e.Weight
+		}
+	}
+
+	residual := func() [][]int {
+		r := make([][]int, graphSize)
+		for u := range r {
+			r[u] = make([]int, graphSize)
+			for v := range r[u] {
+				r[u][v] = capacity[u][v] - flow[u][v]
+			}
+		}
+		return r
+	}
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: generators.WithEdgeMatrices(map[string]interface{}{
+			"edges":    edges,
+			"source":   source,
+			"sink":     sink,
+			"residual": residual(),
+		}, parameters, graphSize, edges, true),
+		Message:   fmt.Sprintf("Sending flow from node %d to node %d over %d edges", source, sink, len(edges)),
+		Timestamp: time.Now(),
+	})
+
+	maxFlow := 0
+	stepNumber := 1
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		path, visited := residualPath(capacity, flow, source, sink)
+		if path == nil {
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "find_augmenting_path",
+				Data: map[string]interface{}{
+					"path":     []int{},
+					"found":    false,
+					"visited":  visited,
+					"residual": residual(),
+				},
+				Message:   fmt.Sprintf("No path from %d to %d has room left; the flow is maximal", source, sink),
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+			break
+		}
+
+		// The path can carry no more than its tightest residual edge
+		bottleneck := -1
+		for i := 0; i+1 < len(path); i++ {
+			if room := capacity[path[i]][path[i+1]] - flow[path[i]][path[i+1]]; bottleneck < 0 || room < bottleneck {
+				bottleneck = room
+			}
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "find_augmenting_path",
+			Data: map[string]interface{}{
+				"path":       path,
+				"found":      true,
+				"bottleneck": bottleneck,
+				"visited":    visited,
+				"residual":   residual(),
+			},
+			Message:   fmt.Sprintf("Depth-first search found path %v with bottleneck %d", path, bottleneck),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+
+		for i := 0; i+1 < len(path); i++ {
+			flow[path[i]][path[i+1]] += bottleneck
+			flow[path[i+1]][path[i]] -= bottleneck
+		}
+		maxFlow += bottleneck
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "augment",
+			Data: map[string]interface{}{
+				"path":       path,
+				"bottleneck": bottleneck,
+				"flow_value": maxFlow,
+				"residual":   residual(),
+				"edge_flows": edgeFlows(edges, capacity, flow),
+			},
+			Message:   fmt.Sprintf("Pushed %d along %v; total flow is %d", bottleneck, path, maxFlow),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+	}
+
+	// The last search reached exactly the source side of a minimum cut
+	_, visited := residualPath(capacity, flow, source, sink)
+	sourceSide := []int{}
+	for node, reached := range visited {
+		if reached {
+			sourceSide = append(sourceSide, node)
+		}
+	}
+
+	result := map[string]interface{}{
+		"edges":       edges,
+		"source":      source,
+		"sink":        sink,
+		"max_flow":    maxFlow,
+		"edge_flows":  edgeFlows(edges, capacity, flow),
+		"source_side": sourceSide,
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data:       result,
+		Message:    fmt.Sprintf("Maximum flow from %d to %d is %d", source, sink, maxFlow),
+		Timestamp:  time.Now(),
+	})
+
+	return result, nil
+}
+
+// residualPath searches depth first, lowest node first, for a path from
+// source to sink with room on every edge. It returns nil when there is
+// none, along with the nodes the search reached
+func residualPath(capacity, flow [][]int, source, sink int) ([]int, []bool) {
+	visited := make([]bool, len(capacity))
+	path := []int{}
+
+	var visit func(u int) bool
+	visit = func(u int) bool {
+		visited[u] = true
+		path = append(path, u)
+		if u == sink {
+			return true
+		}
+		for v := range capacity[u] {
+			if !visited[v] && capacity[u][v]-flow[u][v] > 0 && visit(v) {
+				return true
+			}
+		}
+		path = path[:len(path)-1]
+		return false
+	}
+
+	if !visit(source) {
+		return nil, visited
+	}
+	return path, visited
+}
+
+// edgeFlows splits the net flow between each pair of nodes across the
+// edges joining them, filling each edge in turn
+func edgeFlows(edges []generators.Edge, capacity, flow [][]int) []flowEdge {
+	left := make([][]int, len(flow))
+	for u := range flow {
+		left[u] = make([]int, len(flow))
+		for v := range flow[u] {
+			if flow[u][v] > 0 && capacity[u][v] > 0 {
+				left[u][v] = flow[u][v]
+			}
+		}
+	}
+
+	flows := make([]flowEdge, len(edges))
+	for i, e := range edges {
+		flows[i] = flowEdge{From: e.From, To: e.To, Capacity: e.Weight}
+		if e.From == e.To {
+			continue
+		}
+		carried := left[e.From][e.To]
+		if carried > e.Weight {
+			carried = e.Weight
+		}
+		flows[i].Flow = carried
+		left[e.From][e.To] -= carried
+	}
+
+	return flows
+}
+
+// GenerateInstance generates the network Execute runs on when given no input
+func (ff *FordFulkerson) GenerateInstance(parameters map[string]interface{}) interface{} {
+	graphSize := 6
+	if size, ok := parameters["graph_size"].(int); ok {
+		graphSize = size
+	}
+
+	maxCapacity := 10
+	if c, ok := parameters["max_capacity"].(int); ok {
+		maxCapacity = c
+	}
+
+	// The chain from node 0 keeps the sink reachable from the source
+	return generators.WeightedEdges(generators.NewRand(parameters), graphSize, 1, maxCapacity)
+}
+
+// ValidateParameters validates the input parameters
+func (ff *FordFulkerson) ValidateParameters(parameters map[string]interface{}) error {
+	if size, ok := parameters["graph_size"].(int); ok {
+		if size < 4 || size > 12 {
+			return fmt.Errorf("graph_size must be between 4 and 12")
+		}
+	}
+
+	if maxCapacity, ok := parameters["max_capacity"].(int); ok {
+		if maxCapacity < 1 || maxCapacity > 50 {
+			return fmt.Errorf("max_capacity must be between 1 and 50")
+		}
+	}
+
+	return generators.ValidateRepresentation(parameters)
+}
+
+// SelfTest checks that every edge flow respects its capacity, flow is
+// conserved at inner nodes, and the maximum flow equals the capacity of
+// the cut it reports
+func (ff *FordFulkerson) SelfTest() error {
+	for seed := 1; seed <= 10; seed++ {
+		output, err := ff.Execute(context.Background(), nil, map[string]interface{}{"graph_size": 8, "seed": seed}, func(types.ExecutionStep) {})
+		if err != nil {
+			return fmt.Errorf("execution failed: %v", err)
+		}
+
+		result := output.(map[string]interface{})
+		maxFlow := result["max_flow"].(int)
+
+		inSource := make([]bool, 8)
+		for _, node := range result["source_side"].([]int) {
+			inSource[node] = true
+		}
+		if !inSource[0] || inSource[7] {
+			return fmt.Errorf("seed %d: cut does not separate source from sink", seed)
+		}
+
+		net := make([]int, 8)
+		cut := 0
+		for _, e := range result["edge_flows"].([]flowEdge) {
+			if e.Flow < 0 || e.Flow > e.Capacity {
+				return fmt.Errorf("seed %d: edge %d -> %d carries %d over capacity %d", seed, e.From, e.To, e.Flow, e.Capacity)
+			}
+			net[e.From] -= e.Flow
+			net[e.To] += e.Flow
+			if inSource[e.From] && !inSource[e.To] {
+				cut += e.Capacity
+			}
+		}
+
+		for node := 1; node < 7; node++ {
+			if net[node] != 0 {
+				return fmt.Errorf("seed %d: node %d gains %d flow", seed, node, net[node])
+			}
+		}
+		if net[7] != maxFlow || cut != maxFlow {
+			return fmt.Errorf("seed %d: flow %d reaches the sink as %d, and the cut holds %d", seed, maxFlow, net[7], cut)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,368 @@
+package optimization
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// GeneticAlgorithm implements a genetic algorithm solving a 0/1 knapsack
+// instance with bit-string chromosomes
+type GeneticAlgorithm struct {
+	metadata types.Algorithm
+}
+
+// NewGeneticAlgorithm creates a new GeneticAlgorithm instance
+func NewGeneticAlgorithm() *GeneticAlgorithm {
+	return &GeneticAlgorithm{
+		metadata: types.Algorithm{
+			ID:          "genetic_algorithm",
+			Name:        "Genetic Algorithm",
+			Category:    types.CategoryOptimization,
+			Description: "Evolves a population of bit-string knapsack selections through fitness evaluation, tournament selection, single-point crossover and random mutation. Each gene says whether one item is packed, so the chromosome length is the number of items.",
+			BigO:        "Time: O(g · p · n) for g generations of p individuals with n genes, Space: O(p · n)",
+			Parameters: []types.Parameter{
+				{
+					Name:        "population_size",
+					Type:        "int",
+					Description: "Number of individuals in each generation",
+					Default:     20,
+					Min:         types.IntPtr(4),
+					Max:         types.IntPtr(100),
+					Required:    true,
+				},
+				{
+					Name:        "chromosome_length",
+					Type:        "int",
+					Description: "Number of genes per chromosome, one per knapsack item",
+					Default:     12,
+					Min:         types.IntPtr(4),
+					Max:         types.IntPtr(30),
+					Required:    false,
+				},
+				{
+					Name:        "mutation_rate",
+					Type:        "float",
+					Description: "Probability of flipping each gene, between 0 and 1",
+					Default:     0.05,
+					Required:    false,
+				},
+				{
+					Name:        "generations",
+					Type:        "int",
+					Description: "Maximum number of generations to evolve",
+					Default:     30,
+					Min:         types.IntPtr(1),
+					Max:         types.IntPtr(200),
+					Required:    true,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible items and evolution",
+					Default:     nil,
+					Required:    false,
+				},
+				maxNoImproveParameter(),
+			},
+			RelatedIDs: []string{"hill_climbing"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (ga *GeneticAlgorithm) GetMetadata() types.Algorithm {
+	return ga.metadata
+}
+
+// knapsackItem is a candidate item for the knapsack
+type knapsackItem struct {
+	Weight int `json:"weight"`
+	Value  int `json:"value"`
+}
+
+// Execute runs the genetic algorithm
+func (ga *GeneticAlgorithm) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	populationSize := 20
+	if p, ok := parameters["population_size"].(int); ok {
+		populationSize = p
+	}
+
+	chromosomeLength := 12
+	if c, ok := parameters["chromosome_length"].(int); ok {
+		chromosomeLength = c
+	}
+
+	mutationRate := 0.05
+	if m, ok := parameters["mutation_rate"].(float64); ok {
+		mutationRate = m
+	}
+
+	generations := 30
+	if g, ok := parameters["generations"].(int); ok {
+		generations = g
+	}
+
+	rng := generators.NewRand(parameters)
+	tracker := newConvergenceTracker(parameters, true)
+
+	// Generate the knapsack instance; capacity fits roughly half the items
+	items := make([]knapsackItem, chromosomeLength)
+	totalWeight := 0
+	for i := range items {
+		items[i] = knapsackItem{Weight: rng.Intn(20) + 1, Value: rng.Intn(50) + 1}
+		totalWeight += items[i].Weight
+	}
+	capacity := totalWeight / 2
+
+	population := make([][]int, populationSize)
+	for i := range population {
+		population[i] = make([]int, chromosomeLength)
+		for j := range population[i] {
+			population[i][j] = rng.Intn(2)
+		}
+	}
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"items":         items,
+			"capacity":      capacity,
+			"population":    population,
+			"mutation_rate": mutationRate,
+		},
+		Message:   fmt.Sprintf("Starting Genetic Algorithm with %d individuals over %d items", populationSize, chromosomeLength),
+		Timestamp: time.Now(),
+	})
+
+	var best []int
+	bestFitness := -1
+	fitnessHistory := []int{}
+	averageHistory := []float64{}
+	stepNumber := 1
+	generation := 0
+
+	for generation = 0; generation < generations; generation++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		// Evaluate the fitness of every individual
+		fitness := make([]int, populationSize)
+		totalFitness := 0
+		for i, individual := range population {
+			fitness[i] = knapsackFitness(individual, items, capacity)
+			totalFitness += fitness[i]
+			if fitness[i] > bestFitness {
+				bestFitness = fitness[i]
+				best = append([]int{}, individual...)
+			}
+		}
+		averageFitness := float64(totalFitness) / float64(populationSize)
+		fitnessHistory = append(fitnessHistory, bestFitness)
+		averageHistory = append(averageHistory, averageFitness)
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "evaluate_fitness",
+			Data: map[string]interface{}{
+				"generation":      generation,
+				"population":      population,
+				"fitness":         fitness,
+				"best_individual": best,
+				"best_fitness":    bestFitness,
+				"average_fitness": averageFitness,
+				"fitness_history": fitnessHistory,
+				"average_history": averageHistory,
+			},
+			Message:   fmt.Sprintf("Generation %d: best fitness %d, average %.1f", generation, bestFitness, averageFitness),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+
+		tracker.observe(float64(bestFitness))
+		if tracker.converged() {
+			tracker.emitConverged(stepCallback, stepNumber, generation, map[string]interface{}{
+				"best_individual": best,
+				"best_fitness":    bestFitness,
+				"fitness_history": fitnessHistory,
+			})
+			stepNumber++
+			break
+		}
+
+		// The last generation is only evaluated
+		if generation == generations-1 {
+			break
+		}
+
+		// Select parents by binary tournament
+		parents := make([]int, populationSize)
+		for i := range parents {
+			a, b := rng.Intn(populationSize), rng.Intn(populationSize)
+			if fitness[b] > fitness[a] {
+				a = b
+			}
+			parents[i] = a
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "selection",
+			Data: map[string]interface{}{
+				"generation": generation,
+				"population": population,
+				"fitness":    fitness,
+				"selected":   parents,
+			},
+			Message:   fmt.Sprintf("Selected %d parents by tournament", len(parents)),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+
+		// Recombine pairs of parents at a random crossover point, keeping the
+		// best individual so far (elitism)
+		next := [][]int{append([]int{}, best...)}
+		crossoverPoints := []int{}
+		for i := 0; len(next) < populationSize; i += 2 {
+			mother := population[parents[i%populationSize]]
+			father := population[parents[(i+1)%populationSize]]
+			point := rng.Intn(chromosomeLength-1) + 1
+			crossoverPoints = append(crossoverPoints, point)
+
+			child := append(append([]int{}, mother[:point]...), father[point:]...)
+			next = append(next, child)
+			if len(next) < populationSize {
+				sibling := append(append([]int{}, father[:point]...), mother[point:]...)
+				next = append(next, sibling)
+			}
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "crossover",
+			Data: map[string]interface{}{
+				"generation":       generation,
+				"population":       next,
+				"crossover_points": crossoverPoints,
+			},
+			Message:   fmt.Sprintf("Produced %d offspring by single-point crossover", len(next)-1),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+
+		// Mutate offspring gene by gene, sparing the elite individual
+		mutations := mutate(rng, next[1:], mutationRate)
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "mutation",
+			Data: map[string]interface{}{
+				"generation": generation,
+				"population": next,
+				"mutations":  mutations,
+			},
+			Message:   fmt.Sprintf("Applied %d mutations", len(mutations)),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+
+		population = next
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data: map[string]interface{}{
+			"best_individual": best,
+			"best_fitness":    bestFitness,
+			"fitness_history": fitnessHistory,
+			"average_history": averageHistory,
+		},
+		Message:   fmt.Sprintf("Genetic Algorithm completed with best fitness %d", bestFitness),
+		Timestamp: time.Now(),
+	})
+
+	return map[string]interface{}{
+		"best_individual": best,
+		"best_fitness":    bestFitness,
+		"best_weight":     knapsackWeight(best, items),
+		"fitness_history": fitnessHistory,
+		"average_history": averageHistory,
+		"items":           items,
+		"capacity":        capacity,
+		"stop_reason":     tracker.stopReason(),
+	}, nil
+}
+
+// ValidateParameters validates the input parameters
+func (ga *GeneticAlgorithm) ValidateParameters(parameters map[string]interface{}) error {
+	if populationSize, ok := parameters["population_size"].(int); ok {
+		if populationSize < 4 || populationSize > 100 {
+			return fmt.Errorf("population_size must be between 4 and 100")
+		}
+	}
+
+	if chromosomeLength, ok := parameters["chromosome_length"].(int); ok {
+		if chromosomeLength < 4 || chromosomeLength > 30 {
+			return fmt.Errorf("chromosome_length must be between 4 and 30")
+		}
+	}
+
+	if mutationRate, ok := parameters["mutation_rate"].(float64); ok {
+		if mutationRate < 0 || mutationRate > 1 {
+			return fmt.Errorf("mutation_rate must be between 0 and 1")
+		}
+	}
+
+	if generations, ok := parameters["generations"].(int); ok {
+		if generations < 1 || generations > 200 {
+			return fmt.Errorf("generations must be between 1 and 200")
+		}
+	}
+
+	return validateMaxNoImprove(parameters)
+}
+
+// mutate flips each gene with the given probability, returning the
+// [individual, gene] positions that were flipped
+func mutate(rng *rand.Rand, population [][]int, rate float64) [][]int {
+	mutations := [][]int{}
+	for i, individual := range population {
+		for j := range individual {
+			if rng.Float64() < rate {
+				individual[j] = 1 - individual[j]
+				mutations = append(mutations, []int{i + 1, j})
+			}
+		}
+	}
+	return mutations
+}
+
+// knapsackWeight returns the total weight of the selected items
+func knapsackWeight(individual []int, items []knapsackItem) int {
+	weight := 0
+	for i, gene := range individual {
+		weight += gene * items[i].Weight
+	}
+	return weight
+}
+
+// knapsackFitness returns the total value of the selected items, or 0 if
+// they exceed the capacity
+func knapsackFitness(individual []int, items []knapsackItem, capacity int) int {
+	if knapsackWeight(individual, items) > capacity {
+		return 0
+	}
+
+	value := 0
+	for i, gene := range individual {
+		value += gene * items[i].Value
+	}
+	return value
+}
@@ -0,0 +1,291 @@
+package optimization
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"time"
+)
+
+// HillClimbing implements steepest-ascent hill climbing with random restarts
+// on a traveling salesman instance
+type HillClimbing struct {
+	metadata types.Algorithm
+}
+
+// NewHillClimbing creates a new HillClimbing instance
+func NewHillClimbing() *HillClimbing {
+	return &HillClimbing{
+		metadata: types.Algorithm{
+			ID:          "hill_climbing",
+			Name:        "Hill Climbing",
+			Category:    types.CategoryOptimization,
+			Description: "Repeatedly moves a traveling salesman tour to its best neighbouring tour (swapping two cities) until no neighbour is shorter, then optionally restarts from a random tour to escape local optima.",
+			BigO:        "Time: O(r · k · n²) for r restarts of k moves over n cities, Space: O(n²)",
+			Parameters: []types.Parameter{
+				{
+					Name:        "num_cities",
+					Type:        "int",
+					Description: "Number of cities in the tour",
+					Default:     10,
+					Min:         types.IntPtr(4),
+					Max:         types.IntPtr(20),
+					Required:    true,
+				},
+				{
+					Name:        "restarts",
+					Type:        "int",
+					Description: "Number of random restarts after the first climb",
+					Default:     3,
+					Min:         types.IntPtr(0),
+					Max:         types.IntPtr(20),
+					Required:    false,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible cities and starting tours",
+					Default:     nil,
+					Required:    false,
+				},
+				maxNoImproveParameter(),
+			},
+			InputType:  types.InputPointList,
+			RelatedIDs: []string{"held_karp_tsp", "genetic_algorithm"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (hc *HillClimbing) GetMetadata() types.Algorithm {
+	return hc.metadata
+}
+
+// Execute runs hill climbing with random restarts
+func (hc *HillClimbing) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	numCities := 10
+	if n, ok := parameters["num_cities"].(int); ok {
+		numCities = n
+	}
+
+	restarts := 3
+	if r, ok := parameters["restarts"].(int); ok {
+		restarts = r
+	}
+
+	// The cities are drawn even when supplied, so the random restarts that
+	// follow are the same as in the run the cities were generated for
+	rng := generators.NewRand(parameters)
+	cities := generators.Points(rng, numCities, 100)
+	if input != nil {
+		inputCities, ok := input.([]generators.Point)
+		if !ok {
+			return nil, fmt.Errorf("invalid input type, expected a list of points")
+		}
+		if err := generators.ValidatePoints(inputCities, numCities); err != nil {
+			return nil, err
+		}
+		cities = inputCities
+	}
+	distances := cityDistances(cities)
+
+	// Early stopping counts restarts that fail to improve the overall best tour
+	tracker := newConvergenceTracker(parameters, false)
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"cities":   cities,
+			"restarts": restarts,
+		},
+		Message:   fmt.Sprintf("Starting Hill Climbing over %d cities with %d restarts", numCities, restarts),
+		Timestamp: time.Now(),
+	})
+
+	var bestTour []int
+	bestLength := 0.0
+	localOptima := []float64{}
+	firstOptimum := 0.0
+	stepNumber := 1
+	climbs := 0
+
+	for climb := 0; climb <= restarts; climb++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		current := randomTour(rng, numCities)
+		currentLength := tourLength(current, distances)
+
+		if climb > 0 {
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "restart",
+				Data: map[string]interface{}{
+					"cities":       cities,
+					"climb":        climb,
+					"current_tour": current,
+					"current_cost": currentLength,
+					"best_tour":    bestTour,
+					"best_cost":    bestLength,
+				},
+				Message:   fmt.Sprintf("Restart %d from a random tour of length %.2f", climb, currentLength),
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+		}
+
+		for {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			// Evaluate every tour reachable by swapping two cities (city 0 stays fixed)
+			neighborTour := []int(nil)
+			neighborLength := currentLength
+			evaluated := 0
+			for i := 1; i < numCities; i++ {
+				for j := i + 1; j < numCities; j++ {
+					current[i], current[j] = current[j], current[i]
+					if length := tourLength(current, distances); length < neighborLength {
+						neighborLength = length
+						neighborTour = append([]int{}, current...)
+					}
+					current[i], current[j] = current[j], current[i]
+					evaluated++
+				}
+			}
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "evaluate_neighbors",
+				Data: map[string]interface{}{
+					"cities":              cities,
+					"climb":               climb,
+					"current_tour":        current,
+					"current_cost":        currentLength,
+					"neighbors_evaluated": evaluated,
+					"best_neighbor_cost":  neighborLength,
+					"best_tour":           bestTour,
+					"best_cost":           bestLength,
+				},
+				Message:   fmt.Sprintf("Evaluated %d neighbours of a tour of length %.2f", evaluated, currentLength),
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+
+			if neighborTour == nil {
+				break
+			}
+
+			current, currentLength = neighborTour, neighborLength
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "move_to_best",
+				Data: map[string]interface{}{
+					"cities":       cities,
+					"climb":        climb,
+					"current_tour": current,
+					"current_cost": currentLength,
+					"best_tour":    bestTour,
+					"best_cost":    bestLength,
+				},
+				Message:   fmt.Sprintf("Moved to the best neighbour with length %.2f", currentLength),
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+		}
+
+		localOptima = append(localOptima, currentLength)
+		if climb == 0 {
+			firstOptimum = currentLength
+		}
+		if bestTour == nil || currentLength < bestLength {
+			bestTour, bestLength = current, currentLength
+		}
+		climbs++
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "local_optimum",
+			Data: map[string]interface{}{
+				"cities":       cities,
+				"climb":        climb,
+				"current_tour": current,
+				"current_cost": currentLength,
+				"best_tour":    bestTour,
+				"best_cost":    bestLength,
+				"local_optima": localOptima,
+			},
+			Message:   fmt.Sprintf("Reached a local optimum of length %.2f", currentLength),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+
+		tracker.observe(bestLength)
+		if climb < restarts && tracker.converged() {
+			tracker.emitConverged(stepCallback, stepNumber, climb, map[string]interface{}{
+				"cities":    cities,
+				"best_tour": bestTour,
+				"best_cost": bestLength,
+			})
+			stepNumber++
+			break
+		}
+	}
+
+	escaped := bestLength < firstOptimum
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data: map[string]interface{}{
+			"cities":       cities,
+			"best_tour":    bestTour,
+			"best_cost":    bestLength,
+			"local_optima": localOptima,
+		},
+		Message:   fmt.Sprintf("Hill Climbing completed with best tour length %.2f after %d climbs", bestLength, climbs),
+		Timestamp: time.Now(),
+	})
+
+	return map[string]interface{}{
+		"best_tour":            bestTour,
+		"best_length":          bestLength,
+		"cities":               cities,
+		"local_optima":         localOptima,
+		"climbs":               climbs,
+		"escaped_local_optima": escaped,
+		"stop_reason":          tracker.stopReason(),
+	}, nil
+}
+
+// GenerateInstance generates the cities Execute runs on when given no input
+func (hc *HillClimbing) GenerateInstance(parameters map[string]interface{}) interface{} {
+	numCities := 10
+	if n, ok := parameters["num_cities"].(int); ok {
+		numCities = n
+	}
+
+	return generators.Points(generators.NewRand(parameters), numCities, 100)
+}
+
+// ValidateParameters validates the input parameters
+func (hc *HillClimbing) ValidateParameters(parameters map[string]interface{}) error {
+	if numCities, ok := parameters["num_cities"].(int); ok {
+		if numCities < 4 || numCities > 20 {
+			return fmt.Errorf("num_cities must be between 4 and 20")
+		}
+	}
+
+	if restarts, ok := parameters["restarts"].(int); ok {
+		if restarts < 0 || restarts > 20 {
+			return fmt.Errorf("restarts must be between 0 and 20")
+		}
+	}
+
+	return validateMaxNoImprove(parameters)
+}
@@ -0,0 +1,322 @@
+package optimization
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	// minAnnealingTemperature is the temperature at which the tour freezes
+	minAnnealingTemperature = 0.01
+	// maxAnnealingIterations caps slow cooling schedules
+	maxAnnealingIterations = 2000
+)
+
+// SimulatedAnnealing implements simulated annealing on a traveling salesman
+// instance
+type SimulatedAnnealing struct {
+	metadata types.Algorithm
+}
+
+// NewSimulatedAnnealing creates a new SimulatedAnnealing instance
+func NewSimulatedAnnealing() *SimulatedAnnealing {
+	return &SimulatedAnnealing{
+		metadata: types.Algorithm{
+			ID:          "simulated_annealing",
+			Name:        "Simulated Annealing",
+			Category:    types.CategoryOptimization,
+			Description: "Improves a traveling salesman tour by proposing random swaps of two cities. A shorter tour is always accepted; a longer one is accepted with probability e^(-Δ/T), so at high temperature the search wanders out of local optima and, as the temperature is multiplied by the cooling rate each iteration, it settles into plain descent. The shortest tour seen is kept.",
+			BigO:        fmt.Sprintf("Time: O(k · n) for k iterations over n cities, at most %d, Space: O(n²)", maxAnnealingIterations),
+			Parameters: []types.Parameter{
+				{
+					Name:        "num_cities",
+					Type:        "int",
+					Description: "Number of cities in the tour",
+					Default:     8,
+					Min:         types.IntPtr(4),
+					Max:         types.IntPtr(12),
+					Required:    true,
+				},
+				{
+					Name:        "initial_temperature",
+					Type:        "float",
+					Description: "Starting temperature, between 1 and 1000",
+					Default:     100.0,
+					Required:    false,
+				},
+				{
+					Name:        "cooling_rate",
+					Type:        "float",
+					Description: "Factor the temperature is multiplied by each iteration, above 0 and below 1",
+					Default:     0.95,
+					Required:    false,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible cities, starting tour and acceptance draws",
+					Default:     nil,
+					Required:    false,
+				},
+				maxNoImproveParameter(),
+			},
+			InputType:  types.InputPointList,
+			RelatedIDs: []string{"hill_climbing", "held_karp_tsp"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (sa *SimulatedAnnealing) GetMetadata() types.Algorithm {
+	return sa.metadata
+}
+
+// Execute anneals a random tour until it freezes, converges or reaches the
+// iteration cap
+func (sa *SimulatedAnnealing) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	numCities := 8
+	if n, ok := parameters["num_cities"].(int); ok {
+		numCities = n
+	}
+
+	temperature := 100.0
+	if t, ok := parameters["initial_temperature"].(float64); ok {
+		temperature = t
+	}
+
+	coolingRate := 0.95
+	if c, ok := parameters["cooling_rate"].(float64); ok {
+		coolingRate = c
+	}
+
+	// The cities are drawn even when supplied, so the tour and acceptance
+	// draws that follow are the same as in the run they were generated for
+	rng := generators.NewRand(parameters)
+	cities := generators.Points(rng, numCities, 100)
+	if input != nil {
+		inputCities, ok := input.([]generators.Point)
+		if !ok {
+			return nil, fmt.Errorf("invalid input type, expected a list of points")
+		}
+		if err := generators.ValidatePoints(inputCities, numCities); err != nil {
+			return nil, err
+		}
+		cities = inputCities
+	}
+	distances := cityDistances(cities)
+
+	current := randomTour(rng, numCities)
+	currentLength := tourLength(current, distances)
+	bestTour, bestLength := append([]int{}, current...), currentLength
+
+	tracker := newConvergenceTracker(parameters, false)
+	tracker.observe(bestLength)
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"cities":       cities,
+			"current_tour": current,
+			"current_cost": currentLength,
+			"temperature":  temperature,
+			"cooling_rate": coolingRate,
+		},
+		Message:   fmt.Sprintf("Annealing a random tour of length %.2f over %d cities from temperature %.4g", currentLength, numCities, temperature),
+		Timestamp: time.Now(),
+	})
+
+	stepNumber := 1
+	iterations, acceptedMoves := 0, 0
+	stopReason := stopReasonIterationCap
+
+	for iterations < maxAnnealingIterations {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if temperature < minAnnealingTemperature {
+			stopReason = stopReasonFrozen
+			break
+		}
+		iterations++
+
+		// Swap two cities other than city 0, which anchors the tour
+		i := 1 + rng.Intn(numCities-1)
+		j := 1 + rng.Intn(numCities-2)
+		if j >= i {
+			j++
+		}
+
+		proposed := append([]int{}, current...)
+		proposed[i], proposed[j] = proposed[j], proposed[i]
+		proposedLength := tourLength(proposed, distances)
+		delta := proposedLength - currentLength
+
+		probability := 1.0
+		if delta > 0 {
+			probability = math.Exp(-delta / temperature)
+		}
+		accepted := delta <= 0 || rng.Float64() < probability
+
+		message := fmt.Sprintf("Swapping tour positions %d and %d shortens the tour to %.2f; accepted", i, j, proposedLength)
+		switch {
+		case delta > 0 && accepted:
+			message = fmt.Sprintf("Swapping tour positions %d and %d lengthens the tour by %.2f; accepted with probability %.3f", i, j, delta, probability)
+		case delta > 0:
+			message = fmt.Sprintf("Swapping tour positions %d and %d lengthens the tour by %.2f; rejected, having had probability %.3f", i, j, delta, probability)
+		case delta == 0:
+			message = fmt.Sprintf("Swapping tour positions %d and %d keeps the length at %.2f; accepted", i, j, proposedLength)
+		}
+
+		if accepted {
+			current, currentLength = proposed, proposedLength
+			acceptedMoves++
+			if currentLength < bestLength {
+				bestTour, bestLength = append([]int{}, current...), currentLength
+			}
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "anneal_step",
+			Data: map[string]interface{}{
+				"cities":                 cities,
+				"iteration":              iterations,
+				"temperature":            temperature,
+				"proposed_swap":          []int{i, j},
+				"proposed_tour":          proposed,
+				"proposed_cost":          proposedLength,
+				"delta":                  delta,
+				"acceptance_probability": probability,
+				"accepted":               accepted,
+				"current_tour":           current,
+				"current_cost":           currentLength,
+				"best_tour":              bestTour,
+				"best_cost":              bestLength,
+			},
+			Message:   message,
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+
+		temperature *= coolingRate
+
+		tracker.observe(bestLength)
+		if tracker.converged() {
+			tracker.emitConverged(stepCallback, stepNumber, iterations, map[string]interface{}{
+				"cities":    cities,
+				"best_tour": bestTour,
+				"best_cost": bestLength,
+			})
+			stepNumber++
+			stopReason = tracker.stopReason()
+			break
+		}
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data: map[string]interface{}{
+			"cities":      cities,
+			"best_tour":   bestTour,
+			"best_cost":   bestLength,
+			"temperature": temperature,
+		},
+		Message:   fmt.Sprintf("Simulated Annealing completed with best tour length %.2f after %d iterations, %d moves accepted", bestLength, iterations, acceptedMoves),
+		Timestamp: time.Now(),
+	})
+
+	return map[string]interface{}{
+		"best_tour":         bestTour,
+		"best_length":       bestLength,
+		"cities":            cities,
+		"iterations":        iterations,
+		"accepted_moves":    acceptedMoves,
+		"final_temperature": temperature,
+		"stop_reason":       stopReason,
+	}, nil
+}
+
+// GenerateInstance generates the cities Execute runs on when given no input
+func (sa *SimulatedAnnealing) GenerateInstance(parameters map[string]interface{}) interface{} {
+	numCities := 8
+	if n, ok := parameters["num_cities"].(int); ok {
+		numCities = n
+	}
+
+	return generators.Points(generators.NewRand(parameters), numCities, 100)
+}
+
+// ValidateParameters validates the input parameters
+func (sa *SimulatedAnnealing) ValidateParameters(parameters map[string]interface{}) error {
+	if numCities, ok := parameters["num_cities"].(int); ok {
+		if numCities < 4 || numCities > 12 {
+			return fmt.Errorf("num_cities must be between 4 and 12")
+		}
+	}
+
+	if temperature, exists := parameters["initial_temperature"]; exists {
+		t, ok := temperature.(float64)
+		if !ok || t < 1 || t > 1000 {
+			return fmt.Errorf("initial_temperature must be a number between 1 and 1000")
+		}
+	}
+
+	if coolingRate, exists := parameters["cooling_rate"]; exists {
+		c, ok := coolingRate.(float64)
+		if !ok || c <= 0 || c >= 1 {
+			return fmt.Errorf("cooling_rate must be a number above 0 and below 1")
+		}
+	}
+
+	return validateMaxNoImprove(parameters)
+}
+
+// SelfTest checks that the same seed gives the same run, and that the best
+// tour visits every city once and is as short as reported
+func (sa *SimulatedAnnealing) SelfTest() error {
+	for seed := 1; seed <= 5; seed++ {
+		parameters := map[string]interface{}{"num_cities": 8, "seed": seed}
+
+		output, err := sa.Execute(context.Background(), nil, parameters, func(types.ExecutionStep) {})
+		if err != nil {
+			return fmt.Errorf("execution failed: %v", err)
+		}
+		again, _ := sa.Execute(context.Background(), nil, parameters, func(types.ExecutionStep) {})
+
+		result := output.(map[string]interface{})
+		bestLength := result["best_length"].(float64)
+		if other := again.(map[string]interface{})["best_length"].(float64); other != bestLength {
+			return fmt.Errorf("seed %d: runs found tours of length %.2f and %.2f", seed, bestLength, other)
+		}
+
+		tour := result["best_tour"].([]int)
+		seen := make([]bool, 8)
+		for _, city := range tour {
+			if seen[city] {
+				return fmt.Errorf("seed %d: city %d is visited twice", seed, city)
+			}
+			seen[city] = true
+		}
+		if len(tour) != 8 || tour[0] != 0 {
+			return fmt.Errorf("seed %d: tour %v does not start at city 0 and visit all 8 cities", seed, tour)
+		}
+
+		distances := cityDistances(result["cities"].([]generators.Point))
+		if math.Abs(tourLength(tour, distances)-bestLength) > 1e-9 {
+			return fmt.Errorf("seed %d: tour measures %.2f, reported %.2f", seed, tourLength(tour, distances), bestLength)
+		}
+		if result["stop_reason"].(string) != stopReasonFrozen {
+			return fmt.Errorf("seed %d: expected the default schedule to freeze, stopped on %s", seed, result["stop_reason"])
+		}
+	}
+
+	return nil
+}
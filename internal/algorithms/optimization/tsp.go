@@ -0,0 +1,43 @@
+package optimization
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"math"
+	"math/rand"
+)
+
+// cityDistances returns the pairwise Euclidean distances between cities
+func cityDistances(cities []generators.Point) [][]float64 {
+	distances := make([][]float64, len(cities))
+	for i := range cities {
+		distances[i] = make([]float64, len(cities))
+		for j := range cities {
+			dx := float64(cities[i].X - cities[j].X)
+			dy := float64(cities[i].Y - cities[j].Y)
+			distances[i][j] = math.Sqrt(dx*dx + dy*dy)
+		}
+	}
+	return distances
+}
+
+// tourLength returns the length of the closed tour visiting cities in order
+func tourLength(tour []int, distances [][]float64) float64 {
+	length := 0.0
+	for i := range tour {
+		length += distances[tour[i]][tour[(i+1)%len(tour)]]
+	}
+	return length
+}
+
+// randomTour returns a random permutation of the cities starting at city 0
+func randomTour(rng *rand.Rand, numCities int) []int {
+	tour := make([]int, numCities)
+	for i := range tour {
+		tour[i] = i
+	}
+	rest := tour[1:]
+	rng.Shuffle(len(rest), func(i, j int) {
+		rest[i], rest[j] = rest[j], rest[i]
+	})
+	return tour
+}
@@ -0,0 +1,380 @@
+package pathfinding
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"container/heap"
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// Dijkstra implements Dijkstra's single-source shortest paths on a directed
+// graph with non-negative edge weights
+type Dijkstra struct {
+	metadata types.Algorithm
+}
+
+// NewDijkstra creates a new Dijkstra instance
+func NewDijkstra() *Dijkstra {
+	return &Dijkstra{
+		metadata: types.Algorithm{
+			ID:          "dijkstra",
+			Name:        "Dijkstra's Shortest Path",
+			Category:    types.CategoryPathfinding,
+			Description: "Finds the shortest path between two nodes of a weighted directed graph. The unsettled node with the smallest tentative distance is repeatedly taken from a priority queue and settled, and its outgoing edges are relaxed. The path is rebuilt by following each node's predecessor back from the target.",
+			BigO:        "Time: O((V + E) log V), Space: O(V + E) where V is vertices and E is edges",
+			Parameters: []types.Parameter{
+				{
+					Name:        "graph_size",
+					Type:        "int",
+					Description: "Number of nodes in the graph",
+					Default:     6,
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(20),
+					Required:    true,
+				},
+				{
+					Name:        "start_node",
+					Type:        "int",
+					Description: "Node the path starts from",
+					Default:     0,
+					Min:         types.IntPtr(0),
+					Max:         types.IntPtr(19),
+					Required:    true,
+				},
+				{
+					Name:        "target_node",
+					Type:        "int",
+					Description: "Node the path leads to (default: the last node)",
+					Default:     nil,
+					Min:         types.IntPtr(0),
+					Max:         types.IntPtr(19),
+					Required:    false,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible graph generation",
+					Default:     nil,
+					Required:    false,
+				},
+				generators.RepresentationParameter(),
+			},
+			InputType:  types.InputEdgeList,
+			RelatedIDs: []string{"bellman_ford", "astar"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (d *Dijkstra) GetMetadata() types.Algorithm {
+	return d.metadata
+}
+
+// queuedNode is a tentative distance waiting in Dijkstra's priority queue
+type queuedNode struct {
+	Node     int     `json:"node"`
+	Distance float64 `json:"distance"`
+}
+
+// distanceQueue is a min-heap of tentative distances
+type distanceQueue []queuedNode
+
+func (q distanceQueue) Len() int { return len(q) }
+func (q distanceQueue) Less(i, j int) bool {
+	if q[i].Distance != q[j].Distance {
+		return q[i].Distance < q[j].Distance
+	}
+	return q[i].Node < q[j].Node
+}
+func (q distanceQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *distanceQueue) Push(x interface{}) { *q = append(*q, x.(queuedNode)) }
+func (q *distanceQueue) Pop() interface{} {
+	old := *q
+	node := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return node
+}
+
+// Execute runs Dijkstra's algorithm from start_node until target_node is settled
+func (d *Dijkstra) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	graphSize := 6
+	if size, ok := parameters["graph_size"].(int); ok {
+		graphSize = size
+	}
+
+	// Use the supplied edge list, or generate a graph with positive weights
+	var edges []generators.Edge
+	if input != nil {
+		inputEdges, ok := input.([]generators.Edge)
+		if !ok {
+			return nil, fmt.Errorf("invalid input type, expected a list of weighted edges")
+		}
+		edges = inputEdges
+		graphSize = 0
+		for _, e := range edges {
+			if e.From < 0 || e.To < 0 {
+				return nil, fmt.Errorf("edge %d -> %d has a negative node index", e.From, e.To)
+			}
+			if e.From >= 20 || e.To >= 20 {
+				return nil, fmt.Errorf("edge %d -> %d is outside the largest graph of 20 nodes", e.From, e.To)
+			}
+			if e.Weight < 0 {
+				return nil, fmt.Errorf("edge %d -> %d has negative weight %d", e.From, e.To, e.Weight)
+			}
+			if e.From >= graphSize {
+				graphSize = e.From + 1
+			}
+			if e.To >= graphSize {
+				graphSize = e.To + 1
+			}
+		}
+	} else {
+		edges = d.GenerateInstance(parameters).([]generators.Edge)
+	}
+
+	startNode := 0
+	if start, ok := parameters["start_node"].(int); ok {
+		startNode = start
+	}
+
+	targetNode := graphSize - 1
+	if target, ok := parameters["target_node"].(int); ok {
+		targetNode = target
+	}
+
+	if startNode < 0 || startNode >= graphSize {
+		return nil, fmt.Errorf("start_node %d is outside the graph of %d nodes", startNode, graphSize)
+	}
+	if targetNode < 0 || targetNode >= graphSize {
+		return nil, fmt.Errorf("target_node %d is outside the graph of %d nodes", targetNode, graphSize)
+	}
+
+	adjacency := make([][]generators.Edge, graphSize)
+	for _, e := range edges {
+		adjacency[e.From] = append(adjacency[e.From], e)
+	}
+
+	dist := make([]float64, graphSize)
+	pred := make([]int, graphSize)
+	for i := range dist {
+		dist[i] = math.Inf(1)
+		pred[i] = -1
+	}
+	dist[startNode] = 0
+
+	settled := make([]bool, graphSize)
+	visitOrder := []int{}
+	queue := &distanceQueue{{Node: startNode, Distance: 0}}
+
+	// queueContents lists the live queue entries in priority order, leaving
+	// out entries superseded by a shorter distance or already settled
+	queueContents := func() []queuedNode {
+		contents := []queuedNode{}
+		for _, entry := range *queue {
+			if !settled[entry.Node] && entry.Distance == dist[entry.Node] {
+				contents = append(contents, entry)
+			}
+		}
+		sort.Slice(contents, func(i, j int) bool {
+			return distanceQueue(contents).Less(i, j)
+		})
+		return contents
+	}
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: generators.WithEdgeMatrices(map[string]interface{}{
+			"edges":       edges,
+			"start_node":  startNode,
+			"target_node": targetNode,
+			"distances":   distanceTable(dist),
+			"queue":       queueContents(),
+		}, parameters, graphSize, edges, true),
+		Message:   fmt.Sprintf("Starting Dijkstra from node %d towards node %d over %d edges", startNode, targetNode, len(edges)),
+		Timestamp: time.Now(),
+	})
+
+	stepNumber := 1
+
+	for queue.Len() > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		entry := heap.Pop(queue).(queuedNode)
+		if settled[entry.Node] || entry.Distance > dist[entry.Node] {
+			continue // Stale entry superseded by a shorter distance
+		}
+		current := entry.Node
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "extract_min",
+			Data: map[string]interface{}{
+				"node":      current,
+				"distance":  entry.Distance,
+				"distances": distanceTable(dist),
+				"queue":     queueContents(),
+			},
+			Message:   fmt.Sprintf("Node %d has the smallest tentative distance, %g", current, entry.Distance),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+
+		settled[current] = true
+		visitOrder = append(visitOrder, current)
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "settle_node",
+			Data: map[string]interface{}{
+				"node":         current,
+				"distance":     dist[current],
+				"distances":    distanceTable(dist),
+				"predecessors": pred,
+				"settled":      visitOrder,
+				"queue":        queueContents(),
+			},
+			Message:   fmt.Sprintf("Distance to node %d is final at %g", current, dist[current]),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+
+		if current == targetNode {
+			break
+		}
+
+		for _, e := range adjacency[current] {
+			if settled[e.To] || dist[current]+float64(e.Weight) >= dist[e.To] {
+				continue
+			}
+
+			dist[e.To] = dist[current] + float64(e.Weight)
+			pred[e.To] = current
+			heap.Push(queue, queuedNode{Node: e.To, Distance: dist[e.To]})
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "relax_edge",
+				Data: map[string]interface{}{
+					"edge":         e,
+					"distances":    distanceTable(dist),
+					"predecessors": pred,
+					"queue":        queueContents(),
+				},
+				Message:   fmt.Sprintf("Distance to node %d lowered to %g via %d", e.To, dist[e.To], current),
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+		}
+	}
+
+	result := map[string]interface{}{
+		"edges":        edges,
+		"distances":    distanceTable(dist),
+		"predecessors": pred,
+		"visit_order":  visitOrder,
+		"found":        settled[targetNode],
+		"path":         []int{},
+		"distance":     nil,
+	}
+
+	message := fmt.Sprintf("Node %d is unreachable from node %d", targetNode, startNode)
+	if settled[targetNode] {
+		// Follow predecessors back from the target; the visit order is not the path
+		path := []int{}
+		for node := targetNode; node != -1; node = pred[node] {
+			path = append([]int{node}, path...)
+		}
+		result["path"] = path
+		result["distance"] = dist[targetNode]
+		message = fmt.Sprintf("Shortest path from %d to %d has length %g over %d edges", startNode, targetNode, dist[targetNode], len(path)-1)
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data:       result,
+		Message:    message,
+		Timestamp:  time.Now(),
+	})
+
+	return result, nil
+}
+
+// distanceTable converts distances into a JSON-safe form, using nil for
+// nodes not yet reached
+func distanceTable(dist []float64) []interface{} {
+	values := make([]interface{}, len(dist))
+	for i, v := range dist {
+		if !math.IsInf(v, 1) {
+			values[i] = v
+		}
+	}
+	return values
+}
+
+// GenerateInstance generates the graph Execute runs on when given no input
+func (d *Dijkstra) GenerateInstance(parameters map[string]interface{}) interface{} {
+	graphSize := 6
+	if size, ok := parameters["graph_size"].(int); ok {
+		graphSize = size
+	}
+
+	return generators.WeightedEdges(generators.NewRand(parameters), graphSize, 1, 9)
+}
+
+// ValidateParameters validates the input parameters
+func (d *Dijkstra) ValidateParameters(parameters map[string]interface{}) error {
+	graphSize := 6
+	if size, ok := parameters["graph_size"].(int); ok {
+		if size < 3 || size > 20 {
+			return fmt.Errorf("graph_size must be between 3 and 20")
+		}
+		graphSize = size
+	}
+
+	for _, name := range []string{"start_node", "target_node"} {
+		if node, ok := parameters[name].(int); ok {
+			if node < 0 || node >= graphSize {
+				return fmt.Errorf("%s must be between 0 and %d", name, graphSize-1)
+			}
+		}
+	}
+
+	return generators.ValidateRepresentation(parameters)
+}
+
+// SelfTest checks that the returned path follows predecessors rather than
+// the order in which nodes were settled
+func (d *Dijkstra) SelfTest() error {
+	// Node 1 is settled before node 2, but the shortest route to 3 is 0 -> 2 -> 3
+	edges := []generators.Edge{
+		{From: 0, To: 1, Weight: 1},
+		{From: 0, To: 2, Weight: 2},
+		{From: 1, To: 3, Weight: 5},
+		{From: 2, To: 3, Weight: 1},
+	}
+
+	output, err := d.Execute(context.Background(), edges, map[string]interface{}{"start_node": 0, "target_node": 3}, func(types.ExecutionStep) {})
+	if err != nil {
+		return fmt.Errorf("execution failed: %v", err)
+	}
+
+	result := output.(map[string]interface{})
+	path, _ := result["path"].([]int)
+	if fmt.Sprint(path) != "[0 2 3]" {
+		return fmt.Errorf("expected path [0 2 3], got %v", path)
+	}
+	if distance, _ := result["distance"].(float64); distance != 3 {
+		return fmt.Errorf("expected distance 3, got %v", result["distance"])
+	}
+
+	return nil
+}
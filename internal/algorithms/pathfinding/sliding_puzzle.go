@@ -0,0 +1,448 @@
+package pathfinding
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"container/heap"
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// maxPuzzleExpansions bounds the A* search so deep 15-puzzle scrambles
+// cannot run away
+const maxPuzzleExpansions = 5000
+
+// SlidingPuzzle solves the 8-puzzle or 15-puzzle with A* search
+type SlidingPuzzle struct {
+	metadata types.Algorithm
+}
+
+// NewSlidingPuzzle creates a new SlidingPuzzle instance
+func NewSlidingPuzzle() *SlidingPuzzle {
+	return &SlidingPuzzle{
+		metadata: types.Algorithm{
+			ID:          "sliding_puzzle",
+			Name:        "Sliding Puzzle (A*)",
+			Category:    types.CategoryPathfinding,
+			Description: "Solves the 8-puzzle or 15-puzzle with A* search over board states. States are expanded in order of moves made plus the Manhattan distance of every tile from its goal square, which never overestimates, so the first solution found is optimal.",
+			BigO:        "Time: O(b^d), Space: O(b^d) for branching factor b and solution depth d, much less in practice thanks to the heuristic",
+			Parameters: []types.Parameter{
+				{
+					Name:        "size",
+					Type:        "int",
+					Description: "Board width: 3 for the 8-puzzle, 4 for the 15-puzzle",
+					Default:     3,
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(4),
+					Required:    true,
+				},
+				{
+					Name:        "scramble",
+					Type:        "int",
+					Description: "Number of random moves applied to the solved board",
+					Default:     12,
+					Min:         types.IntPtr(1),
+					Max:         types.IntPtr(30),
+					Required:    true,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible scrambles",
+					Default:     nil,
+					Required:    false,
+				},
+			},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (sp *SlidingPuzzle) GetMetadata() types.Algorithm {
+	return sp.metadata
+}
+
+// puzzleMove names a move of the blank and its row and column offsets
+type puzzleMove struct {
+	name   string
+	dr, dc int
+}
+
+var puzzleMoves = []puzzleMove{
+	{"up", -1, 0},
+	{"down", 1, 0},
+	{"left", 0, -1},
+	{"right", 0, 1},
+}
+
+// puzzleState is a board reached by the search. Boards store tiles row by
+// row with 0 for the blank
+type puzzleState struct {
+	board  []int
+	blank  int
+	g, h   int
+	parent *puzzleState
+	move   string
+}
+
+// puzzleQueue is the A* open set, ordered by f = g + h and then by h
+type puzzleQueue []*puzzleState
+
+func (q puzzleQueue) Len() int { return len(q) }
+func (q puzzleQueue) Less(i, j int) bool {
+	fi, fj := q[i].g+q[i].h, q[j].g+q[j].h
+	if fi != fj {
+		return fi < fj
+	}
+	return q[i].h < q[j].h
+}
+func (q puzzleQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *puzzleQueue) Push(x interface{}) { *q = append(*q, x.(*puzzleState)) }
+func (q *puzzleQueue) Pop() interface{} {
+	old := *q
+	state := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return state
+}
+
+// Execute runs A* from a scrambled board to the solved board
+func (sp *SlidingPuzzle) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	size := 3
+	if s, ok := parameters["size"].(int); ok {
+		size = s
+	}
+
+	scramble := 12
+	if s, ok := parameters["scramble"].(int); ok {
+		scramble = s
+	}
+
+	var start []int
+	if input != nil {
+		board, ok := input.([]int)
+		if !ok {
+			return nil, fmt.Errorf("invalid input type, expected []int")
+		}
+		if err := checkBoard(board, size); err != nil {
+			return nil, err
+		}
+		start = board
+	} else {
+		start = scrambleBoard(generators.NewRand(parameters), size, scramble)
+	}
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"board":     start,
+			"goal":      solvedBoard(size),
+			"size":      size,
+			"heuristic": manhattan(start, size),
+		},
+		Message:   fmt.Sprintf("Solving a %dx%d sliding puzzle with A*", size, size),
+		Timestamp: time.Now(),
+	})
+
+	root := &puzzleState{board: start, blank: blankIndex(start), h: manhattan(start, size)}
+	open := &puzzleQueue{}
+	heap.Push(open, root)
+	best := map[string]*puzzleState{boardKey(start): root}
+	closed := map[string]bool{}
+	expanded := 0
+	stepNumber := 1
+
+	var solution *puzzleState
+	for open.Len() > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		current := heap.Pop(open).(*puzzleState)
+		key := boardKey(current.board)
+		if closed[key] {
+			continue
+		}
+		closed[key] = true
+		expanded++
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "expand_state",
+			Data: map[string]interface{}{
+				"board":     current.board,
+				"g":         current.g,
+				"h":         current.h,
+				"f":         current.g + current.h,
+				"open_size": open.Len(),
+				"expanded":  expanded,
+			},
+			Message:   fmt.Sprintf("Expanding a state %d moves in with heuristic %d (f = %d)", current.g, current.h, current.g+current.h),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+
+		if current.h == 0 {
+			solution = current
+			break
+		}
+		if expanded >= maxPuzzleExpansions {
+			return nil, fmt.Errorf("no solution found within %d expanded states", maxPuzzleExpansions)
+		}
+
+		row, col := current.blank/size, current.blank%size
+		for _, move := range puzzleMoves {
+			r, c := row+move.dr, col+move.dc
+			if r < 0 || r >= size || c < 0 || c >= size {
+				continue
+			}
+
+			board := append([]int{}, current.board...)
+			target := r*size + c
+			board[current.blank], board[target] = board[target], board[current.blank]
+			neighborKey := boardKey(board)
+
+			neighbor := &puzzleState{
+				board:  board,
+				blank:  target,
+				g:      current.g + 1,
+				h:      manhattan(board, size),
+				parent: current,
+				move:   move.name,
+			}
+
+			// Keep the neighbor only if it is the cheapest known route to its board
+			outcome := "added"
+			if closed[neighborKey] {
+				outcome = "closed"
+			} else if known, seen := best[neighborKey]; seen && known.g <= neighbor.g {
+				outcome = "not_better"
+			} else {
+				if seen {
+					outcome = "improved"
+				}
+				best[neighborKey] = neighbor
+				heap.Push(open, neighbor)
+			}
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "evaluate_neighbor",
+				Data: map[string]interface{}{
+					"board":   board,
+					"move":    move.name,
+					"g":       neighbor.g,
+					"h":       neighbor.h,
+					"f":       neighbor.g + neighbor.h,
+					"outcome": outcome,
+				},
+				Message:   fmt.Sprintf("Moving the blank %s gives heuristic %d (f = %d): %s", move.name, neighbor.h, neighbor.g+neighbor.h, outcome),
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+		}
+	}
+
+	if solution == nil {
+		return nil, fmt.Errorf("the board cannot be solved")
+	}
+
+	// Walk back from the goal to recover the moves
+	path := []*puzzleState{}
+	for state := solution; state != nil; state = state.parent {
+		path = append([]*puzzleState{state}, path...)
+	}
+
+	moves := []string{}
+	for i, state := range path[1:] {
+		moves = append(moves, state.move)
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "solution_path",
+			Data: map[string]interface{}{
+				"board":     state.board,
+				"move":      state.move,
+				"move_num":  i + 1,
+				"heuristic": state.h,
+				"moves":     moves,
+			},
+			Message:   fmt.Sprintf("Move %d: blank %s", i+1, state.move),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+	}
+
+	result := map[string]interface{}{
+		"start":           start,
+		"moves":           moves,
+		"solution_length": len(moves),
+		"states_expanded": expanded,
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data:       result,
+		Message:    fmt.Sprintf("Solved in %d moves after expanding %d states", len(moves), expanded),
+		Timestamp:  time.Now(),
+	})
+
+	return result, nil
+}
+
+// solvedBoard returns the goal board: tiles in order with the blank last
+func solvedBoard(size int) []int {
+	board := make([]int, size*size)
+	for i := range board[:len(board)-1] {
+		board[i] = i + 1
+	}
+	return board
+}
+
+// scrambleBoard applies random blank moves to the solved board, never
+// immediately undoing the previous move
+func scrambleBoard(rng *rand.Rand, size, moves int) []int {
+	board := solvedBoard(size)
+	blank := len(board) - 1
+	previous := -1
+
+	for i := 0; i < moves; i++ {
+		row, col := blank/size, blank%size
+		candidates := []int{}
+		for _, move := range puzzleMoves {
+			r, c := row+move.dr, col+move.dc
+			target := r*size + c
+			if r < 0 || r >= size || c < 0 || c >= size || target == previous {
+				continue
+			}
+			candidates = append(candidates, target)
+		}
+
+		target := candidates[rng.Intn(len(candidates))]
+		board[blank], board[target] = board[target], board[blank]
+		previous, blank = blank, target
+	}
+
+	return board
+}
+
+// manhattan sums the grid distance of every tile from its goal square
+func manhattan(board []int, size int) int {
+	distance := 0
+	for i, tile := range board {
+		if tile == 0 {
+			continue
+		}
+		goal := tile - 1
+		distance += generators.Abs(i/size-goal/size) + generators.Abs(i%size-goal%size)
+	}
+	return distance
+}
+
+// checkBoard verifies a supplied board holds each tile exactly once
+func checkBoard(board []int, size int) error {
+	if len(board) != size*size {
+		return fmt.Errorf("board must have %d tiles for size %d", size*size, size)
+	}
+
+	seen := make([]bool, len(board))
+	for _, tile := range board {
+		if tile < 0 || tile >= len(board) || seen[tile] {
+			return fmt.Errorf("board must contain each of 0 to %d exactly once", len(board)-1)
+		}
+		seen[tile] = true
+	}
+
+	// Half of all arrangements cannot reach the goal. Count inversions among
+	// the tiles; with an even width the blank's row also flips the parity
+	inversions := 0
+	for i := range board {
+		for j := i + 1; j < len(board); j++ {
+			if board[i] != 0 && board[j] != 0 && board[i] > board[j] {
+				inversions++
+			}
+		}
+	}
+	if size%2 == 0 {
+		inversions += size - blankIndex(board)/size
+		if inversions%2 == 0 {
+			return fmt.Errorf("board is not solvable")
+		}
+	} else if inversions%2 != 0 {
+		return fmt.Errorf("board is not solvable")
+	}
+
+	return nil
+}
+
+// blankIndex returns the position of the blank
+func blankIndex(board []int) int {
+	for i, tile := range board {
+		if tile == 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+// boardKey encodes a board as a map key
+func boardKey(board []int) string {
+	key := make([]byte, len(board))
+	for i, tile := range board {
+		key[i] = byte(tile)
+	}
+	return string(key)
+}
+
+// ValidateParameters validates the input parameters
+func (sp *SlidingPuzzle) ValidateParameters(parameters map[string]interface{}) error {
+	if size, ok := parameters["size"].(int); ok {
+		if size < 3 || size > 4 {
+			return fmt.Errorf("size must be 3 or 4")
+		}
+	}
+
+	if scramble, ok := parameters["scramble"].(int); ok {
+		if scramble < 1 || scramble > 30 {
+			return fmt.Errorf("scramble must be between 1 and 30")
+		}
+	}
+
+	return nil
+}
+
+// SelfTest solves a fixed scramble and checks the moves reach the goal
+func (sp *SlidingPuzzle) SelfTest() error {
+	// Two moves from solved: the blank goes down, then right
+	start := []int{1, 2, 3, 4, 0, 6, 7, 5, 8}
+	output, err := sp.Execute(context.Background(), append([]int{}, start...), map[string]interface{}{"size": 3}, func(types.ExecutionStep) {})
+	if err != nil {
+		return fmt.Errorf("execution failed: %v", err)
+	}
+
+	moves := output.(map[string]interface{})["moves"].([]string)
+	if len(moves) != 2 {
+		return fmt.Errorf("expected an optimal 2-move solution, got %v", moves)
+	}
+
+	board := start
+	blank := blankIndex(board)
+	for _, name := range moves {
+		for _, move := range puzzleMoves {
+			if move.name == name {
+				target := blank + move.dr*3 + move.dc
+				board[blank], board[target] = board[target], board[blank]
+				blank = target
+			}
+		}
+	}
+	if boardKey(board) != boardKey(solvedBoard(3)) {
+		return fmt.Errorf("moves %v do not solve the board", moves)
+	}
+
+	return nil
+}
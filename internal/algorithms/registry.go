@@ -1,15 +1,27 @@
 package algorithms
 
 import (
+	dp "algorthmia/internal/algorithms/dynamic_programming"
+	graphs "algorthmia/internal/algorithms/graphs_trees"
+	"algorthmia/internal/algorithms/greedy"
+	numbertheory "algorthmia/internal/algorithms/number_theory"
+	"algorthmia/internal/algorithms/optimization"
+	"algorthmia/internal/algorithms/pathfinding"
 	"algorthmia/internal/algorithms/searching"
 	"algorthmia/internal/algorithms/sorting"
+	"algorthmia/internal/algorithms/strings"
 	"algorthmia/internal/types"
+	"fmt"
+	"log"
+	"sort"
 	"sync"
 )
 
-// Registry manages all available algorithms
+// Registry manages all available algorithms. The catalog holds every known
+// algorithm; algorithms holds those the current filter exposes
 type Registry struct {
 	algorithms map[string]types.AlgorithmExecutor
+	catalog    map[string]types.AlgorithmExecutor
 	mutex      sync.RWMutex
 }
 
@@ -17,14 +29,83 @@ type Registry struct {
 func NewRegistry() *Registry {
 	registry := &Registry{
 		algorithms: make(map[string]types.AlgorithmExecutor),
+		catalog:    make(map[string]types.AlgorithmExecutor),
 	}
 
 	// Register all algorithms
 	registry.registerAlgorithms()
+	registry.checkRelatedIDs()
 
 	return registry
 }
 
+// Filter selects the algorithms a registry exposes. With a non-empty Allow
+// list only those IDs are exposed; IDs in Deny are never exposed
+type Filter struct {
+	Allow []string `json:"allow"`
+	Deny  []string `json:"deny"`
+}
+
+// IsEmpty reports whether the filter exposes every algorithm
+func (f Filter) IsEmpty() bool {
+	return len(f.Allow) == 0 && len(f.Deny) == 0
+}
+
+// permits reports whether the filter exposes the algorithm ID
+func (f Filter) permits(id string) bool {
+	for _, denied := range f.Deny {
+		if denied == id {
+			return false
+		}
+	}
+	if len(f.Allow) == 0 {
+		return true
+	}
+	for _, allowed := range f.Allow {
+		if allowed == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Reload rebuilds the registry from every known algorithm, keeping those the
+// filter permits, and swaps the new set in at once. Lookups in progress see
+// either the old or the new set. Executions already started keep their
+// algorithm even if it is no longer exposed. Filters naming unknown IDs are
+// rejected and leave the registry unchanged. It returns the exposed IDs
+func (r *Registry) Reload(filter Filter) ([]string, error) {
+	all := &Registry{algorithms: make(map[string]types.AlgorithmExecutor), catalog: make(map[string]types.AlgorithmExecutor)}
+	all.registerAlgorithms()
+
+	unknown := []string{}
+	for _, id := range append(append([]string{}, filter.Allow...), filter.Deny...) {
+		if _, exists := all.algorithms[id]; !exists {
+			unknown = append(unknown, id)
+		}
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("unknown algorithm IDs: %v", unknown)
+	}
+
+	algorithms := make(map[string]types.AlgorithmExecutor)
+	ids := []string{}
+	for id, algorithm := range all.algorithms {
+		if filter.permits(id) {
+			algorithms[id] = algorithm
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	r.mutex.Lock()
+	r.algorithms = algorithms
+	r.catalog = all.catalog
+	r.mutex.Unlock()
+
+	return ids, nil
+}
+
 // RegisterAlgorithm adds an algorithm to the registry
 func (r *Registry) RegisterAlgorithm(algorithm types.AlgorithmExecutor) {
 	r.mutex.Lock()
@@ -32,6 +113,7 @@ func (r *Registry) RegisterAlgorithm(algorithm types.AlgorithmExecutor) {
 
 	metadata := algorithm.GetMetadata()
 	r.algorithms[metadata.ID] = algorithm
+	r.catalog[metadata.ID] = algorithm
 }
 
 // GetAlgorithm retrieves an algorithm by ID
@@ -43,6 +125,16 @@ func (r *Registry) GetAlgorithm(id string) (types.AlgorithmExecutor, bool) {
 	return algorithm, exists
 }
 
+// GetKnownAlgorithm retrieves an algorithm by ID from the full catalog,
+// including algorithms the current filter does not expose
+func (r *Registry) GetKnownAlgorithm(id string) (types.AlgorithmExecutor, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	algorithm, exists := r.catalog[id]
+	return algorithm, exists
+}
+
 // GetAllAlgorithms returns all registered algorithms
 func (r *Registry) GetAllAlgorithms() []types.Algorithm {
 	r.mutex.RLock()
@@ -72,6 +164,42 @@ func (r *Registry) GetAlgorithmsByCategory(category types.AlgorithmCategory) []t
 	return algorithms
 }
 
+// RunSelfTests runs the self-check of every algorithm that provides one,
+// logging and returning the failures keyed by algorithm ID
+func (r *Registry) RunSelfTests() map[string]error {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	failures := make(map[string]error)
+	for id, algorithm := range r.algorithms {
+		tester, ok := algorithm.(types.SelfTester)
+		if !ok {
+			continue
+		}
+
+		if err := tester.SelfTest(); err != nil {
+			log.Printf("Self-test failed for %s: %v", id, err)
+			failures[id] = err
+		}
+	}
+
+	return failures
+}
+
+// checkRelatedIDs logs a warning for every related algorithm ID that is not registered
+func (r *Registry) checkRelatedIDs() {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	for id, algorithm := range r.algorithms {
+		for _, related := range algorithm.GetMetadata().RelatedIDs {
+			if _, exists := r.algorithms[related]; !exists {
+				log.Printf("Warning: algorithm %s lists unknown related algorithm %s", id, related)
+			}
+		}
+	}
+}
+
 // registerAlgorithms registers all available algorithms
 func (r *Registry) registerAlgorithms() {
 	// Register sorting algorithms
@@ -80,6 +208,13 @@ func (r *Registry) registerAlgorithms() {
 	r.RegisterAlgorithm(sorting.NewQuickSort())
 	r.RegisterAlgorithm(sorting.NewHeapSort())
 	r.RegisterAlgorithm(sorting.NewCountingSort())
+	r.RegisterAlgorithm(sorting.NewRadixSort())
+	r.RegisterAlgorithm(sorting.NewBucketSort())
+	r.RegisterAlgorithm(sorting.NewMSDRadixSort())
+	r.RegisterAlgorithm(sorting.NewCombSort())
+	r.RegisterAlgorithm(sorting.NewGnomeSort())
+	r.RegisterAlgorithm(sorting.NewTimSort())
+	r.RegisterAlgorithm(sorting.NewCycleSort())
 
 	// Register searching algorithms
 	r.RegisterAlgorithm(searching.NewLinearSearch())
@@ -87,6 +222,64 @@ func (r *Registry) registerAlgorithms() {
 	r.RegisterAlgorithm(searching.NewDFS())
 	r.RegisterAlgorithm(searching.NewBFS())
 	r.RegisterAlgorithm(searching.NewHashLookup())
+	r.RegisterAlgorithm(searching.NewMajorityVote())
+	r.RegisterAlgorithm(searching.NewStreamingMedian())
+	r.RegisterAlgorithm(searching.NewJumpSearch())
+	r.RegisterAlgorithm(searching.NewTernarySearch())
+
+	// Register graph and tree algorithms
+	r.RegisterAlgorithm(graphs.NewExpressionTree())
+	r.RegisterAlgorithm(graphs.NewBellmanFord())
+	r.RegisterAlgorithm(graphs.NewFloydWarshall())
+	r.RegisterAlgorithm(graphs.NewPrimMST())
+	r.RegisterAlgorithm(graphs.NewKruskalMST())
+	r.RegisterAlgorithm(graphs.NewTopologicalSort())
+	r.RegisterAlgorithm(graphs.NewUnionFind())
+	r.RegisterAlgorithm(graphs.NewBST())
+	r.RegisterAlgorithm(graphs.NewAVLTree())
+	r.RegisterAlgorithm(graphs.NewLRUCache())
+
+	// Register pathfinding algorithms
+	r.RegisterAlgorithm(pathfinding.NewAStar())
+	r.RegisterAlgorithm(pathfinding.NewSlidingPuzzle())
+	r.RegisterAlgorithm(pathfinding.NewDijkstra())
+
+	// Register string algorithms
+	r.RegisterAlgorithm(strings.NewShuntingYard())
+	r.RegisterAlgorithm(strings.NewNaiveMatch())
+	r.RegisterAlgorithm(strings.NewKMP())
+	r.RegisterAlgorithm(strings.NewRabinKarp())
+	r.RegisterAlgorithm(strings.NewBoyerMoore())
+	r.RegisterAlgorithm(strings.NewZAlgorithm())
+	r.RegisterAlgorithm(strings.NewTrie())
+	r.RegisterAlgorithm(strings.NewHuffman())
+
+	// Register number theory algorithms
+	r.RegisterAlgorithm(numbertheory.NewSieveOfEratosthenes())
+	r.RegisterAlgorithm(numbertheory.NewEuclideanGCD())
+	r.RegisterAlgorithm(numbertheory.NewModularExponentiation())
+	r.RegisterAlgorithm(numbertheory.NewMillerRabin())
+
+	// Register dynamic programming algorithms
+	r.RegisterAlgorithm(dp.NewHeldKarp())
+	r.RegisterAlgorithm(dp.NewDAGLongestPath())
+	r.RegisterAlgorithm(dp.NewKnapsack01())
+	r.RegisterAlgorithm(dp.NewLCS())
+	r.RegisterAlgorithm(dp.NewEditDistance())
+	r.RegisterAlgorithm(dp.NewCoinChange())
+	r.RegisterAlgorithm(dp.NewMaximumSubarray())
+
+	// Register greedy algorithms
+	r.RegisterAlgorithm(greedy.NewActivitySelection())
+	r.RegisterAlgorithm(greedy.NewFractionalKnapsack())
+
+	// Register optimization algorithms
+	r.RegisterAlgorithm(optimization.NewClosestPair())
+	r.RegisterAlgorithm(optimization.NewConvexHull())
+	r.RegisterAlgorithm(optimization.NewHillClimbing())
+	r.RegisterAlgorithm(optimization.NewSimulatedAnnealing())
+	r.RegisterAlgorithm(optimization.NewGeneticAlgorithm())
+	r.RegisterAlgorithm(optimization.NewFordFulkerson())
 
 	// More algorithms will be added in future iterations
 }
@@ -0,0 +1,13 @@
+package algorithms
+
+import "testing"
+
+// TestSelfTests runs every algorithm's self-check, as SELF_TEST_ON_STARTUP
+// does, so a broken algorithm fails the test suite rather than a deployment
+func TestSelfTests(t *testing.T) {
+	registry := NewRegistry()
+
+	for id, err := range registry.RunSelfTests() {
+		t.Errorf("%s: %v", id, err)
+	}
+}
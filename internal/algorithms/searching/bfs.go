@@ -1,7 +1,9 @@
 package searching
 
 import (
+	"algorthmia/internal/algorithms/generators"
 	"algorthmia/internal/types"
+	"context"
 	"fmt"
 	"time"
 )
@@ -26,8 +28,8 @@ func NewBFS() *BFS {
 					Type:        "int",
 					Description: "Number of nodes in the graph",
 					Default:     6,
-					Min:         intPtr(3),
-					Max:         intPtr(20),
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(20),
 					Required:    true,
 				},
 				{
@@ -35,20 +37,30 @@ func NewBFS() *BFS {
 					Type:        "int",
 					Description: "Starting node for BFS",
 					Default:     0,
-					Min:         intPtr(0),
-					Max:         intPtr(19),
+					Min:         types.IntPtr(0),
+					Max:         types.IntPtr(19),
 					Required:    true,
 				},
 				{
 					Name:        "target_node",
 					Type:        "int",
-					Description: "Target node to find",
-					Default:     5,
-					Min:         intPtr(0),
-					Max:         intPtr(19),
-					Required:    true,
+					Description: "Target node to find (default: the last node)",
+					Default:     nil,
+					Min:         types.IntPtr(0),
+					Max:         types.IntPtr(19),
+					Required:    false,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible graph generation",
+					Default:     nil,
+					Required:    false,
 				},
+				generators.RepresentationParameter(),
 			},
+			InputType:  types.InputAdjacencyList,
+			RelatedIDs: []string{"dfs"},
 		},
 	}
 }
@@ -59,7 +71,7 @@ func (bfs *BFS) GetMetadata() types.Algorithm {
 }
 
 // Execute runs the BFS algorithm
-func (bfs *BFS) Execute(input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+func (bfs *BFS) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
 	graphSize := 6
 	if size, ok := parameters["graph_size"].(int); ok {
 		graphSize = size
@@ -70,33 +82,60 @@ func (bfs *BFS) Execute(input interface{}, parameters map[string]interface{}, st
 		startNode = start
 	}
 
-	targetNode := 5
+	targetNode := graphSize - 1
 	if target, ok := parameters["target_node"].(int); ok {
 		targetNode = target
 	}
 
-	// Generate a simple graph
-	graph := generateGraph(graphSize)
+	// Use the supplied adjacency list, or generate a connected graph,
+	// reproducible when a seed is supplied
+	var graph [][]int
+	if input != nil {
+		inputGraph, ok := input.([][]int)
+		if !ok {
+			return nil, fmt.Errorf("invalid input type, expected an adjacency list")
+		}
+		if err := generators.ValidateAdjacency(inputGraph, graphSize); err != nil {
+			return nil, err
+		}
+		graph = inputGraph
+	} else {
+		graph = bfs.GenerateInstance(parameters).([][]int)
+	}
 
 	// Send initial state
 	stepCallback(types.ExecutionStep{
 		StepNumber: 0,
 		Action:     "initialize",
-		Data: map[string]interface{}{
+		Data: generators.WithAdjacencyMatrix(map[string]interface{}{
 			"graph":       graph,
 			"start_node":  startNode,
 			"target_node": targetNode,
-		},
+		}, parameters, graph),
 		Message:   fmt.Sprintf("Starting BFS from node %d to find node %d", startNode, targetNode),
 		Timestamp: time.Now(),
 	})
 
 	visited := make([]bool, graphSize)
 	queue := []int{startNode}
-	path := []int{}
+	visitOrder := []int{}
 	stepNumber := 1
 
+	// parent[v] is the node v was reached from, or -1 for the start and
+	// nodes not yet reached
+	parent := make([]int, graphSize)
+	for i := range parent {
+		parent[i] = -1
+	}
+
+	// Exploration cost, comparable across graph searches
+	edgesExamined := 0
+	maxFrontier := len(queue)
+
 	for len(queue) > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		current := queue[0]
 		queue = queue[1:]
 
@@ -105,7 +144,7 @@ func (bfs *BFS) Execute(input interface{}, parameters map[string]interface{}, st
 		}
 
 		visited[current] = true
-		path = append(path, current)
+		visitOrder = append(visitOrder, current)
 
 		stepCallback(types.ExecutionStep{
 			StepNumber: stepNumber,
@@ -115,7 +154,7 @@ func (bfs *BFS) Execute(input interface{}, parameters map[string]interface{}, st
 				"current":     current,
 				"visited":     visited,
 				"queue":       queue,
-				"path":        path,
+				"visit_order": visitOrder,
 				"target_node": targetNode,
 			},
 			Message:   fmt.Sprintf("Visiting node %d", current),
@@ -124,33 +163,62 @@ func (bfs *BFS) Execute(input interface{}, parameters map[string]interface{}, st
 		stepNumber++
 
 		if current == targetNode {
+			path := reconstructPath(parent, targetNode)
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "path_reconstruction",
+				Data: map[string]interface{}{
+					"graph":       graph,
+					"parent":      append([]int{}, parent...),
+					"path":        path,
+					"visit_order": visitOrder,
+				},
+				Message:   fmt.Sprintf("Followed parents back from node %d to node %d: %v", targetNode, startNode, path),
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+
 			stepCallback(types.ExecutionStep{
 				StepNumber: stepNumber,
 				Action:     "found",
 				Data: map[string]interface{}{
-					"graph":    graph,
-					"found_at": current,
-					"path":     path,
-					"visited":  visited,
+					"graph":       graph,
+					"found_at":    current,
+					"path":        path,
+					"visit_order": visitOrder,
+					"visited":     visited,
 				},
 				Message:   fmt.Sprintf("Target node %d found! Path: %v", targetNode, path),
 				Timestamp: time.Now(),
 			})
 
 			return map[string]interface{}{
-				"found":   true,
-				"target":  targetNode,
-				"path":    path,
-				"visited": visited,
+				"found":             true,
+				"target":            targetNode,
+				"path":              path,
+				"visit_order":       visitOrder,
+				"visited":           visited,
+				"nodes_visited":     len(visitOrder),
+				"edges_examined":    edgesExamined,
+				"max_frontier_size": maxFrontier,
 			}, nil
 		}
 
-		// Add unvisited neighbors to queue
+		// Add unvisited neighbors to queue. A node is dequeued first from the
+		// entry that discovered it, so its first parent lies on a shortest path
 		for _, neighbor := range graph[current] {
+			edgesExamined++
 			if !visited[neighbor] {
 				queue = append(queue, neighbor)
+				if parent[neighbor] == -1 {
+					parent[neighbor] = current
+				}
 			}
 		}
+		if len(queue) > maxFrontier {
+			maxFrontier = len(queue)
+		}
 
 		stepCallback(types.ExecutionStep{
 			StepNumber: stepNumber,
@@ -173,28 +241,68 @@ func (bfs *BFS) Execute(input interface{}, parameters map[string]interface{}, st
 		StepNumber: stepNumber,
 		Action:     "not_found",
 		Data: map[string]interface{}{
-			"graph":   graph,
-			"visited": visited,
-			"path":    path,
+			"graph":       graph,
+			"visited":     visited,
+			"visit_order": visitOrder,
 		},
 		Message:   fmt.Sprintf("Target node %d not found", targetNode),
 		Timestamp: time.Now(),
 	})
 
 	return map[string]interface{}{
-		"found":   false,
-		"target":  targetNode,
-		"path":    path,
-		"visited": visited,
+		"found":             false,
+		"target":            targetNode,
+		"path":              []int{},
+		"visit_order":       visitOrder,
+		"visited":           visited,
+		"nodes_visited":     len(visitOrder),
+		"edges_examined":    edgesExamined,
+		"max_frontier_size": maxFrontier,
 	}, nil
 }
 
+// reconstructPath walks parent links back from target and returns the path
+// from the start node, which has no parent, to target
+func reconstructPath(parent []int, target int) []int {
+	path := []int{}
+	for node := target; node != -1; node = parent[node] {
+		path = append([]int{node}, path...)
+	}
+	return path
+}
+
+// GenerateInstance generates the graph Execute runs on when given no input
+func (bfs *BFS) GenerateInstance(parameters map[string]interface{}) interface{} {
+	graphSize := 6
+	if size, ok := parameters["graph_size"].(int); ok {
+		graphSize = size
+	}
+
+	return generators.Graph(generators.NewRand(parameters), graphSize)
+}
+
 // ValidateParameters validates the input parameters
 func (bfs *BFS) ValidateParameters(parameters map[string]interface{}) error {
-	if graphSize, ok := parameters["graph_size"].(int); ok {
-		if graphSize < 3 || graphSize > 20 {
+	graphSize := 6
+	if size, ok := parameters["graph_size"].(int); ok {
+		if size < 3 || size > 20 {
 			return fmt.Errorf("graph_size must be between 3 and 20")
 		}
+		graphSize = size
 	}
-	return nil
+
+	for _, name := range []string{"start_node", "target_node"} {
+		if node, ok := parameters[name].(int); ok {
+			if node < 0 || node >= graphSize {
+				return fmt.Errorf("%s must be between 0 and %d", name, graphSize-1)
+			}
+		}
+	}
+
+	return generators.ValidateRepresentation(parameters)
+}
+
+// SelfTest verifies the BFS implementation against a known input
+func (bfs *BFS) SelfTest() error {
+	return selfTestGraphSearch(bfs)
 }
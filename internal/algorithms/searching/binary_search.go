@@ -1,7 +1,9 @@
 package searching
 
 import (
+	"algorthmia/internal/algorithms/generators"
 	"algorthmia/internal/types"
+	"context"
 	"fmt"
 	"sort"
 	"time"
@@ -27,10 +29,17 @@ func NewBinarySearch() *BinarySearch {
 					Type:        "int",
 					Description: "Size of the array to search",
 					Default:     10,
-					Min:         intPtr(3),
-					Max:         intPtr(100),
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(100),
 					Required:    true,
 				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible array generation",
+					Default:     nil,
+					Required:    false,
+				},
 				{
 					Name:        "target",
 					Type:        "int",
@@ -39,6 +48,7 @@ func NewBinarySearch() *BinarySearch {
 					Required:    true,
 				},
 			},
+			RelatedIDs: []string{"linear_search"},
 		},
 	}
 }
@@ -49,7 +59,7 @@ func (bs *BinarySearch) GetMetadata() types.Algorithm {
 }
 
 // Execute runs the binary search algorithm
-func (bs *BinarySearch) Execute(input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+func (bs *BinarySearch) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
 	// Generate array if not provided
 	var arr []int
 	if input != nil {
@@ -64,7 +74,7 @@ func (bs *BinarySearch) Execute(input interface{}, parameters map[string]interfa
 		if size, ok := parameters["array_size"].(int); ok {
 			arraySize = size
 		}
-		arr = generateRandomArray(arraySize)
+		arr = generators.Permutation(generators.NewRand(parameters), arraySize)
 	}
 
 	target := 5
@@ -91,6 +101,9 @@ func (bs *BinarySearch) Execute(input interface{}, parameters map[string]interfa
 	comparisons := 0
 
 	for left <= right {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		mid := left + (right-left)/2
 		comparisons++
 
@@ -198,3 +211,8 @@ func (bs *BinarySearch) ValidateParameters(parameters map[string]interface{}) er
 	}
 	return nil
 }
+
+// SelfTest verifies the binary search implementation against a known input
+func (bs *BinarySearch) SelfTest() error {
+	return selfTestArraySearch(bs)
+}
@@ -1,7 +1,9 @@
 package searching
 
 import (
+	"algorthmia/internal/algorithms/generators"
 	"algorthmia/internal/types"
+	"context"
 	"fmt"
 	"time"
 )
@@ -26,8 +28,8 @@ func NewDFS() *DFS {
 					Type:        "int",
 					Description: "Number of nodes in the graph",
 					Default:     6,
-					Min:         intPtr(3),
-					Max:         intPtr(20),
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(20),
 					Required:    true,
 				},
 				{
@@ -35,20 +37,31 @@ func NewDFS() *DFS {
 					Type:        "int",
 					Description: "Starting node for DFS",
 					Default:     0,
-					Min:         intPtr(0),
-					Max:         intPtr(19),
+					Min:         types.IntPtr(0),
+					Max:         types.IntPtr(19),
 					Required:    true,
 				},
 				{
 					Name:        "target_node",
 					Type:        "int",
-					Description: "Target node to find",
-					Default:     5,
-					Min:         intPtr(0),
-					Max:         intPtr(19),
-					Required:    true,
+					Description: "Target node to find (default: the last node)",
+					Default:     nil,
+					Min:         types.IntPtr(0),
+					Max:         types.IntPtr(19),
+					Required:    false,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible graph generation",
+					Default:     nil,
+					Required:    false,
 				},
+				generators.RepresentationParameter(),
+				generators.EmitStackParameter(),
 			},
+			InputType:  types.InputAdjacencyList,
+			RelatedIDs: []string{"bfs"},
 		},
 	}
 }
@@ -59,7 +72,7 @@ func (dfs *DFS) GetMetadata() types.Algorithm {
 }
 
 // Execute runs the DFS algorithm
-func (dfs *DFS) Execute(input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+func (dfs *DFS) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
 	graphSize := 6
 	if size, ok := parameters["graph_size"].(int); ok {
 		graphSize = size
@@ -70,33 +83,64 @@ func (dfs *DFS) Execute(input interface{}, parameters map[string]interface{}, st
 		startNode = start
 	}
 
-	targetNode := 5
+	targetNode := graphSize - 1
 	if target, ok := parameters["target_node"].(int); ok {
 		targetNode = target
 	}
 
-	// Generate a simple graph
-	graph := generateGraph(graphSize)
+	// Use the supplied adjacency list, or generate a connected graph,
+	// reproducible when a seed is supplied
+	var graph [][]int
+	if input != nil {
+		inputGraph, ok := input.([][]int)
+		if !ok {
+			return nil, fmt.Errorf("invalid input type, expected an adjacency list")
+		}
+		if err := generators.ValidateAdjacency(inputGraph, graphSize); err != nil {
+			return nil, err
+		}
+		graph = inputGraph
+	} else {
+		graph = dfs.GenerateInstance(parameters).([][]int)
+	}
 
 	// Send initial state
 	stepCallback(types.ExecutionStep{
 		StepNumber: 0,
 		Action:     "initialize",
-		Data: map[string]interface{}{
+		Data: generators.WithAdjacencyMatrix(map[string]interface{}{
 			"graph":       graph,
 			"start_node":  startNode,
 			"target_node": targetNode,
-		},
+		}, parameters, graph),
 		Message:   fmt.Sprintf("Starting DFS from node %d to find node %d", startNode, targetNode),
 		Timestamp: time.Now(),
 	})
 
 	visited := make([]bool, graphSize)
 	stack := []int{startNode}
-	path := []int{}
+	visitOrder := []int{}
 	stepNumber := 1
 
+	// parent[v] is the node v was reached from, or -1 for the start and
+	// nodes not yet reached
+	parent := make([]int, graphSize)
+	for i := range parent {
+		parent[i] = -1
+	}
+
+	// Exploration cost, comparable across graph searches
+	edgesExamined := 0
+	maxFrontier := len(stack)
+
+	// The recursion stack of the equivalent recursive DFS: the path from
+	// the start node to the node being explored
+	callStack := generators.NewDecisionStack(parameters)
+
 	for len(stack) > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		current := stack[len(stack)-1]
 		stack = stack[:len(stack)-1]
 
@@ -105,63 +149,96 @@ func (dfs *DFS) Execute(input interface{}, parameters map[string]interface{}, st
 		}
 
 		visited[current] = true
-		path = append(path, current)
+		visitOrder = append(visitOrder, current)
+
+		// Return up the path to the node current was reached from
+		for top, ok := callStack.Top(); ok && top != parent[current]; top, ok = callStack.Top() {
+			callStack.Pop()
+		}
+		callStack.Push(current)
 
 		stepCallback(types.ExecutionStep{
 			StepNumber: stepNumber,
 			Action:     "visit_node",
-			Data: map[string]interface{}{
+			Data: callStack.WithDecisionStack(map[string]interface{}{
 				"graph":       graph,
 				"current":     current,
 				"visited":     visited,
 				"stack":       stack,
-				"path":        path,
+				"visit_order": visitOrder,
 				"target_node": targetNode,
-			},
+			}),
 			Message:   fmt.Sprintf("Visiting node %d", current),
 			Timestamp: time.Now(),
 		})
 		stepNumber++
 
 		if current == targetNode {
+			path := reconstructPath(parent, targetNode)
+
 			stepCallback(types.ExecutionStep{
 				StepNumber: stepNumber,
-				Action:     "found",
+				Action:     "path_reconstruction",
 				Data: map[string]interface{}{
-					"graph":    graph,
-					"found_at": current,
-					"path":     path,
-					"visited":  visited,
+					"graph":       graph,
+					"parent":      append([]int{}, parent...),
+					"path":        path,
+					"visit_order": visitOrder,
 				},
+				Message:   fmt.Sprintf("Followed parents back from node %d to node %d: %v", targetNode, startNode, path),
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "found",
+				Data: callStack.WithDecisionStack(map[string]interface{}{
+					"graph":       graph,
+					"found_at":    current,
+					"path":        path,
+					"visit_order": visitOrder,
+					"visited":     visited,
+				}),
 				Message:   fmt.Sprintf("Target node %d found! Path: %v", targetNode, path),
 				Timestamp: time.Now(),
 			})
 
 			return map[string]interface{}{
-				"found":   true,
-				"target":  targetNode,
-				"path":    path,
-				"visited": visited,
+				"found":             true,
+				"target":            targetNode,
+				"path":              path,
+				"visit_order":       visitOrder,
+				"visited":           visited,
+				"nodes_visited":     len(visitOrder),
+				"edges_examined":    edgesExamined,
+				"max_frontier_size": maxFrontier,
 			}, nil
 		}
 
-		// Add unvisited neighbors to stack
+		// Add unvisited neighbors to stack. A node is popped first from the
+		// entry pushed last, so the latest parent is the one it is reached from
 		for _, neighbor := range graph[current] {
+			edgesExamined++
 			if !visited[neighbor] {
 				stack = append(stack, neighbor)
+				parent[neighbor] = current
 			}
 		}
+		if len(stack) > maxFrontier {
+			maxFrontier = len(stack)
+		}
 
 		stepCallback(types.ExecutionStep{
 			StepNumber: stepNumber,
 			Action:     "add_neighbors",
-			Data: map[string]interface{}{
+			Data: callStack.WithDecisionStack(map[string]interface{}{
 				"graph":     graph,
 				"current":   current,
 				"neighbors": graph[current],
 				"stack":     stack,
 				"visited":   visited,
-			},
+			}),
 			Message:   fmt.Sprintf("Adding unvisited neighbors of %d to stack: %v", current, graph[current]),
 			Timestamp: time.Now(),
 		})
@@ -173,51 +250,62 @@ func (dfs *DFS) Execute(input interface{}, parameters map[string]interface{}, st
 		StepNumber: stepNumber,
 		Action:     "not_found",
 		Data: map[string]interface{}{
-			"graph":   graph,
-			"visited": visited,
-			"path":    path,
+			"graph":       graph,
+			"visited":     visited,
+			"visit_order": visitOrder,
 		},
 		Message:   fmt.Sprintf("Target node %d not found", targetNode),
 		Timestamp: time.Now(),
 	})
 
 	return map[string]interface{}{
-		"found":   false,
-		"target":  targetNode,
-		"path":    path,
-		"visited": visited,
+		"found":             false,
+		"target":            targetNode,
+		"path":              []int{},
+		"visit_order":       visitOrder,
+		"visited":           visited,
+		"nodes_visited":     len(visitOrder),
+		"edges_examined":    edgesExamined,
+		"max_frontier_size": maxFrontier,
 	}, nil
 }
 
+// GenerateInstance generates the graph Execute runs on when given no input
+func (dfs *DFS) GenerateInstance(parameters map[string]interface{}) interface{} {
+	graphSize := 6
+	if size, ok := parameters["graph_size"].(int); ok {
+		graphSize = size
+	}
+
+	return generators.Graph(generators.NewRand(parameters), graphSize)
+}
+
 // ValidateParameters validates the input parameters
 func (dfs *DFS) ValidateParameters(parameters map[string]interface{}) error {
-	if graphSize, ok := parameters["graph_size"].(int); ok {
-		if graphSize < 3 || graphSize > 20 {
+	graphSize := 6
+	if size, ok := parameters["graph_size"].(int); ok {
+		if size < 3 || size > 20 {
 			return fmt.Errorf("graph_size must be between 3 and 20")
 		}
+		graphSize = size
 	}
-	return nil
-}
 
-// Helper function to generate a simple graph
-func generateGraph(size int) [][]int {
-	graph := make([][]int, size)
+	if err := generators.ValidateEmitStack(parameters); err != nil {
+		return err
+	}
 
-	// Create a simple connected graph
-	for i := 0; i < size; i++ {
-		neighbors := []int{}
-		if i > 0 {
-			neighbors = append(neighbors, i-1)
-		}
-		if i < size-1 {
-			neighbors = append(neighbors, i+1)
-		}
-		// Add some random connections
-		if i+2 < size {
-			neighbors = append(neighbors, i+2)
+	for _, name := range []string{"start_node", "target_node"} {
+		if node, ok := parameters[name].(int); ok {
+			if node < 0 || node >= graphSize {
+				return fmt.Errorf("%s must be between 0 and %d", name, graphSize-1)
+			}
 		}
-		graph[i] = neighbors
 	}
 
-	return graph
+	return generators.ValidateRepresentation(parameters)
+}
+
+// SelfTest verifies the DFS implementation against a known input
+func (dfs *DFS) SelfTest() error {
+	return selfTestGraphSearch(dfs)
 }
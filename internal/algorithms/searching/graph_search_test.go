@@ -0,0 +1,259 @@
+package searching
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"algorthmia/internal/types"
+)
+
+func TestGraphSearchNodeRange(t *testing.T) {
+	for _, algorithm := range []types.AlgorithmExecutor{NewBFS(), NewDFS()} {
+		id := algorithm.GetMetadata().ID
+
+		invalid := []map[string]interface{}{
+			{"start_node": 19},
+			{"target_node": 6},
+			{"start_node": -1},
+			{"graph_size": 4, "target_node": 4},
+		}
+		for _, parameters := range invalid {
+			if err := algorithm.ValidateParameters(parameters); err == nil {
+				t.Errorf("%s accepted %v", id, parameters)
+			}
+		}
+
+		valid := []map[string]interface{}{
+			{},
+			{"graph_size": 20, "start_node": 19, "target_node": 0},
+			{"graph_size": 3, "start_node": 2},
+		}
+		for _, parameters := range valid {
+			if err := algorithm.ValidateParameters(parameters); err != nil {
+				t.Errorf("%s rejected %v: %v", id, parameters, err)
+				continue
+			}
+			if _, err := algorithm.Execute(context.Background(), nil, parameters, func(types.ExecutionStep) {}); err != nil {
+				t.Errorf("%s failed with %v: %v", id, parameters, err)
+			}
+		}
+	}
+}
+
+// TestDFSEmitStack checks that the DFS decision stack is the path from the
+// start node to the visited node, so it shrinks when the search backtracks
+func TestDFSEmitStack(t *testing.T) {
+	graph := [][]int{{1, 2}, {0, 3}, {0, 4}, {1}, {2}}
+	parameters := map[string]interface{}{"graph_size": 5, "start_node": 0, "target_node": 3, "emit_stack": true}
+
+	dfs := NewDFS()
+	if err := dfs.ValidateParameters(parameters); err != nil {
+		t.Fatalf("validating: %v", err)
+	}
+
+	var stacks [][]interface{}
+	_, err := dfs.Execute(context.Background(), graph, parameters, func(step types.ExecutionStep) {
+		if step.Action == "visit_node" {
+			stacks = append(stacks, step.Data["decision_stack"].([]interface{}))
+		}
+	})
+	if err != nil {
+		t.Fatalf("executing: %v", err)
+	}
+
+	expected := [][]interface{}{{0}, {0, 2}, {0, 2, 4}, {0, 1}, {0, 1, 3}}
+	if !reflect.DeepEqual(stacks, expected) {
+		t.Fatalf("decision stacks %v, expected %v", stacks, expected)
+	}
+
+	if err := dfs.ValidateParameters(map[string]interface{}{"emit_stack": "yes"}); err == nil {
+		t.Errorf("accepted a non-boolean emit_stack")
+	}
+
+	_, err = dfs.Execute(context.Background(), graph, map[string]interface{}{"graph_size": 5}, func(step types.ExecutionStep) {
+		if _, ok := step.Data["decision_stack"]; ok {
+			t.Fatalf("%s step has a decision stack without emit_stack", step.Action)
+		}
+	})
+	if err != nil {
+		t.Fatalf("executing: %v", err)
+	}
+}
+
+// TestGraphSearchCounters checks the exploration counters against the
+// steps: one visit per expanded node, each examining all of its neighbors
+func TestGraphSearchCounters(t *testing.T) {
+	// Node 5 cannot be reached from node 0, so the search expands every other node
+	disconnected := [][]int{{1, 2}, {0, 3}, {0, 3, 4}, {1, 2}, {2}, {}}
+
+	runs := []struct {
+		input      interface{}
+		parameters map[string]interface{}
+	}{
+		{nil, map[string]interface{}{"graph_size": 6, "seed": 1}},
+		{nil, map[string]interface{}{"graph_size": 20, "start_node": 3, "target_node": 17, "seed": 2}},
+		{disconnected, map[string]interface{}{"graph_size": 6, "start_node": 0, "target_node": 5}},
+	}
+
+	for _, algorithm := range []types.AlgorithmExecutor{NewBFS(), NewDFS()} {
+		id := algorithm.GetMetadata().ID
+		for _, run := range runs {
+			graphSize := run.parameters["graph_size"].(int)
+			visits, neighbors, frontier := 0, 0, 1
+			output, err := algorithm.Execute(context.Background(), run.input, run.parameters, func(step types.ExecutionStep) {
+				switch step.Action {
+				case "visit_node":
+					visits++
+				case "add_neighbors":
+					neighbors += len(step.Data["neighbors"].([]int))
+					for _, key := range []string{"queue", "stack"} {
+						if pending, ok := step.Data[key].([]int); ok && len(pending) > frontier {
+							frontier = len(pending)
+						}
+					}
+				}
+			})
+			if err != nil {
+				t.Fatalf("%s %v: %v", id, run.parameters, err)
+			}
+
+			result := output.(map[string]interface{})
+			if visited := result["nodes_visited"].(int); visited != visits || visited > graphSize {
+				t.Errorf("%s %v: nodes_visited is %d, but %d of %d nodes were visited", id, run.parameters, visited, visits, graphSize)
+			}
+			if examined := result["edges_examined"].(int); examined != neighbors {
+				t.Errorf("%s %v: edges_examined is %d, but %d neighbors were examined", id, run.parameters, examined, neighbors)
+			}
+			if peak := result["max_frontier_size"].(int); peak != frontier {
+				t.Errorf("%s %v: max_frontier_size is %d, but the frontier peaked at %d", id, run.parameters, peak, frontier)
+			}
+		}
+	}
+}
+
+// TestGraphSearchMatrixRepresentation checks that the matrix in the first
+// step describes the same graph as the adjacency list beside it
+func TestGraphSearchMatrixRepresentation(t *testing.T) {
+	for _, algorithm := range []types.AlgorithmExecutor{NewBFS(), NewDFS()} {
+		id := algorithm.GetMetadata().ID
+		for _, representation := range []string{"list", "matrix"} {
+			parameters := map[string]interface{}{"graph_size": 10, "seed": 3, "graph_representation": representation}
+
+			var initialize types.ExecutionStep
+			_, err := algorithm.Execute(context.Background(), nil, parameters, func(step types.ExecutionStep) {
+				if step.Action == "initialize" {
+					initialize = step
+				}
+			})
+			if err != nil {
+				t.Fatalf("%s: %v", id, err)
+			}
+
+			matrix, hasMatrix := initialize.Data["adjacency_matrix"].([][]int)
+			if hasMatrix != (representation == "matrix") {
+				t.Errorf("%s with %s representation: adjacency matrix present is %v", id, representation, hasMatrix)
+				continue
+			}
+			if !hasMatrix {
+				continue
+			}
+
+			graph := initialize.Data["graph"].([][]int)
+			for i, neighbors := range graph {
+				linked := 0
+				for _, cell := range matrix[i] {
+					linked += cell
+				}
+				if linked != len(neighbors) {
+					t.Errorf("%s: matrix row %d has %d edges, but node %d has neighbors %v", id, i, linked, i, neighbors)
+				}
+				for _, j := range neighbors {
+					if matrix[i][j] != 1 {
+						t.Errorf("%s: edge %d-%d is missing from the matrix", id, i, j)
+					}
+				}
+			}
+		}
+	}
+}
+
+// TestGraphSearchReturnsPath checks that BFS and DFS return the path to the
+// target, not the order they visited nodes in: a chain of edges from the
+// start to the target, which for BFS is as short as possible
+func TestGraphSearchReturnsPath(t *testing.T) {
+	// Both searches visit 1 and 3 or 4 off the path 0-2-5 before reaching 5
+	pathGraph := [][]int{{1, 2}, {0, 3}, {0, 5, 4}, {1}, {2}, {2}}
+
+	for _, algorithm := range []types.AlgorithmExecutor{NewBFS(), NewDFS()} {
+		id := algorithm.GetMetadata().ID
+
+		output, err := algorithm.Execute(context.Background(), pathGraph, map[string]interface{}{"graph_size": 6, "start_node": 0, "target_node": 5}, func(types.ExecutionStep) {})
+		if err != nil {
+			t.Fatalf("%s: %v", id, err)
+		}
+		result := output.(map[string]interface{})
+		if path := result["path"].([]int); !reflect.DeepEqual(path, []int{0, 2, 5}) {
+			t.Errorf("%s: path %v, expected [0 2 5]", id, path)
+		}
+		if order := result["visit_order"].([]int); reflect.DeepEqual(order, result["path"]) {
+			t.Errorf("%s: visit order %v should include nodes off the path", id, order)
+		}
+
+		for seed := 1; seed <= 10; seed++ {
+			parameters := map[string]interface{}{"graph_size": 15, "start_node": 0, "target_node": 14, "seed": seed}
+			graph := algorithm.(types.InstanceGenerator).GenerateInstance(parameters).([][]int)
+
+			output, err := algorithm.Execute(context.Background(), graph, parameters, func(types.ExecutionStep) {})
+			if err != nil {
+				t.Fatalf("%s seed %d: %v", id, seed, err)
+			}
+			path := output.(map[string]interface{})["path"].([]int)
+
+			if len(path) == 0 || path[0] != 0 || path[len(path)-1] != 14 {
+				t.Fatalf("%s seed %d: path %v does not run from 0 to 14", id, seed, path)
+			}
+			for i := 0; i+1 < len(path); i++ {
+				if !hasEdge(graph, path[i], path[i+1]) {
+					t.Errorf("%s seed %d: path %v uses %d-%d, which is not an edge", id, seed, path, path[i], path[i+1])
+				}
+			}
+			if id == "bfs" {
+				if shortest := distance(graph, 0, 14); len(path)-1 != shortest {
+					t.Errorf("bfs seed %d: path %v has %d edges, but the shortest has %d", seed, path, len(path)-1, shortest)
+				}
+			}
+		}
+	}
+}
+
+// hasEdge reports whether to is a neighbor of from
+func hasEdge(graph [][]int, from, to int) bool {
+	for _, neighbor := range graph[from] {
+		if neighbor == to {
+			return true
+		}
+	}
+	return false
+}
+
+// distance returns the number of edges on a shortest path, or -1
+func distance(graph [][]int, from, to int) int {
+	dist := make([]int, len(graph))
+	for i := range dist {
+		dist[i] = -1
+	}
+	dist[from] = 0
+	queue := []int{from}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, neighbor := range graph[current] {
+			if dist[neighbor] == -1 {
+				dist[neighbor] = dist[current] + 1
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+	return dist[to]
+}
@@ -2,6 +2,7 @@ package searching
 
 import (
 	"algorthmia/internal/types"
+	"context"
 	"fmt"
 	"time"
 )
@@ -26,8 +27,8 @@ func NewHashLookup() *HashLookup {
 					Type:        "int",
 					Description: "Size of the hash table",
 					Default:     10,
-					Min:         intPtr(5),
-					Max:         intPtr(50),
+					Min:         types.IntPtr(5),
+					Max:         types.IntPtr(50),
 					Required:    true,
 				},
 				{
@@ -38,6 +39,7 @@ func NewHashLookup() *HashLookup {
 					Required:    true,
 				},
 			},
+			RelatedIDs: []string{"linear_search", "binary_search"},
 		},
 	}
 }
@@ -48,7 +50,7 @@ func (hl *HashLookup) GetMetadata() types.Algorithm {
 }
 
 // Execute runs the hash lookup algorithm
-func (hl *HashLookup) Execute(input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+func (hl *HashLookup) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
 	tableSize := 10
 	if size, ok := parameters["table_size"].(int); ok {
 		tableSize = size
@@ -108,6 +110,9 @@ func (hl *HashLookup) Execute(input interface{}, parameters map[string]interface
 
 		// Search within the bucket (handling collisions)
 		for i, entry := range bucket {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
 			stepCallback(types.ExecutionStep{
 				StepNumber: 3 + i,
 				Action:     "check_entry",
@@ -180,6 +185,25 @@ func (hl *HashLookup) ValidateParameters(parameters map[string]interface{}) erro
 	return nil
 }
 
+// SelfTest verifies the hash lookup finds a key known to be in the generated table
+func (hl *HashLookup) SelfTest() error {
+	output, err := hl.Execute(context.Background(), nil, map[string]interface{}{"table_size": 10, "key": "key5"}, func(types.ExecutionStep) {})
+	if err != nil {
+		return fmt.Errorf("execution failed: %v", err)
+	}
+
+	result, ok := output.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected output type %T", output)
+	}
+
+	if found, _ := result["found"].(bool); !found || result["value"] != "value5" {
+		return fmt.Errorf("expected key5 to map to value5, got %v", result["value"])
+	}
+
+	return nil
+}
+
 // HashEntry represents an entry in the hash table
 type HashEntry struct {
 	Key   string
@@ -0,0 +1,225 @@
+package searching
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// JumpSearch implements the jump search algorithm
+type JumpSearch struct {
+	metadata types.Algorithm
+}
+
+// NewJumpSearch creates a new JumpSearch instance
+func NewJumpSearch() *JumpSearch {
+	return &JumpSearch{
+		metadata: types.Algorithm{
+			ID:          "jump_search",
+			Name:        "Jump Search",
+			Category:    types.CategorySearching,
+			Description: "Searches a sorted array by jumping ahead in blocks of √n elements until the block that could hold the target is found, then scanning that block linearly.",
+			BigO:        "Time: O(√n), Space: O(1)",
+			Parameters: []types.Parameter{
+				{
+					Name:        "array_size",
+					Type:        "int",
+					Description: "Size of the array to search",
+					Default:     16,
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(100),
+					Required:    true,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible array generation",
+					Default:     nil,
+					Required:    false,
+				},
+				{
+					Name:        "target",
+					Type:        "int",
+					Description: "Value to search for",
+					Default:     5,
+					Required:    true,
+				},
+			},
+			RelatedIDs: []string{"binary_search", "linear_search"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (js *JumpSearch) GetMetadata() types.Algorithm {
+	return js.metadata
+}
+
+// Execute runs the jump search algorithm
+func (js *JumpSearch) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	// Generate array if not provided
+	var arr []int
+	if input != nil {
+		if inputArr, ok := input.([]int); ok {
+			arr = inputArr
+		} else {
+			return nil, fmt.Errorf("invalid input type, expected []int")
+		}
+	} else {
+		// Generate random array
+		arraySize := 16
+		if size, ok := parameters["array_size"].(int); ok {
+			arraySize = size
+		}
+		arr = generators.Permutation(generators.NewRand(parameters), arraySize)
+	}
+
+	target := 5
+	if t, ok := parameters["target"].(int); ok {
+		target = t
+	}
+
+	// Sort the array first
+	sort.Ints(arr)
+
+	n := len(arr)
+	stepSize := int(math.Sqrt(float64(n)))
+	if stepSize < 1 {
+		stepSize = 1
+	}
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"array":     arr,
+			"target":    target,
+			"step_size": stepSize,
+		},
+		Message:   fmt.Sprintf("Starting Jump Search for target: %d with block size %d", target, stepSize),
+		Timestamp: time.Now(),
+	})
+
+	stepNumber := 1
+	blocksJumped := 0
+
+	// Jump ahead while the last element of the current block is below the target
+	blockStart := 0
+	for blockStart < n {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		blockEnd := blockStart + stepSize - 1
+		if blockEnd > n-1 {
+			blockEnd = n - 1
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "jump_block",
+			Data: map[string]interface{}{
+				"array":         arr,
+				"target":        target,
+				"block_start":   blockStart,
+				"block_end":     blockEnd,
+				"step_size":     stepSize,
+				"block_last":    arr[blockEnd],
+				"blocks_jumped": blocksJumped,
+			},
+			Message:   fmt.Sprintf("Block %d..%d ends with %d", blockStart, blockEnd, arr[blockEnd]),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+
+		if arr[blockEnd] >= target {
+			// The target can only be in this block
+			for i := blockStart; i <= blockEnd; i++ {
+				stepCallback(types.ExecutionStep{
+					StepNumber: stepNumber,
+					Action:     "linear_scan",
+					Data: map[string]interface{}{
+						"array":         arr,
+						"target":        target,
+						"block_start":   blockStart,
+						"block_end":     blockEnd,
+						"step_size":     stepSize,
+						"current_index": i,
+						"current_value": arr[i],
+						"blocks_jumped": blocksJumped,
+					},
+					Message:   fmt.Sprintf("Scanning index %d: %d", i, arr[i]),
+					Timestamp: time.Now(),
+				})
+				stepNumber++
+
+				if arr[i] == target {
+					stepCallback(types.ExecutionStep{
+						StepNumber: stepNumber,
+						Action:     "found",
+						Data: map[string]interface{}{
+							"array":         arr,
+							"target":        target,
+							"found_at":      i,
+							"blocks_jumped": blocksJumped,
+						},
+						Message:   fmt.Sprintf("Target %d found at index %d after jumping %d blocks", target, i, blocksJumped),
+						Timestamp: time.Now(),
+					})
+
+					return map[string]interface{}{
+						"found":         true,
+						"index":         i,
+						"blocks_jumped": blocksJumped,
+					}, nil
+				}
+
+				if arr[i] > target {
+					break
+				}
+			}
+			break
+		}
+
+		blockStart += stepSize
+		blocksJumped++
+	}
+
+	// Target not found
+	stepCallback(types.ExecutionStep{
+		StepNumber: stepNumber,
+		Action:     "not_found",
+		Data: map[string]interface{}{
+			"array":         arr,
+			"target":        target,
+			"blocks_jumped": blocksJumped,
+		},
+		Message:   fmt.Sprintf("Target %d not found after jumping %d blocks", target, blocksJumped),
+		Timestamp: time.Now(),
+	})
+
+	return map[string]interface{}{
+		"found":         false,
+		"index":         -1,
+		"blocks_jumped": blocksJumped,
+	}, nil
+}
+
+// ValidateParameters validates the input parameters
+func (js *JumpSearch) ValidateParameters(parameters map[string]interface{}) error {
+	if arraySize, ok := parameters["array_size"].(int); ok {
+		if arraySize < 3 || arraySize > 100 {
+			return fmt.Errorf("array_size must be between 3 and 100")
+		}
+	}
+	return nil
+}
+
+// SelfTest verifies the jump search implementation against a known input
+func (js *JumpSearch) SelfTest() error {
+	return selfTestArraySearch(js)
+}
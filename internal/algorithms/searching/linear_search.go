@@ -1,7 +1,9 @@
 package searching
 
 import (
+	"algorthmia/internal/algorithms/generators"
 	"algorthmia/internal/types"
+	"context"
 	"fmt"
 	"time"
 )
@@ -26,10 +28,17 @@ func NewLinearSearch() *LinearSearch {
 					Type:        "int",
 					Description: "Size of the array to search",
 					Default:     10,
-					Min:         intPtr(3),
-					Max:         intPtr(100),
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(100),
 					Required:    true,
 				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible array generation",
+					Default:     nil,
+					Required:    false,
+				},
 				{
 					Name:        "target",
 					Type:        "int",
@@ -38,6 +47,7 @@ func NewLinearSearch() *LinearSearch {
 					Required:    true,
 				},
 			},
+			RelatedIDs: []string{"binary_search", "hash_lookup"},
 		},
 	}
 }
@@ -48,7 +58,7 @@ func (ls *LinearSearch) GetMetadata() types.Algorithm {
 }
 
 // Execute runs the linear search algorithm
-func (ls *LinearSearch) Execute(input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+func (ls *LinearSearch) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
 	// Generate array if not provided
 	var arr []int
 	if input != nil {
@@ -63,7 +73,7 @@ func (ls *LinearSearch) Execute(input interface{}, parameters map[string]interfa
 		if size, ok := parameters["array_size"].(int); ok {
 			arraySize = size
 		}
-		arr = generateRandomArray(arraySize)
+		arr = generators.Permutation(generators.NewRand(parameters), arraySize)
 	}
 
 	target := 5
@@ -85,6 +95,9 @@ func (ls *LinearSearch) Execute(input interface{}, parameters map[string]interfa
 
 	// Perform linear search
 	for i := 0; i < len(arr); i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		stepCallback(types.ExecutionStep{
 			StepNumber: i + 1,
 			Action:     "check_element",
@@ -155,23 +168,7 @@ func (ls *LinearSearch) ValidateParameters(parameters map[string]interface{}) er
 	return nil
 }
 
-// Helper function to generate random array
-func generateRandomArray(size int) []int {
-	arr := make([]int, size)
-	for i := 0; i < size; i++ {
-		arr[i] = i + 1
-	}
-
-	// Shuffle the array
-	for i := len(arr) - 1; i > 0; i-- {
-		j := i % (i + 1) // Simple shuffle for demo
-		arr[i], arr[j] = arr[j], arr[i]
-	}
-
-	return arr
-}
-
-// Helper function to get int pointer
-func intPtr(i int) *int {
-	return &i
+// SelfTest verifies the linear search implementation against a known input
+func (ls *LinearSearch) SelfTest() error {
+	return selfTestArraySearch(ls)
 }
@@ -0,0 +1,232 @@
+package searching
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"time"
+)
+
+// MajorityVote implements the Boyer–Moore majority vote algorithm
+type MajorityVote struct {
+	metadata types.Algorithm
+}
+
+// NewMajorityVote creates a new MajorityVote instance
+func NewMajorityVote() *MajorityVote {
+	return &MajorityVote{
+		metadata: types.Algorithm{
+			ID:          "boyer_moore_majority",
+			Name:        "Boyer–Moore Majority Vote",
+			Category:    types.CategorySearching,
+			Description: "Finds the element occurring more than n/2 times in a single streaming pass with one candidate and one counter, then confirms the candidate with a verification pass.",
+			BigO:        "Time: O(n), Space: O(1)",
+			Parameters: []types.Parameter{
+				{
+					Name:        "array_size",
+					Type:        "int",
+					Description: "Size of the array to scan",
+					Default:     15,
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(100),
+					Required:    true,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible array generation",
+					Default:     nil,
+					Required:    false,
+				},
+			},
+			RelatedIDs: []string{"hash_lookup"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (mv *MajorityVote) GetMetadata() types.Algorithm {
+	return mv.metadata
+}
+
+// Execute runs the Boyer–Moore majority vote algorithm
+func (mv *MajorityVote) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	var arr []int
+	if input != nil {
+		if inputArr, ok := input.([]int); ok {
+			arr = inputArr
+		} else {
+			return nil, fmt.Errorf("invalid input type, expected []int")
+		}
+	} else {
+		arraySize := 15
+		if size, ok := parameters["array_size"].(int); ok {
+			arraySize = size
+		}
+
+		// Plant a majority element in roughly half of the generated arrays
+		rng := generators.NewRand(parameters)
+		arr = make([]int, arraySize)
+		planted := rng.Intn(2) == 0
+		majority := rng.Intn(5) + 1
+		for i := range arr {
+			if planted && rng.Intn(3) > 0 {
+				arr[i] = majority
+			} else {
+				arr[i] = rng.Intn(5) + 1
+			}
+		}
+	}
+
+	if len(arr) == 0 {
+		return nil, fmt.Errorf("array must not be empty")
+	}
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"array": arr,
+		},
+		Message:   fmt.Sprintf("Starting Boyer–Moore Majority Vote over %d elements", len(arr)),
+		Timestamp: time.Now(),
+	})
+
+	candidate := 0
+	count := 0
+	stepNumber := 1
+
+	for i, value := range arr {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		var action, message string
+		switch {
+		case count == 0:
+			candidate = value
+			count = 1
+			action = "candidate_set"
+			message = fmt.Sprintf("Counter was zero: %d becomes the candidate", value)
+		case value == candidate:
+			count++
+			action = "increment_count"
+			message = fmt.Sprintf("%d matches the candidate, count rises to %d", value, count)
+		default:
+			count--
+			action = "decrement_count"
+			message = fmt.Sprintf("%d differs from candidate %d, count falls to %d", value, candidate, count)
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     action,
+			Data: map[string]interface{}{
+				"array":     arr,
+				"index":     i,
+				"current":   value,
+				"candidate": candidate,
+				"count":     count,
+				"phase":     "voting",
+			},
+			Message:   message,
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+	}
+
+	// Verify the candidate, since the vote only finds a majority if one exists
+	occurrences := 0
+	for i, value := range arr {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if value == candidate {
+			occurrences++
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "verify",
+			Data: map[string]interface{}{
+				"array":       arr,
+				"index":       i,
+				"current":     value,
+				"candidate":   candidate,
+				"occurrences": occurrences,
+				"phase":       "verifying",
+			},
+			Message:   fmt.Sprintf("Counted %d occurrences of candidate %d so far", occurrences, candidate),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+	}
+
+	verified := occurrences > len(arr)/2
+	var majority interface{}
+	if verified {
+		majority = candidate
+	}
+
+	message := fmt.Sprintf("%d is the majority element with %d of %d occurrences", candidate, occurrences, len(arr))
+	if !verified {
+		message = fmt.Sprintf("No majority element: candidate %d occurs only %d of %d times", candidate, occurrences, len(arr))
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data: map[string]interface{}{
+			"array":       arr,
+			"candidate":   candidate,
+			"occurrences": occurrences,
+			"majority":    majority,
+			"verified":    verified,
+		},
+		Message:   message,
+		Timestamp: time.Now(),
+	})
+
+	return map[string]interface{}{
+		"majority":    majority,
+		"candidate":   candidate,
+		"occurrences": occurrences,
+		"verified":    verified,
+	}, nil
+}
+
+// ValidateParameters validates the input parameters
+func (mv *MajorityVote) ValidateParameters(parameters map[string]interface{}) error {
+	if arraySize, ok := parameters["array_size"].(int); ok {
+		if arraySize < 3 || arraySize > 100 {
+			return fmt.Errorf("array_size must be between 3 and 100")
+		}
+	}
+	return nil
+}
+
+// SelfTest verifies that a majority is found and that its absence is reported
+func (mv *MajorityVote) SelfTest() error {
+	cases := []struct {
+		input    []int
+		majority interface{}
+	}{
+		{[]int{2, 7, 2, 2, 5, 2, 2}, 2},
+		{[]int{1, 2, 3, 1, 2, 3}, nil},
+	}
+
+	for _, c := range cases {
+		output, err := mv.Execute(context.Background(), c.input, map[string]interface{}{}, func(types.ExecutionStep) {})
+		if err != nil {
+			return fmt.Errorf("execution failed: %v", err)
+		}
+
+		if majority := output.(map[string]interface{})["majority"]; majority != c.majority {
+			return fmt.Errorf("expected majority %v for %v, got %v", c.majority, c.input, majority)
+		}
+	}
+
+	return nil
+}
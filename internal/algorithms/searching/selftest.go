@@ -0,0 +1,65 @@
+package searching
+
+import (
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+)
+
+// selfTestInput is the fixed input array searches are checked against
+var selfTestInput = []int{4, 8, 15, 16, 23, 42}
+
+// selfTestArraySearch runs an array search for a known element and checks the reported index
+func selfTestArraySearch(algorithm types.AlgorithmExecutor) error {
+	input := make([]int, len(selfTestInput))
+	copy(input, selfTestInput)
+
+	target := 23
+	output, err := algorithm.Execute(context.Background(), input, map[string]interface{}{"target": target}, func(types.ExecutionStep) {})
+	if err != nil {
+		return fmt.Errorf("execution failed: %v", err)
+	}
+
+	result, ok := output.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected output type %T", output)
+	}
+
+	if found, _ := result["found"].(bool); !found {
+		return fmt.Errorf("target %d was not found", target)
+	}
+
+	index, ok := result["index"].(int)
+	if !ok || index < 0 || index >= len(input) || input[index] != target {
+		return fmt.Errorf("reported index %v does not hold target %d", result["index"], target)
+	}
+
+	return nil
+}
+
+// selfTestPathGraph is a fixed graph in which 5 is reached from 0 along 0-2-5
+var selfTestPathGraph = [][]int{{1, 2}, {0, 3}, {0, 5, 4}, {1}, {2}, {2}}
+
+// selfTestGraphSearch runs a graph search on selfTestPathGraph and checks
+// the target is reached along the path from node 0 to node 5
+func selfTestGraphSearch(algorithm types.AlgorithmExecutor) error {
+	parameters := map[string]interface{}{"graph_size": 6, "start_node": 0, "target_node": 5}
+	output, err := algorithm.Execute(context.Background(), selfTestPathGraph, parameters, func(types.ExecutionStep) {})
+	if err != nil {
+		return fmt.Errorf("execution failed: %v", err)
+	}
+
+	result, ok := output.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected output type %T", output)
+	}
+
+	if found, _ := result["found"].(bool); !found {
+		return fmt.Errorf("target node 5 was not reached from node 0")
+	}
+	if path := fmt.Sprint(result["path"]); path != "[0 2 5]" {
+		return fmt.Errorf("expected path [0 2 5], got %s", path)
+	}
+
+	return nil
+}
@@ -0,0 +1,235 @@
+package searching
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"container/heap"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// StreamingMedian maintains the running median of a stream with two heaps
+type StreamingMedian struct {
+	metadata types.Algorithm
+}
+
+// NewStreamingMedian creates a new StreamingMedian instance
+func NewStreamingMedian() *StreamingMedian {
+	return &StreamingMedian{
+		metadata: types.Algorithm{
+			ID:          "streaming_median",
+			Name:        "Streaming Median (Two Heaps)",
+			Category:    types.CategorySearching,
+			Description: "Keeps the lower half of a stream in a max-heap and the upper half in a min-heap, rebalancing so their sizes differ by at most one, which makes the median available from the heap tops after every arrival.",
+			BigO:        "Time: O(log n) per element, Space: O(n)",
+			Parameters: []types.Parameter{
+				{
+					Name:        "stream_size",
+					Type:        "int",
+					Description: "Number of values arriving on the stream",
+					Default:     12,
+					Min:         types.IntPtr(1),
+					Max:         types.IntPtr(100),
+					Required:    true,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible stream generation",
+					Default:     nil,
+					Required:    false,
+				},
+			},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (sm *StreamingMedian) GetMetadata() types.Algorithm {
+	return sm.metadata
+}
+
+// intHeap is a min-heap of ints; a max-heap stores negated values
+type intHeap []int
+
+func (h intHeap) Len() int            { return len(h) }
+func (h intHeap) Less(i, j int) bool  { return h[i] < h[j] }
+func (h intHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *intHeap) Push(x interface{}) { *h = append(*h, x.(int)) }
+func (h *intHeap) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// Execute runs the two-heap streaming median algorithm
+func (sm *StreamingMedian) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	var stream []int
+	if input != nil {
+		if inputArr, ok := input.([]int); ok {
+			stream = inputArr
+		} else {
+			return nil, fmt.Errorf("invalid input type, expected []int")
+		}
+	} else {
+		streamSize := 12
+		if size, ok := parameters["stream_size"].(int); ok {
+			streamSize = size
+		}
+
+		rng := generators.NewRand(parameters)
+		stream = make([]int, streamSize)
+		for i := range stream {
+			stream[i] = rng.Intn(100)
+		}
+	}
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"stream": stream,
+		},
+		Message:   fmt.Sprintf("Starting Streaming Median over %d values", len(stream)),
+		Timestamp: time.Now(),
+	})
+
+	lower := &intHeap{} // max-heap via negated values
+	upper := &intHeap{}
+	medians := []float64{}
+	stepNumber := 1
+
+	// heaps returns both heaps' contents in heap order
+	heaps := func() map[string]interface{} {
+		lowerValues := make([]int, lower.Len())
+		for i, v := range *lower {
+			lowerValues[i] = -v
+		}
+		return map[string]interface{}{
+			"lower_heap": lowerValues,
+			"upper_heap": append([]int{}, *upper...),
+		}
+	}
+
+	emit := func(action, message string, extra map[string]interface{}) {
+		data := heaps()
+		for k, v := range extra {
+			data[k] = v
+		}
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     action,
+			Data:       data,
+			Message:    message,
+			Timestamp:  time.Now(),
+		})
+		stepNumber++
+	}
+
+	for i, value := range stream {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		// Values no larger than the lower half's maximum belong in the lower heap
+		target := "upper"
+		if lower.Len() == 0 || value <= -(*lower)[0] {
+			heap.Push(lower, -value)
+			target = "lower"
+		} else {
+			heap.Push(upper, value)
+		}
+
+		emit("insert_element", fmt.Sprintf("Inserted %d into the %s heap", value, target), map[string]interface{}{
+			"index":   i,
+			"element": value,
+			"heap":    target,
+		})
+
+		// Keep the lower heap equal in size to the upper heap or one larger
+		if lower.Len() > upper.Len()+1 {
+			moved := -heap.Pop(lower).(int)
+			heap.Push(upper, moved)
+			emit("rebalance_heaps", fmt.Sprintf("Moved %d from the lower heap to the upper heap", moved), map[string]interface{}{
+				"index": i,
+				"moved": moved,
+				"from":  "lower",
+			})
+		} else if upper.Len() > lower.Len() {
+			moved := heap.Pop(upper).(int)
+			heap.Push(lower, -moved)
+			emit("rebalance_heaps", fmt.Sprintf("Moved %d from the upper heap to the lower heap", moved), map[string]interface{}{
+				"index": i,
+				"moved": moved,
+				"from":  "upper",
+			})
+		}
+
+		median := float64(-(*lower)[0])
+		if lower.Len() == upper.Len() {
+			median = (median + float64((*upper)[0])) / 2
+		}
+		medians = append(medians, median)
+
+		emit("report_median", fmt.Sprintf("Median after %d values is %g", i+1, median), map[string]interface{}{
+			"index":   i,
+			"median":  median,
+			"medians": medians,
+		})
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data: map[string]interface{}{
+			"stream":  stream,
+			"medians": medians,
+		},
+		Message:   "Streaming Median completed",
+		Timestamp: time.Now(),
+	})
+
+	return map[string]interface{}{
+		"stream":  stream,
+		"medians": medians,
+	}, nil
+}
+
+// ValidateParameters validates the input parameters
+func (sm *StreamingMedian) ValidateParameters(parameters map[string]interface{}) error {
+	if streamSize, ok := parameters["stream_size"].(int); ok {
+		if streamSize < 1 || streamSize > 100 {
+			return fmt.Errorf("stream_size must be between 1 and 100")
+		}
+	}
+	return nil
+}
+
+// SelfTest verifies every running median against a sorted prefix of the stream
+func (sm *StreamingMedian) SelfTest() error {
+	stream := []int{5, 15, 1, 3, 8, 7, 9, 10, 20, 2}
+	output, err := sm.Execute(context.Background(), stream, map[string]interface{}{}, func(types.ExecutionStep) {})
+	if err != nil {
+		return fmt.Errorf("execution failed: %v", err)
+	}
+
+	medians := output.(map[string]interface{})["medians"].([]float64)
+	for i := range stream {
+		prefix := append([]int{}, stream[:i+1]...)
+		sort.Ints(prefix)
+
+		expected := float64(prefix[i/2])
+		if (i+1)%2 == 0 {
+			expected = float64(prefix[i/2]+prefix[i/2+1]) / 2
+		}
+		if medians[i] != expected {
+			return fmt.Errorf("median after %d values: expected %g, got %g", i+1, expected, medians[i])
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+package searching
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// TernarySearch implements ternary search over a sorted array
+type TernarySearch struct {
+	metadata types.Algorithm
+}
+
+// NewTernarySearch creates a new TernarySearch instance
+func NewTernarySearch() *TernarySearch {
+	return &TernarySearch{
+		metadata: types.Algorithm{
+			ID:          "ternary_search",
+			Name:        "Ternary Search",
+			Category:    types.CategorySearching,
+			Description: "Finds a target in a sorted array by splitting the search interval into three parts with two midpoints and discarding the two thirds that cannot hold it. Assumes a sorted array; unsorted input is sorted first.",
+			BigO:        "Time: O(log₃ n), Space: O(1)",
+			Parameters: []types.Parameter{
+				{
+					Name:        "array_size",
+					Type:        "int",
+					Description: "Size of the array to search",
+					Default:     10,
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(100),
+					Required:    true,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible array generation",
+					Default:     nil,
+					Required:    false,
+				},
+				{
+					Name:        "target",
+					Type:        "int",
+					Description: "Value to search for",
+					Default:     5,
+					Required:    true,
+				},
+			},
+			RelatedIDs: []string{"binary_search"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (ts *TernarySearch) GetMetadata() types.Algorithm {
+	return ts.metadata
+}
+
+// Execute runs the ternary search algorithm
+func (ts *TernarySearch) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	// Generate array if not provided
+	var arr []int
+	if input != nil {
+		if inputArr, ok := input.([]int); ok {
+			arr = inputArr
+		} else {
+			return nil, fmt.Errorf("invalid input type, expected []int")
+		}
+	} else {
+		// Generate random array
+		arraySize := 10
+		if size, ok := parameters["array_size"].(int); ok {
+			arraySize = size
+		}
+		arr = generators.Permutation(generators.NewRand(parameters), arraySize)
+	}
+
+	target := 5
+	if t, ok := parameters["target"].(int); ok {
+		target = t
+	}
+
+	// Sort the array first
+	sort.Ints(arr)
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"array":  arr,
+			"target": target,
+		},
+		Message:   fmt.Sprintf("Starting Ternary Search for target: %d in sorted array", target),
+		Timestamp: time.Now(),
+	})
+
+	left, right := 0, len(arr)-1
+	comparisons := 0
+	stepNumber := 1
+
+	for left <= right {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		third := (right - left) / 3
+		mid1 := left + third
+		mid2 := right - third
+
+		// Work out which third the target must be in
+		found := -1
+		next := "middle"
+		switch {
+		case arr[mid1] == target:
+			comparisons++
+			found, next = mid1, "found"
+		case arr[mid2] == target:
+			comparisons += 2
+			found, next = mid2, "found"
+		case target < arr[mid1]:
+			comparisons += 3
+			next = "left"
+		case target > arr[mid2]:
+			comparisons += 4
+			next = "right"
+		default:
+			comparisons += 4
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "check_midpoints",
+			Data: map[string]interface{}{
+				"array":       arr,
+				"target":      target,
+				"left":        left,
+				"right":       right,
+				"mid1":        mid1,
+				"mid2":        mid2,
+				"mid1_value":  arr[mid1],
+				"mid2_value":  arr[mid2],
+				"next_third":  next,
+				"comparisons": comparisons,
+			},
+			Message:   fmt.Sprintf("Midpoints %d at index %d and %d at index %d, continuing in the %s third", arr[mid1], mid1, arr[mid2], mid2, next),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+
+		if found >= 0 {
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "found",
+				Data: map[string]interface{}{
+					"array":       arr,
+					"target":      target,
+					"found_at":    found,
+					"value":       arr[found],
+					"comparisons": comparisons,
+				},
+				Message:   fmt.Sprintf("Target %d found at index %d after %d comparisons", target, found, comparisons),
+				Timestamp: time.Now(),
+			})
+
+			return map[string]interface{}{
+				"found":       true,
+				"index":       found,
+				"value":       arr[found],
+				"comparisons": comparisons,
+			}, nil
+		}
+
+		switch next {
+		case "left":
+			right = mid1 - 1
+		case "right":
+			left = mid2 + 1
+		default:
+			left, right = mid1+1, mid2-1
+		}
+	}
+
+	// Target not found
+	stepCallback(types.ExecutionStep{
+		StepNumber: stepNumber,
+		Action:     "not_found",
+		Data: map[string]interface{}{
+			"array":       arr,
+			"target":      target,
+			"comparisons": comparisons,
+		},
+		Message:   fmt.Sprintf("Target %d not found after %d comparisons", target, comparisons),
+		Timestamp: time.Now(),
+	})
+
+	return map[string]interface{}{
+		"found":       false,
+		"index":       -1,
+		"value":       nil,
+		"comparisons": comparisons,
+	}, nil
+}
+
+// ValidateParameters validates the input parameters
+func (ts *TernarySearch) ValidateParameters(parameters map[string]interface{}) error {
+	if arraySize, ok := parameters["array_size"].(int); ok {
+		if arraySize < 3 || arraySize > 100 {
+			return fmt.Errorf("array_size must be between 3 and 100")
+		}
+	}
+	return nil
+}
+
+// SelfTest verifies the ternary search implementation against a known input
+func (ts *TernarySearch) SelfTest() error {
+	return selfTestArraySearch(ts)
+}
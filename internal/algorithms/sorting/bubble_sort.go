@@ -1,7 +1,9 @@
 package sorting
 
 import (
+	"algorthmia/internal/algorithms/generators"
 	"algorthmia/internal/types"
+	"context"
 	"fmt"
 	"time"
 )
@@ -26,10 +28,17 @@ func NewBubbleSort() *BubbleSort {
 					Type:        "int",
 					Description: "Size of the array to sort",
 					Default:     10,
-					Min:         intPtr(3),
-					Max:         intPtr(100),
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(100),
 					Required:    true,
 				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible array generation",
+					Default:     nil,
+					Required:    false,
+				},
 				{
 					Name:        "show_comparisons",
 					Type:        "bool",
@@ -37,7 +46,11 @@ func NewBubbleSort() *BubbleSort {
 					Default:     true,
 					Required:    false,
 				},
+				inputPatternParameter(),
+				verifyParameter(),
 			},
+			InputType:  types.InputIntArray,
+			RelatedIDs: []string{"comb_sort", "merge_sort", "quick_sort"},
 		},
 	}
 }
@@ -48,22 +61,25 @@ func (bs *BubbleSort) GetMetadata() types.Algorithm {
 }
 
 // Execute runs the bubble sort algorithm
-func (bs *BubbleSort) Execute(input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+func (bs *BubbleSort) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
 	// Generate array if not provided
 	var arr []int
 	if input != nil {
 		if inputArr, ok := input.([]int); ok {
-			arr = inputArr
+			// Sort a copy in place, leaving the stored input as given
+			arr = append([]int{}, inputArr...)
 		} else {
 			return nil, fmt.Errorf("invalid input type, expected []int")
 		}
+	} else if demo, ok := demoArray(bs.metadata.ID, parameters); ok {
+		arr = demo
 	} else {
 		// Generate random array
 		arraySize := 10
 		if size, ok := parameters["array_size"].(int); ok {
 			arraySize = size
 		}
-		arr = generateRandomArray(arraySize)
+		arr = generators.Permutation(generators.NewRand(parameters), arraySize)
 	}
 
 	showComparisons := true
@@ -71,6 +87,8 @@ func (bs *BubbleSort) Execute(input interface{}, parameters map[string]interface
 		showComparisons = show
 	}
 
+	verifier := newSortVerifier(arr, parameters)
+
 	// Send initial state
 	stepCallback(types.ExecutionStep{
 		StepNumber: 0,
@@ -92,6 +110,9 @@ func (bs *BubbleSort) Execute(input interface{}, parameters map[string]interface
 
 	// Bubble sort implementation
 	for i := 0; i < n-1; i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		swapped := false
 
 		stepCallback(types.ExecutionStep{
@@ -188,7 +209,7 @@ func (bs *BubbleSort) Execute(input interface{}, parameters map[string]interface
 		Timestamp: time.Now(),
 	})
 
-	return arr, nil
+	return verifier.result(arr, stepCallback), nil
 }
 
 // ValidateParameters validates the input parameters
@@ -198,26 +219,15 @@ func (bs *BubbleSort) ValidateParameters(parameters map[string]interface{}) erro
 			return fmt.Errorf("array_size must be between 3 and 100")
 		}
 	}
-	return nil
-}
-
-// Helper function to generate random array
-func generateRandomArray(size int) []int {
-	arr := make([]int, size)
-	for i := 0; i < size; i++ {
-		arr[i] = i + 1
-	}
 
-	// Shuffle the array
-	for i := len(arr) - 1; i > 0; i-- {
-		j := i % (i + 1) // Simple shuffle for demo
-		arr[i], arr[j] = arr[j], arr[i]
+	if err := validateInputPattern(parameters); err != nil {
+		return err
 	}
 
-	return arr
+	return validateVerify(parameters)
 }
 
-// Helper function to get int pointer
-func intPtr(i int) *int {
-	return &i
+// SelfTest verifies the bubble sort implementation against a known input
+func (bs *BubbleSort) SelfTest() error {
+	return selfTestSort(bs)
 }
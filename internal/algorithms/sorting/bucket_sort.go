@@ -0,0 +1,265 @@
+package sorting
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"time"
+)
+
+// BucketSort implements bucket sort with insertion-sorted buckets
+type BucketSort struct {
+	metadata types.Algorithm
+}
+
+// NewBucketSort creates a new BucketSort instance
+func NewBucketSort() *BucketSort {
+	return &BucketSort{
+		metadata: types.Algorithm{
+			ID:          "bucket_sort",
+			Name:        "Bucket Sort",
+			Category:    types.CategorySorting,
+			Description: "Scatters elements into buckets covering equal slices of the value range, insertion-sorts each bucket, then gathers the buckets in order.",
+			BigO:        "Time: O(n + k) average, O(n²) worst case, Space: O(n + k) where k is the number of buckets",
+			Parameters: []types.Parameter{
+				{
+					Name:        "array_size",
+					Type:        "int",
+					Description: "Size of the array to sort",
+					Default:     15,
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(50),
+					Required:    true,
+				},
+				{
+					Name:        "max_value",
+					Type:        "int",
+					Description: "Maximum value in the array, used to split the range into buckets",
+					Default:     99,
+					Min:         types.IntPtr(1),
+					Max:         types.IntPtr(1000),
+					Required:    true,
+				},
+				{
+					Name:        "num_buckets",
+					Type:        "int",
+					Description: "Number of buckets to scatter elements into",
+					Default:     5,
+					Min:         types.IntPtr(2),
+					Max:         types.IntPtr(20),
+					Required:    true,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible array generation",
+					Default:     nil,
+					Required:    false,
+				},
+				inputPatternParameter(),
+				verifyParameter(),
+			},
+			InputType:  types.InputIntArray,
+			RelatedIDs: []string{"counting_sort", "radix_sort"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (bs *BucketSort) GetMetadata() types.Algorithm {
+	return bs.metadata
+}
+
+// Execute runs the bucket sort algorithm
+func (bs *BucketSort) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	maxValue := 99
+	if max, ok := parameters["max_value"].(int); ok {
+		maxValue = max
+	}
+
+	// Generate array if not provided
+	var arr []int
+	if input != nil {
+		if inputArr, ok := input.([]int); ok {
+			arr = inputArr
+		} else {
+			return nil, fmt.Errorf("invalid input type, expected []int")
+		}
+	} else if demo, ok := demoArray(bs.metadata.ID, parameters); ok {
+		arr = demo
+	} else {
+		// Generate random array
+		arraySize := 15
+		if size, ok := parameters["array_size"].(int); ok {
+			arraySize = size
+		}
+
+		rng := generators.NewRand(parameters)
+		arr = make([]int, arraySize)
+		for i := range arr {
+			arr[i] = rng.Intn(maxValue + 1)
+		}
+	}
+
+	numBuckets := 5
+	if n, ok := parameters["num_buckets"].(int); ok {
+		numBuckets = n
+	}
+
+	// Widen the range if supplied input exceeds max_value
+	for _, v := range arr {
+		if v < 0 {
+			return nil, fmt.Errorf("bucket sort requires non-negative integers, got %d", v)
+		}
+		if v > maxValue {
+			maxValue = v
+		}
+	}
+
+	verifier := newSortVerifier(arr, parameters)
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"array":       arr,
+			"num_buckets": numBuckets,
+			"max_value":   maxValue,
+		},
+		Message:   fmt.Sprintf("Starting Bucket Sort with %d buckets over the range 0-%d", numBuckets, maxValue),
+		Timestamp: time.Now(),
+	})
+
+	buckets := make([][]int, numBuckets)
+	for i := range buckets {
+		buckets[i] = []int{}
+	}
+	stepNumber := 1
+
+	// Scatter each element into the bucket covering its slice of the range.
+	// width * numBuckets exceeds maxValue, so every bucket index is in range,
+	// and nothing overflows however large the values are
+	width := maxValue/numBuckets + 1
+	for i, v := range arr {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		bucket := v / width
+		buckets[bucket] = append(buckets[bucket], v)
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "scatter",
+			Data: map[string]interface{}{
+				"array":   arr,
+				"index":   i,
+				"element": v,
+				"bucket":  bucket,
+				"buckets": buckets,
+			},
+			Message:   fmt.Sprintf("Placed %d into bucket %d", v, bucket),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+	}
+
+	// Insertion-sort every bucket
+	for b, bucket := range buckets {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		comparisons := 0
+		for i := 1; i < len(bucket); i++ {
+			key := bucket[i]
+			j := i - 1
+			for j >= 0 {
+				comparisons++
+				if bucket[j] <= key {
+					break
+				}
+				bucket[j+1] = bucket[j]
+				j--
+			}
+			bucket[j+1] = key
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "sort_bucket",
+			Data: map[string]interface{}{
+				"bucket":      b,
+				"contents":    bucket,
+				"buckets":     buckets,
+				"comparisons": comparisons,
+			},
+			Message:   fmt.Sprintf("Insertion-sorted bucket %d (%d elements)", b, len(bucket)),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+	}
+
+	// Gather the buckets in order
+	output := make([]int, 0, len(arr))
+	for _, bucket := range buckets {
+		output = append(output, bucket...)
+	}
+
+	stepCallback(types.ExecutionStep{
+		StepNumber: stepNumber,
+		Action:     "gather",
+		Data: map[string]interface{}{
+			"buckets": buckets,
+			"output":  output,
+		},
+		Message:   "Concatenated the sorted buckets",
+		Timestamp: time.Now(),
+	})
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data: map[string]interface{}{
+			"array":  output,
+			"sorted": true,
+		},
+		Message:   "Bucket Sort completed",
+		Timestamp: time.Now(),
+	})
+
+	return verifier.result(output, stepCallback), nil
+}
+
+// ValidateParameters validates the input parameters
+func (bs *BucketSort) ValidateParameters(parameters map[string]interface{}) error {
+	if arraySize, ok := parameters["array_size"].(int); ok {
+		if arraySize < 3 || arraySize > 50 {
+			return fmt.Errorf("array_size must be between 3 and 50")
+		}
+	}
+
+	if maxValue, ok := parameters["max_value"].(int); ok {
+		if maxValue < 1 || maxValue > 1000 {
+			return fmt.Errorf("max_value must be between 1 and 1000")
+		}
+	}
+
+	if numBuckets, ok := parameters["num_buckets"].(int); ok {
+		if numBuckets < 2 || numBuckets > 20 {
+			return fmt.Errorf("num_buckets must be between 2 and 20")
+		}
+	}
+
+	if err := validateInputPattern(parameters); err != nil {
+		return err
+	}
+
+	return validateVerify(parameters)
+}
+
+// SelfTest verifies the bucket sort implementation against a known input
+func (bs *BucketSort) SelfTest() error {
+	return selfTestSort(bs)
+}
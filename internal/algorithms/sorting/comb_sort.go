@@ -0,0 +1,201 @@
+package sorting
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"time"
+)
+
+// CombSort implements comb sort with a configurable gap shrink factor
+type CombSort struct {
+	metadata types.Algorithm
+}
+
+// NewCombSort creates a new CombSort instance
+func NewCombSort() *CombSort {
+	return &CombSort{
+		metadata: types.Algorithm{
+			ID:          "comb_sort",
+			Name:        "Comb Sort",
+			Category:    types.CategorySorting,
+			Description: "Improves on bubble sort by comparing elements a shrinking gap apart, moving small values from the end quickly. Once the gap reaches 1 it finishes with bubble sort passes.",
+			BigO:        "Time: O(n²) worst case, Ω(n log n) best case, Space: O(1)",
+			Parameters: []types.Parameter{
+				{
+					Name:        "array_size",
+					Type:        "int",
+					Description: "Size of the array to sort",
+					Default:     10,
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(100),
+					Required:    true,
+				},
+				{
+					Name:        "shrink_factor",
+					Type:        "float",
+					Description: "Factor the gap is divided by after each pass, greater than 1 and at most 2",
+					Default:     1.3,
+					Required:    false,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible array generation",
+					Default:     nil,
+					Required:    false,
+				},
+				inputPatternParameter(),
+				verifyParameter(),
+			},
+			InputType:  types.InputIntArray,
+			RelatedIDs: []string{"bubble_sort"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (cs *CombSort) GetMetadata() types.Algorithm {
+	return cs.metadata
+}
+
+// Execute runs the comb sort algorithm
+func (cs *CombSort) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	// Generate array if not provided
+	var arr []int
+	if input != nil {
+		if inputArr, ok := input.([]int); ok {
+			// Sort a copy in place, leaving the stored input as given
+			arr = append([]int{}, inputArr...)
+		} else {
+			return nil, fmt.Errorf("invalid input type, expected []int")
+		}
+	} else if demo, ok := demoArray(cs.metadata.ID, parameters); ok {
+		arr = demo
+	} else {
+		// Generate random array
+		arraySize := 10
+		if size, ok := parameters["array_size"].(int); ok {
+			arraySize = size
+		}
+
+		rng := generators.NewRand(parameters)
+		arr = rng.Perm(arraySize)
+		for i := range arr {
+			arr[i]++
+		}
+	}
+
+	shrinkFactor := 1.3
+	if s, ok := parameters["shrink_factor"].(float64); ok {
+		shrinkFactor = s
+	}
+
+	verifier := newSortVerifier(arr, parameters)
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"array":         arr,
+			"shrink_factor": shrinkFactor,
+			"comparisons":   0,
+			"swaps":         0,
+		},
+		Message:   fmt.Sprintf("Starting Comb Sort with shrink factor %g", shrinkFactor),
+		Timestamp: time.Now(),
+	})
+
+	n := len(arr)
+	gap := n
+	comparisons := 0
+	swaps := 0
+	stepNumber := 1
+
+	for sorted := false; !sorted; {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		gap = int(float64(gap) / shrinkFactor)
+		if gap <= 1 {
+			gap = 1
+		}
+
+		// A pass at gap 1 without swaps means the array is sorted
+		sorted = gap == 1
+		compared := [][]int{}
+		passSwaps := 0
+		for i := 0; i+gap < n; i++ {
+			comparisons++
+			compared = append(compared, []int{i, i + gap})
+			if arr[i] > arr[i+gap] {
+				arr[i], arr[i+gap] = arr[i+gap], arr[i]
+				swaps++
+				passSwaps++
+				sorted = false
+			}
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "gap_pass",
+			Data: map[string]interface{}{
+				"array":        arr,
+				"gap":          gap,
+				"compared":     compared,
+				"pass_swaps":   passSwaps,
+				"comparisons":  comparisons,
+				"swaps":        swaps,
+				"final_passes": gap == 1,
+			},
+			Message:   fmt.Sprintf("Pass with gap %d made %d comparisons and %d swaps", gap, len(compared), passSwaps),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data: map[string]interface{}{
+			"array":       arr,
+			"comparisons": comparisons,
+			"swaps":       swaps,
+			"sorted":      true,
+		},
+		Message:   fmt.Sprintf("Comb Sort completed with %d comparisons and %d swaps", comparisons, swaps),
+		Timestamp: time.Now(),
+	})
+
+	return verifier.result(arr, stepCallback), nil
+}
+
+// ValidateParameters validates the input parameters
+func (cs *CombSort) ValidateParameters(parameters map[string]interface{}) error {
+	if arraySize, ok := parameters["array_size"].(int); ok {
+		if arraySize < 3 || arraySize > 100 {
+			return fmt.Errorf("array_size must be between 3 and 100")
+		}
+	}
+
+	if shrinkFactor, exists := parameters["shrink_factor"]; exists {
+		s, ok := shrinkFactor.(float64)
+		if !ok || s <= 1.0 || s > 2.0 {
+			return fmt.Errorf("shrink_factor must be a number greater than 1.0 and at most 2.0")
+		}
+	}
+
+	if err := validateInputPattern(parameters); err != nil {
+		return err
+	}
+
+	return validateVerify(parameters)
+}
+
+// SelfTest verifies the comb sort implementation against a known input
+func (cs *CombSort) SelfTest() error {
+	return selfTestSort(cs)
+}
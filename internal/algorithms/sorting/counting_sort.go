@@ -1,11 +1,18 @@
 package sorting
 
 import (
+	"algorthmia/internal/algorithms/generators"
 	"algorthmia/internal/types"
+	"context"
 	"fmt"
+	"math/rand"
 	"time"
 )
 
+// maxCountingRange bounds max - min of the input, and with it the length of
+// the count array
+const maxCountingRange = 1000
+
 // CountingSort implements the counting sort algorithm
 type CountingSort struct {
 	metadata types.Algorithm
@@ -18,7 +25,7 @@ func NewCountingSort() *CountingSort {
 			ID:          "counting_sort",
 			Name:        "Counting Sort",
 			Category:    types.CategorySorting,
-			Description: "A non-comparison-based sorting algorithm that counts the number of objects having distinct key values.",
+			Description: "A non-comparison-based sorting algorithm that counts the number of objects having distinct key values. Each value v is counted at index v - min, so negative values sort too.",
 			BigO:        "Time: O(n + k), Space: O(k) where k is the range of input",
 			Parameters: []types.Parameter{
 				{
@@ -26,20 +33,31 @@ func NewCountingSort() *CountingSort {
 					Type:        "int",
 					Description: "Size of the array to sort",
 					Default:     10,
-					Min:         intPtr(3),
-					Max:         intPtr(50),
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(50),
 					Required:    true,
 				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible array generation",
+					Default:     nil,
+					Required:    false,
+				},
 				{
 					Name:        "max_value",
 					Type:        "int",
-					Description: "Maximum value in the array",
+					Description: "Maximum value in a generated array; supplied input sets its own range",
 					Default:     20,
-					Min:         intPtr(5),
-					Max:         intPtr(100),
+					Min:         types.IntPtr(5),
+					Max:         types.IntPtr(100),
 					Required:    true,
 				},
+				inputPatternParameter(),
+				verifyParameter(),
 			},
+			InputType:  types.InputIntArray,
+			RelatedIDs: []string{"radix_sort", "bucket_sort"},
 		},
 	}
 }
@@ -50,7 +68,7 @@ func (cs *CountingSort) GetMetadata() types.Algorithm {
 }
 
 // Execute runs the counting sort algorithm
-func (cs *CountingSort) Execute(input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+func (cs *CountingSort) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
 	// Generate array if not provided
 	var arr []int
 	if input != nil {
@@ -59,6 +77,8 @@ func (cs *CountingSort) Execute(input interface{}, parameters map[string]interfa
 		} else {
 			return nil, fmt.Errorf("invalid input type, expected []int")
 		}
+	} else if demo, ok := demoArray(cs.metadata.ID, parameters); ok {
+		arr = demo
 	} else {
 		// Generate random array
 		arraySize := 10
@@ -69,9 +89,15 @@ func (cs *CountingSort) Execute(input interface{}, parameters map[string]interfa
 		if max, ok := parameters["max_value"].(int); ok {
 			maxValue = max
 		}
-		arr = generateRandomArrayWithMax(arraySize, maxValue)
+		arr = generateRandomArrayWithMax(generators.NewRand(parameters), arraySize, maxValue)
 	}
 
+	if len(arr) == 0 {
+		return nil, fmt.Errorf("array must not be empty")
+	}
+
+	verifier := newSortVerifier(arr, parameters)
+
 	// Send initial state
 	stepCallback(types.ExecutionStep{
 		StepNumber: 0,
@@ -83,27 +109,37 @@ func (cs *CountingSort) Execute(input interface{}, parameters map[string]interfa
 		Timestamp: time.Now(),
 	})
 
-	// Find the maximum element
-	max := arr[0]
+	// Find the range of values; value v is counted at index v - min
+	min, max := arr[0], arr[0]
 	for _, v := range arr {
+		if v < min {
+			min = v
+		}
 		if v > max {
 			max = v
 		}
 	}
+	// The subtraction wraps negative when the range does not fit in an int
+	span := max - min
+	if span < 0 || span > maxCountingRange {
+		return nil, fmt.Errorf("counting sort needs values within a range of %d, got %d to %d", maxCountingRange, min, max)
+	}
 
 	stepCallback(types.ExecutionStep{
 		StepNumber: 1,
-		Action:     "find_max",
+		Action:     "find_range",
 		Data: map[string]interface{}{
 			"array":     arr,
+			"min_value": min,
 			"max_value": max,
+			"offset":    min,
 		},
-		Message:   fmt.Sprintf("Found maximum value: %d", max),
+		Message:   fmt.Sprintf("Values range from %d to %d, so the count array needs %d slots", min, max, span+1),
 		Timestamp: time.Now(),
 	})
 
 	// Create count array
-	count := make([]int, max+1)
+	count := make([]int, span+1)
 	output := make([]int, len(arr))
 
 	// Count occurrences
@@ -120,7 +156,10 @@ func (cs *CountingSort) Execute(input interface{}, parameters map[string]interfa
 	})
 
 	for i := 0; i < len(arr); i++ {
-		count[arr[i]]++
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		count[arr[i]-min]++
 
 		stepCallback(types.ExecutionStep{
 			StepNumber: 3 + i,
@@ -131,7 +170,7 @@ func (cs *CountingSort) Execute(input interface{}, parameters map[string]interfa
 				"element":     arr[i],
 				"index":       i,
 			},
-			Message:   fmt.Sprintf("Counted element %d, count now: %d", arr[i], count[arr[i]]),
+			Message:   fmt.Sprintf("Counted element %d, count now: %d", arr[i], count[arr[i]-min]),
 			Timestamp: time.Now(),
 		})
 	}
@@ -149,7 +188,7 @@ func (cs *CountingSort) Execute(input interface{}, parameters map[string]interfa
 		Timestamp: time.Now(),
 	})
 
-	for i := 1; i <= max; i++ {
+	for i := 1; i <= span; i++ {
 		count[i] += count[i-1]
 
 		stepCallback(types.ExecutionStep{
@@ -158,17 +197,17 @@ func (cs *CountingSort) Execute(input interface{}, parameters map[string]interfa
 			Data: map[string]interface{}{
 				"array":       arr,
 				"count_array": count,
-				"value":       i,
+				"value":       i + min,
 				"new_count":   count[i],
 			},
-			Message:   fmt.Sprintf("Updated count for value %d to position %d", i, count[i]),
+			Message:   fmt.Sprintf("Updated count for value %d to position %d", i+min, count[i]),
 			Timestamp: time.Now(),
 		})
 	}
 
 	// Build output array
 	stepCallback(types.ExecutionStep{
-		StepNumber: 4 + len(arr) + max + 1,
+		StepNumber: 4 + len(arr) + span + 1,
 		Action:     "build_output",
 		Data: map[string]interface{}{
 			"array":       arr,
@@ -181,20 +220,23 @@ func (cs *CountingSort) Execute(input interface{}, parameters map[string]interfa
 	})
 
 	for i := len(arr) - 1; i >= 0; i-- {
-		output[count[arr[i]]-1] = arr[i]
-		count[arr[i]]--
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		output[count[arr[i]-min]-1] = arr[i]
+		count[arr[i]-min]--
 
 		stepCallback(types.ExecutionStep{
-			StepNumber: 5 + len(arr) + max + (len(arr) - i),
+			StepNumber: 5 + len(arr) + span + (len(arr) - i),
 			Action:     "place_element",
 			Data: map[string]interface{}{
 				"array":       arr,
 				"count_array": count,
 				"output":      output,
 				"element":     arr[i],
-				"position":    count[arr[i]],
+				"position":    count[arr[i]-min],
 			},
-			Message:   fmt.Sprintf("Placed element %d at position %d", arr[i], count[arr[i]]),
+			Message:   fmt.Sprintf("Placed element %d at position %d", arr[i], count[arr[i]-min]),
 			Timestamp: time.Now(),
 		})
 	}
@@ -211,7 +253,7 @@ func (cs *CountingSort) Execute(input interface{}, parameters map[string]interfa
 		Timestamp: time.Now(),
 	})
 
-	return output, nil
+	return verifier.result(output, stepCallback), nil
 }
 
 // ValidateParameters validates the input parameters
@@ -228,21 +270,29 @@ func (cs *CountingSort) ValidateParameters(parameters map[string]interface{}) er
 		}
 	}
 
-	return nil
+	if err := validateInputPattern(parameters); err != nil {
+		return err
+	}
+
+	return validateVerify(parameters)
 }
 
-// Helper function to generate random array with max value
-func generateRandomArrayWithMax(size, maxValue int) []int {
+// SelfTest verifies the counting sort implementation against a known input
+func (cs *CountingSort) SelfTest() error {
+	return selfTestSort(cs)
+}
+
+// Helper function to generate random array with max value, cycling through
+// 1 to maxValue so every value appears before any repeats
+func generateRandomArrayWithMax(rng *rand.Rand, size, maxValue int) []int {
 	arr := make([]int, size)
 	for i := 0; i < size; i++ {
 		arr[i] = (i % maxValue) + 1
 	}
 
-	// Shuffle the array
-	for i := len(arr) - 1; i > 0; i-- {
-		j := i % (i + 1) // Simple shuffle for demo
+	rng.Shuffle(len(arr), func(i, j int) {
 		arr[i], arr[j] = arr[j], arr[i]
-	}
+	})
 
 	return arr
 }
@@ -0,0 +1,204 @@
+package sorting
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"time"
+)
+
+// CycleSort implements cycle sort, which writes each element at most once
+type CycleSort struct {
+	metadata types.Algorithm
+}
+
+// NewCycleSort creates a new CycleSort instance
+func NewCycleSort() *CycleSort {
+	return &CycleSort{
+		metadata: types.Algorithm{
+			ID:          "cycle_sort",
+			Name:        "Cycle Sort",
+			Category:    types.CategorySorting,
+			Description: "Places each element directly into its final position by counting the elements smaller than it, rotating the displaced element along the permutation cycle. It performs the minimum possible number of writes, which matters when writes are expensive.",
+			BigO:        "Time: O(n²), Space: O(1), Writes: at most n",
+			Parameters: []types.Parameter{
+				{
+					Name:        "array_size",
+					Type:        "int",
+					Description: "Size of the array to sort",
+					Default:     10,
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(50),
+					Required:    true,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible array generation",
+					Default:     nil,
+					Required:    false,
+				},
+				inputPatternParameter(),
+				verifyParameter(),
+			},
+			InputType:  types.InputIntArray,
+			RelatedIDs: []string{"counting_sort"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (cs *CycleSort) GetMetadata() types.Algorithm {
+	return cs.metadata
+}
+
+// Execute runs the cycle sort algorithm
+func (cs *CycleSort) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	// Generate array if not provided
+	var arr []int
+	if input != nil {
+		if inputArr, ok := input.([]int); ok {
+			// Sort a copy in place, leaving the stored input as given
+			arr = append([]int{}, inputArr...)
+		} else {
+			return nil, fmt.Errorf("invalid input type, expected []int")
+		}
+	} else if demo, ok := demoArray(cs.metadata.ID, parameters); ok {
+		arr = demo
+	} else {
+		// Generate random array
+		arraySize := 10
+		if size, ok := parameters["array_size"].(int); ok {
+			arraySize = size
+		}
+
+		arr = generators.Permutation(generators.NewRand(parameters), arraySize)
+	}
+
+	verifier := newSortVerifier(arr, parameters)
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"array":  arr,
+			"writes": 0,
+		},
+		Message:   "Starting Cycle Sort",
+		Timestamp: time.Now(),
+	})
+
+	n := len(arr)
+	writes := 0
+	stepNumber := 1
+
+	for cycleStart := 0; cycleStart < n-1; cycleStart++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		item := arr[cycleStart]
+
+		// findPosition counts the elements smaller than item to get its final index,
+		// skipping past equal values already in place
+		findPosition := func() int {
+			position := cycleStart
+			for i := cycleStart + 1; i < n; i++ {
+				if arr[i] < item {
+					position++
+				}
+			}
+			if position != cycleStart {
+				for item == arr[position] {
+					position++
+				}
+			}
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "find_position",
+				Data: map[string]interface{}{
+					"array":       arr,
+					"cycle_start": cycleStart,
+					"item":        item,
+					"position":    position,
+					"writes":      writes,
+				},
+				Message:   fmt.Sprintf("%d belongs at index %d", item, position),
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+
+			return position
+		}
+
+		// write places item at position and picks up the element it displaces
+		write := func(position int) {
+			arr[position], item = item, arr[position]
+			writes++
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "write",
+				Data: map[string]interface{}{
+					"array":       arr,
+					"cycle_start": cycleStart,
+					"position":    position,
+					"carried":     item,
+					"writes":      writes,
+				},
+				Message:   fmt.Sprintf("Wrote %d to index %d, now carrying %d", arr[position], position, item),
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+		}
+
+		position := findPosition()
+		if position == cycleStart {
+			continue
+		}
+		write(position)
+
+		// Rotate the rest of the cycle until an element lands back at its start
+		for position != cycleStart {
+			position = findPosition()
+			write(position)
+		}
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data: map[string]interface{}{
+			"array":  arr,
+			"writes": writes,
+			"sorted": true,
+		},
+		Message:   fmt.Sprintf("Cycle Sort completed with %d writes", writes),
+		Timestamp: time.Now(),
+	})
+
+	return verifier.result(arr, stepCallback), nil
+}
+
+// ValidateParameters validates the input parameters
+func (cs *CycleSort) ValidateParameters(parameters map[string]interface{}) error {
+	if arraySize, ok := parameters["array_size"].(int); ok {
+		if arraySize < 3 || arraySize > 50 {
+			return fmt.Errorf("array_size must be between 3 and 50")
+		}
+	}
+
+	if err := validateInputPattern(parameters); err != nil {
+		return err
+	}
+
+	return validateVerify(parameters)
+}
+
+// SelfTest verifies the cycle sort implementation against a known input
+func (cs *CycleSort) SelfTest() error {
+	return selfTestSort(cs)
+}
@@ -0,0 +1,73 @@
+package sorting
+
+import (
+	"algorthmia/internal/types"
+	"fmt"
+)
+
+// demoArrays are small curated inputs, one per sort, chosen so the
+// visualization shows what is characteristic about that algorithm
+var demoArrays = map[string][]int{
+	// A single small value at the end crawls back one position per pass
+	"bubble_sort": {2, 3, 4, 5, 6, 7, 8, 1},
+	// The same turtle is moved in one early pass by the wide gap
+	"comb_sort": {2, 3, 4, 5, 6, 7, 8, 1},
+	// Mostly ordered, so the gnome only steps back twice
+	"gnome_sort": {1, 2, 5, 3, 4, 8, 6, 7},
+	// The textbook example, splitting unevenly into halves of 4 and 3
+	"merge_sort": {38, 27, 43, 3, 9, 82, 10},
+	// The classic partition example, with the last element as the first pivot
+	"quick_sort": {10, 80, 30, 90, 40, 50, 70},
+	// Builds a heap with several sift-downs before extraction starts
+	"heap_sort": {4, 10, 3, 5, 1, 8, 7},
+	// Repeated values show counts above one
+	"counting_sort": {4, 2, 2, 8, 3, 3, 1},
+	// Values of one, two and three digits exercise every digit pass
+	"radix_sort": {170, 45, 75, 90, 802, 24, 2, 66},
+	// Spread across the default range so most buckets receive values
+	"bucket_sort": {29, 25, 3, 49, 9, 37, 21, 43, 95, 71},
+	// Ascending and descending runs; with a small min_run such as 4 they are
+	// detected, reversed where needed and merged
+	"tim_sort": {1, 2, 3, 9, 8, 7, 6, 4, 5, 10, 11, 12},
+	// Two separate cycles: 3 -> 1 -> 2 and 6 -> 4 -> 5
+	"cycle_sort": {3, 1, 2, 6, 4, 5},
+}
+
+// inputPatternParameter selects how a sort's input is produced when none is supplied
+func inputPatternParameter() types.Parameter {
+	return types.Parameter{
+		Name:        "input_pattern",
+		Type:        "string",
+		Description: "Input to generate when none is supplied: \"random\", or \"demo\" for a small curated array that animates clearly",
+		Default:     "random",
+		Required:    false,
+	}
+}
+
+// validateInputPattern checks that input_pattern, if given, is a known pattern
+func validateInputPattern(parameters map[string]interface{}) error {
+	pattern, exists := parameters["input_pattern"]
+	if !exists {
+		return nil
+	}
+
+	switch pattern {
+	case "random", "demo":
+		return nil
+	}
+	return fmt.Errorf("input_pattern must be \"random\" or \"demo\"")
+}
+
+// demoArray returns a copy of the algorithm's curated array when the demo
+// input pattern is requested
+func demoArray(algorithmID string, parameters map[string]interface{}) ([]int, bool) {
+	if pattern, _ := parameters["input_pattern"].(string); pattern != "demo" {
+		return nil, false
+	}
+
+	demo, exists := demoArrays[algorithmID]
+	if !exists {
+		return nil, false
+	}
+	return append([]int{}, demo...), true
+}
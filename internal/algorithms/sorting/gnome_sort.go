@@ -0,0 +1,175 @@
+package sorting
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"time"
+)
+
+// GnomeSort implements the gnome sort algorithm
+type GnomeSort struct {
+	metadata types.Algorithm
+}
+
+// NewGnomeSort creates a new GnomeSort instance
+func NewGnomeSort() *GnomeSort {
+	return &GnomeSort{
+		metadata: types.Algorithm{
+			ID:          "gnome_sort",
+			Name:        "Gnome Sort",
+			Category:    types.CategorySorting,
+			Description: "A gnome walks along the array, stepping forward while neighbours are in order and swapping them and stepping back when they are not.",
+			BigO:        "Time: O(n²), Space: O(1)",
+			Parameters: []types.Parameter{
+				{
+					Name:        "array_size",
+					Type:        "int",
+					Description: "Size of the array to sort",
+					Default:     10,
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(100),
+					Required:    true,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible array generation",
+					Default:     nil,
+					Required:    false,
+				},
+				inputPatternParameter(),
+				verifyParameter(),
+			},
+			InputType:  types.InputIntArray,
+			RelatedIDs: []string{"bubble_sort"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (gs *GnomeSort) GetMetadata() types.Algorithm {
+	return gs.metadata
+}
+
+// Execute runs the gnome sort algorithm
+func (gs *GnomeSort) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	// Generate array if not provided
+	var arr []int
+	if input != nil {
+		if inputArr, ok := input.([]int); ok {
+			// Sort a copy in place, leaving the stored input as given
+			arr = append([]int{}, inputArr...)
+		} else {
+			return nil, fmt.Errorf("invalid input type, expected []int")
+		}
+	} else if demo, ok := demoArray(gs.metadata.ID, parameters); ok {
+		arr = demo
+	} else {
+		// Generate random array
+		arraySize := 10
+		if size, ok := parameters["array_size"].(int); ok {
+			arraySize = size
+		}
+
+		arr = generators.Permutation(generators.NewRand(parameters), arraySize)
+	}
+
+	verifier := newSortVerifier(arr, parameters)
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"array":    arr,
+			"position": 0,
+		},
+		Message:   "Starting Gnome Sort",
+		Timestamp: time.Now(),
+	})
+
+	swaps := 0
+	moves := 0
+	stepNumber := 1
+
+	for position := 0; position < len(arr); {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if position == 0 || arr[position-1] <= arr[position] {
+			position++
+			moves++
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "forward",
+				Data: map[string]interface{}{
+					"array":    arr,
+					"position": position,
+					"swaps":    swaps,
+					"moves":    moves,
+				},
+				Message:   fmt.Sprintf("In order, gnome steps forward to position %d", position),
+				Timestamp: time.Now(),
+			})
+		} else {
+			arr[position-1], arr[position] = arr[position], arr[position-1]
+			swaps++
+			position--
+			moves++
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "back",
+				Data: map[string]interface{}{
+					"array":    arr,
+					"position": position,
+					"swapped":  []int{position, position + 1},
+					"swaps":    swaps,
+					"moves":    moves,
+				},
+				Message:   fmt.Sprintf("Swapped %d and %d, gnome steps back to position %d", arr[position+1], arr[position], position),
+				Timestamp: time.Now(),
+			})
+		}
+		stepNumber++
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data: map[string]interface{}{
+			"array":  arr,
+			"swaps":  swaps,
+			"moves":  moves,
+			"sorted": true,
+		},
+		Message:   fmt.Sprintf("Gnome Sort completed with %d swaps and %d moves", swaps, moves),
+		Timestamp: time.Now(),
+	})
+
+	return verifier.result(arr, stepCallback), nil
+}
+
+// ValidateParameters validates the input parameters
+func (gs *GnomeSort) ValidateParameters(parameters map[string]interface{}) error {
+	if arraySize, ok := parameters["array_size"].(int); ok {
+		if arraySize < 3 || arraySize > 100 {
+			return fmt.Errorf("array_size must be between 3 and 100")
+		}
+	}
+
+	if err := validateInputPattern(parameters); err != nil {
+		return err
+	}
+
+	return validateVerify(parameters)
+}
+
+// SelfTest verifies the gnome sort implementation against a known input
+func (gs *GnomeSort) SelfTest() error {
+	return selfTestSort(gs)
+}
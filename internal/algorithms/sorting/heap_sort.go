@@ -1,7 +1,9 @@
 package sorting
 
 import (
+	"algorthmia/internal/algorithms/generators"
 	"algorthmia/internal/types"
+	"context"
 	"fmt"
 	"time"
 )
@@ -26,10 +28,17 @@ func NewHeapSort() *HeapSort {
 					Type:        "int",
 					Description: "Size of the array to sort",
 					Default:     10,
-					Min:         intPtr(3),
-					Max:         intPtr(100),
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(100),
 					Required:    true,
 				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible array generation",
+					Default:     nil,
+					Required:    false,
+				},
 				{
 					Name:        "show_heap_structure",
 					Type:        "bool",
@@ -37,7 +46,11 @@ func NewHeapSort() *HeapSort {
 					Default:     true,
 					Required:    false,
 				},
+				inputPatternParameter(),
+				verifyParameter(),
 			},
+			InputType:  types.InputIntArray,
+			RelatedIDs: []string{"quick_sort", "merge_sort"},
 		},
 	}
 }
@@ -48,7 +61,7 @@ func (hs *HeapSort) GetMetadata() types.Algorithm {
 }
 
 // Execute runs the heap sort algorithm
-func (hs *HeapSort) Execute(input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+func (hs *HeapSort) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
 	// Generate array if not provided
 	var arr []int
 	if input != nil {
@@ -57,13 +70,15 @@ func (hs *HeapSort) Execute(input interface{}, parameters map[string]interface{}
 		} else {
 			return nil, fmt.Errorf("invalid input type, expected []int")
 		}
+	} else if demo, ok := demoArray(hs.metadata.ID, parameters); ok {
+		arr = demo
 	} else {
 		// Generate random array
 		arraySize := 10
 		if size, ok := parameters["array_size"].(int); ok {
 			arraySize = size
 		}
-		arr = generateRandomArray(arraySize)
+		arr = generators.Permutation(generators.NewRand(parameters), arraySize)
 	}
 
 	showHeapStructure := true
@@ -71,6 +86,12 @@ func (hs *HeapSort) Execute(input interface{}, parameters map[string]interface{}
 		showHeapStructure = show
 	}
 
+	verifier := newSortVerifier(arr, parameters)
+
+	// Progress counts the sift-downs that build the heap and the extractions
+	progress := newProgressTracker(len(arr)/2 + len(arr) - 1)
+	stepCallback = progress.wrap(stepCallback)
+
 	// Send initial state
 	stepCallback(types.ExecutionStep{
 		StepNumber: 0,
@@ -101,17 +122,25 @@ func (hs *HeapSort) Execute(input interface{}, parameters map[string]interface{}
 		Timestamp: time.Now(),
 	})
 
+	stepNumber := 2
 	for i := n/2 - 1; i >= 0; i-- {
-		hs.heapify(sortedArr, n, i, stepCallback, showHeapStructure, 2)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		stepNumber = heapify(sortedArr, n, i, stepCallback, showHeapStructure, stepNumber)
+		progress.advance(1)
 	}
 
 	// Extract elements from heap one by one
 	for i := n - 1; i > 0; i-- {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		// Move current root to end
 		sortedArr[0], sortedArr[i] = sortedArr[i], sortedArr[0]
 
 		stepCallback(types.ExecutionStep{
-			StepNumber: -1, // Dynamic step number
+			StepNumber: stepNumber,
 			Action:     "extract_max",
 			Data: map[string]interface{}{
 				"array":     sortedArr,
@@ -123,8 +152,11 @@ func (hs *HeapSort) Execute(input interface{}, parameters map[string]interface{}
 			Timestamp: time.Now(),
 		})
 
+		stepNumber++
+
 		// Call max heapify on the reduced heap
-		hs.heapify(sortedArr, i, 0, stepCallback, showHeapStructure, -1)
+		stepNumber = heapify(sortedArr, i, 0, stepCallback, showHeapStructure, stepNumber)
+		progress.advance(1)
 	}
 
 	// Send final result
@@ -139,11 +171,12 @@ func (hs *HeapSort) Execute(input interface{}, parameters map[string]interface{}
 		Timestamp: time.Now(),
 	})
 
-	return sortedArr, nil
+	return verifier.result(sortedArr, stepCallback), nil
 }
 
-// heapify maintains the heap property
-func (hs *HeapSort) heapify(arr []int, n, i int, stepCallback func(types.ExecutionStep), showHeapStructure bool, stepNumber int) {
+// heapify maintains the max-heap property of arr[:n] at index i, numbering
+// its steps from stepNumber, and returns the next step number
+func heapify(arr []int, n, i int, stepCallback func(types.ExecutionStep), showHeapStructure bool, stepNumber int) int {
 	largest := i
 	left := 2*i + 1
 	right := 2*i + 2
@@ -163,6 +196,7 @@ func (hs *HeapSort) heapify(arr []int, n, i int, stepCallback func(types.Executi
 			Message:   fmt.Sprintf("Checking heap property at index %d", i),
 			Timestamp: time.Now(),
 		})
+		stepNumber++
 	}
 
 	// If left child is larger than root
@@ -193,11 +227,14 @@ func (hs *HeapSort) heapify(arr []int, n, i int, stepCallback func(types.Executi
 				Message:   fmt.Sprintf("Swapped %d and %d to maintain heap property", arr[largest], arr[i]),
 				Timestamp: time.Now(),
 			})
+			stepNumber++
 		}
 
 		// Recursively heapify the affected sub-tree
-		hs.heapify(arr, n, largest, stepCallback, showHeapStructure, stepNumber)
+		return heapify(arr, n, largest, stepCallback, showHeapStructure, stepNumber)
 	}
+
+	return stepNumber
 }
 
 // ValidateParameters validates the input parameters
@@ -207,5 +244,15 @@ func (hs *HeapSort) ValidateParameters(parameters map[string]interface{}) error
 			return fmt.Errorf("array_size must be between 3 and 100")
 		}
 	}
-	return nil
+
+	if err := validateInputPattern(parameters); err != nil {
+		return err
+	}
+
+	return validateVerify(parameters)
+}
+
+// SelfTest verifies the heap sort implementation against a known input
+func (hs *HeapSort) SelfTest() error {
+	return selfTestSort(hs)
 }
@@ -0,0 +1,50 @@
+package sorting
+
+import (
+	"context"
+	"testing"
+
+	"algorthmia/internal/types"
+)
+
+// TestHeapSortStepNumbers checks that every step before the final one is
+// numbered in strictly increasing order, through both the heap building
+// and the extraction phases. Only the final step and the verification
+// after it are numbered -1
+func TestHeapSortStepNumbers(t *testing.T) {
+	inputs := [][]int{
+		{4, 8, 15, 16, 23, 42},
+		{9, 8, 7, 6, 5, 4, 3, 2, 1},
+		{1, 1, 1, 1},
+		{2, 1},
+	}
+
+	for _, input := range inputs {
+		last, final := -1, false
+		actions := map[string]bool{}
+		_, err := NewHeapSort().Execute(context.Background(), input, map[string]interface{}{"verify": true}, func(step types.ExecutionStep) {
+			actions[step.Action] = true
+			switch {
+			case final:
+				if step.StepNumber != -1 {
+					t.Errorf("%v: step %q is numbered %d after the final step", input, step.Action, step.StepNumber)
+				}
+			case step.StepNumber == -1:
+				final = true
+			case step.StepNumber <= last:
+				t.Errorf("%v: step %q is numbered %d after step %d", input, step.Action, step.StepNumber, last)
+			default:
+				last = step.StepNumber
+			}
+		})
+		if err != nil {
+			t.Fatalf("%v: %v", input, err)
+		}
+		if !final {
+			t.Errorf("%v: no final step", input)
+		}
+		if !actions["extract_max"] {
+			t.Errorf("%v: no extraction steps were checked", input)
+		}
+	}
+}
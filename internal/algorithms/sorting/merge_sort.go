@@ -1,7 +1,9 @@
 package sorting
 
 import (
+	"algorthmia/internal/algorithms/generators"
 	"algorthmia/internal/types"
+	"context"
 	"fmt"
 	"time"
 )
@@ -26,10 +28,17 @@ func NewMergeSort() *MergeSort {
 					Type:        "int",
 					Description: "Size of the array to sort",
 					Default:     10,
-					Min:         intPtr(3),
-					Max:         intPtr(100),
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(100),
 					Required:    true,
 				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible array generation",
+					Default:     nil,
+					Required:    false,
+				},
 				{
 					Name:        "show_divisions",
 					Type:        "bool",
@@ -37,7 +46,12 @@ func NewMergeSort() *MergeSort {
 					Default:     true,
 					Required:    false,
 				},
+				inputPatternParameter(),
+				verifyParameter(),
+				generators.EmitStackParameter(),
 			},
+			InputType:  types.InputIntArray,
+			RelatedIDs: []string{"quick_sort", "heap_sort", "tim_sort"},
 		},
 	}
 }
@@ -48,7 +62,7 @@ func (ms *MergeSort) GetMetadata() types.Algorithm {
 }
 
 // Execute runs the merge sort algorithm
-func (ms *MergeSort) Execute(input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+func (ms *MergeSort) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
 	// Generate array if not provided
 	var arr []int
 	if input != nil {
@@ -57,13 +71,15 @@ func (ms *MergeSort) Execute(input interface{}, parameters map[string]interface{
 		} else {
 			return nil, fmt.Errorf("invalid input type, expected []int")
 		}
+	} else if demo, ok := demoArray(ms.metadata.ID, parameters); ok {
+		arr = demo
 	} else {
 		// Generate random array
 		arraySize := 10
 		if size, ok := parameters["array_size"].(int); ok {
 			arraySize = size
 		}
-		arr = generateRandomArray(arraySize)
+		arr = generators.Permutation(generators.NewRand(parameters), arraySize)
 	}
 
 	showDivisions := true
@@ -71,6 +87,16 @@ func (ms *MergeSort) Execute(input interface{}, parameters map[string]interface{
 		showDivisions = show
 	}
 
+	verifier := newSortVerifier(arr, parameters)
+
+	// The recursion stack holds the [left, right] range of every call
+	// between the full array and the range being divided or merged
+	callStack := generators.NewDecisionStack(parameters)
+
+	// Progress is the share of all merging done, weighted by range size
+	progress := newProgressTracker(mergeWork(0, len(arr)-1))
+	stepCallback = progress.wrap(stepCallback)
+
 	// Send initial state
 	stepCallback(types.ExecutionStep{
 		StepNumber: 0,
@@ -88,7 +114,10 @@ func (ms *MergeSort) Execute(input interface{}, parameters map[string]interface{
 	copy(sortedArr, arr)
 
 	// Perform merge sort
-	ms.mergeSort(sortedArr, 0, len(sortedArr)-1, stepCallback, showDivisions, 1)
+	ms.mergeSort(ctx, sortedArr, 0, len(sortedArr)-1, stepCallback, showDivisions, progress, callStack, 1)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 
 	// Send final result
 	stepCallback(types.ExecutionStep{
@@ -102,26 +131,28 @@ func (ms *MergeSort) Execute(input interface{}, parameters map[string]interface{
 		Timestamp: time.Now(),
 	})
 
-	return sortedArr, nil
+	return verifier.result(sortedArr, stepCallback), nil
 }
 
 // mergeSort performs the recursive merge sort
-func (ms *MergeSort) mergeSort(arr []int, left, right int, stepCallback func(types.ExecutionStep), showDivisions bool, stepNumber int) int {
-	if left < right {
+func (ms *MergeSort) mergeSort(ctx context.Context, arr []int, left, right int, stepCallback func(types.ExecutionStep), showDivisions bool, progress *progressTracker, callStack *generators.DecisionStack, stepNumber int) int {
+	if left < right && ctx.Err() == nil {
 		mid := left + (right-left)/2
+		callStack.Push([]int{left, right})
+		defer callStack.Pop()
 
 		if showDivisions {
 			stepCallback(types.ExecutionStep{
 				StepNumber: stepNumber,
 				Action:     "divide",
-				Data: map[string]interface{}{
+				Data: callStack.WithDecisionStack(map[string]interface{}{
 					"array":       arr,
 					"left":        left,
 					"mid":         mid,
 					"right":       right,
 					"left_array":  arr[left : mid+1],
 					"right_array": arr[mid+1 : right+1],
-				},
+				}),
 				Message:   fmt.Sprintf("Dividing array from index %d to %d", left, right),
 				Timestamp: time.Now(),
 			})
@@ -129,35 +160,37 @@ func (ms *MergeSort) mergeSort(arr []int, left, right int, stepCallback func(typ
 		}
 
 		// Recursively sort left and right halves
-		stepNumber = ms.mergeSort(arr, left, mid, stepCallback, showDivisions, stepNumber)
-		stepNumber = ms.mergeSort(arr, mid+1, right, stepCallback, showDivisions, stepNumber)
+		stepNumber = ms.mergeSort(ctx, arr, left, mid, stepCallback, showDivisions, progress, callStack, stepNumber)
+		stepNumber = ms.mergeSort(ctx, arr, mid+1, right, stepCallback, showDivisions, progress, callStack, stepNumber)
 
 		// Merge the sorted halves
 		stepCallback(types.ExecutionStep{
 			StepNumber: stepNumber,
 			Action:     "merge",
-			Data: map[string]interface{}{
+			Data: callStack.WithDecisionStack(map[string]interface{}{
 				"array":       arr,
 				"left":        left,
 				"mid":         mid,
 				"right":       right,
 				"left_array":  arr[left : mid+1],
 				"right_array": arr[mid+1 : right+1],
-			},
+			}),
 			Message:   fmt.Sprintf("Merging sorted halves from %d to %d", left, right),
 			Timestamp: time.Now(),
 		})
 		stepNumber++
 
-		ms.merge(arr, left, mid, right, stepCallback, stepNumber)
+		mergeHalves(arr, left, mid, right, stepCallback, stepNumber)
+		progress.advance(right - left + 1)
 		stepNumber++
 	}
 
 	return stepNumber
 }
 
-// merge merges two sorted subarrays
-func (ms *MergeSort) merge(arr []int, left, mid, right int, stepCallback func(types.ExecutionStep), stepNumber int) {
+// mergeHalves merges the sorted subarrays arr[left..mid] and arr[mid+1..right],
+// returning the next step number
+func mergeHalves(arr []int, left, mid, right int, stepCallback func(types.ExecutionStep), stepNumber int) int {
 	// Create temporary arrays
 	leftArr := make([]int, mid-left+1)
 	rightArr := make([]int, right-mid)
@@ -208,6 +241,8 @@ func (ms *MergeSort) merge(arr []int, left, mid, right int, stepCallback func(ty
 		j++
 		k++
 	}
+
+	return stepNumber
 }
 
 // ValidateParameters validates the input parameters
@@ -217,5 +252,19 @@ func (ms *MergeSort) ValidateParameters(parameters map[string]interface{}) error
 			return fmt.Errorf("array_size must be between 3 and 100")
 		}
 	}
-	return nil
+
+	if err := validateInputPattern(parameters); err != nil {
+		return err
+	}
+
+	if err := generators.ValidateEmitStack(parameters); err != nil {
+		return err
+	}
+
+	return validateVerify(parameters)
+}
+
+// SelfTest verifies the merge sort implementation against a known input
+func (ms *MergeSort) SelfTest() error {
+	return selfTestSort(ms)
 }
@@ -0,0 +1,62 @@
+package sorting
+
+import (
+	"context"
+	"testing"
+
+	"algorthmia/internal/types"
+)
+
+// TestMergeSortEmitStack checks that the decision stack holds the range of
+// every call down to the one dividing or merging, and shrinks on return
+func TestMergeSortEmitStack(t *testing.T) {
+	parameters := map[string]interface{}{"emit_stack": true}
+
+	mergeSort := NewMergeSort()
+	if err := mergeSort.ValidateParameters(parameters); err != nil {
+		t.Fatalf("validating: %v", err)
+	}
+
+	var depths []int
+	_, err := mergeSort.Execute(context.Background(), []int{4, 3, 2, 1}, parameters, func(step types.ExecutionStep) {
+		if step.Action != "divide" && step.Action != "merge" {
+			return
+		}
+		stack := step.Data["decision_stack"].([]interface{})
+		top := stack[len(stack)-1].([]int)
+		if top[0] != step.Data["left"] || top[1] != step.Data["right"] {
+			t.Errorf("%s step over [%v, %v] has stack top %v", step.Action, step.Data["left"], step.Data["right"], top)
+		}
+		if bottom := stack[0].([]int); bottom[0] != 0 || bottom[1] != 3 {
+			t.Errorf("%s step has stack bottom %v, expected the full array", step.Action, bottom)
+		}
+		depths = append(depths, len(stack))
+	})
+	if err != nil {
+		t.Fatalf("executing: %v", err)
+	}
+
+	// divide [0,3], divide [0,1], merge [0,1], divide [2,3], merge [2,3], merge [0,3]
+	expected := []int{1, 2, 2, 2, 2, 1}
+	if len(depths) != len(expected) {
+		t.Fatalf("stack depths %v, expected %v", depths, expected)
+	}
+	for i := range expected {
+		if depths[i] != expected[i] {
+			t.Fatalf("stack depths %v, expected %v", depths, expected)
+		}
+	}
+
+	if err := mergeSort.ValidateParameters(map[string]interface{}{"emit_stack": 1}); err == nil {
+		t.Errorf("accepted a non-boolean emit_stack")
+	}
+
+	_, err = mergeSort.Execute(context.Background(), []int{4, 3, 2, 1}, map[string]interface{}{}, func(step types.ExecutionStep) {
+		if _, ok := step.Data["decision_stack"]; ok {
+			t.Fatalf("%s step has a decision stack without emit_stack", step.Action)
+		}
+	})
+	if err != nil {
+		t.Fatalf("executing: %v", err)
+	}
+}
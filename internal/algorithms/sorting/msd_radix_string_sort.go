@@ -0,0 +1,232 @@
+package sorting
+
+import (
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// defaultMSDStrings are sorted when no strings are supplied
+var defaultMSDStrings = []string{"she", "sells", "seashells", "by", "the", "sea", "shore", "shells", "sure"}
+
+// MSDRadixSort implements most-significant-digit radix sort for strings
+type MSDRadixSort struct {
+	metadata types.Algorithm
+}
+
+// NewMSDRadixSort creates a new MSDRadixSort instance
+func NewMSDRadixSort() *MSDRadixSort {
+	return &MSDRadixSort{
+		metadata: types.Algorithm{
+			ID:          "msd_radix_sort",
+			Name:        "MSD Radix Sort (Strings)",
+			Category:    types.CategorySorting,
+			Description: "Sorts strings by partitioning them into buckets on the character at one position, starting from the first, then recursively sorting each bucket on the next position. Strings that end early sort before longer ones.",
+			BigO:        "Time: O(n · w) for n strings of length up to w, Space: O(n + w · r) for an alphabet of size r",
+			Parameters: []types.Parameter{
+				{
+					Name:        "strings",
+					Type:        "array",
+					Description: "List of strings to sort",
+					Default:     defaultMSDStrings,
+					Required:    true,
+				},
+			},
+			RelatedIDs: []string{"radix_sort"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (ms *MSDRadixSort) GetMetadata() types.Algorithm {
+	return ms.metadata
+}
+
+// charBucket holds the strings sharing a character at the current position.
+// Char is empty for strings that end before that position
+type charBucket struct {
+	Char    string   `json:"char"`
+	Strings []string `json:"strings"`
+}
+
+// Execute runs MSD radix sort
+func (ms *MSDRadixSort) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	values, err := stringList(parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	arr := append([]string{}, values...)
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"strings": arr,
+		},
+		Message:   fmt.Sprintf("Starting MSD Radix Sort over %d strings", len(arr)),
+		Timestamp: time.Now(),
+	})
+
+	stepNumber := 1
+	ms.msdSort(ctx, arr, 0, len(arr), 0, stepCallback, &stepNumber)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data: map[string]interface{}{
+			"strings": arr,
+			"sorted":  true,
+		},
+		Message:   "MSD Radix Sort completed",
+		Timestamp: time.Now(),
+	})
+
+	return arr, nil
+}
+
+// msdSort sorts arr[lo:hi], whose strings share their first position characters
+func (ms *MSDRadixSort) msdSort(ctx context.Context, arr []string, lo, hi, position int, stepCallback func(types.ExecutionStep), stepNumber *int) {
+	if hi-lo <= 1 || ctx.Err() != nil {
+		return
+	}
+
+	// Partition by the character at position; strings that have ended come first
+	ended := []string{}
+	byChar := make(map[byte][]string)
+	chars := []byte{}
+	for _, s := range arr[lo:hi] {
+		if position >= len(s) {
+			ended = append(ended, s)
+			continue
+		}
+		c := s[position]
+		if _, seen := byChar[c]; !seen {
+			chars = append(chars, c)
+		}
+		byChar[c] = append(byChar[c], s)
+	}
+	sort.Slice(chars, func(i, j int) bool { return chars[i] < chars[j] })
+
+	buckets := []charBucket{}
+	if len(ended) > 0 {
+		buckets = append(buckets, charBucket{Char: "", Strings: ended})
+	}
+	for _, c := range chars {
+		buckets = append(buckets, charBucket{Char: string(c), Strings: byChar[c]})
+	}
+
+	// Write the buckets back in order
+	i := lo
+	for _, bucket := range buckets {
+		copy(arr[i:], bucket.Strings)
+		i += len(bucket.Strings)
+	}
+
+	stepCallback(types.ExecutionStep{
+		StepNumber: *stepNumber,
+		Action:     "partition_by_char",
+		Data: map[string]interface{}{
+			"strings":  append([]string{}, arr...),
+			"position": position,
+			"range":    []int{lo, hi},
+			"buckets":  buckets,
+		},
+		Message:   fmt.Sprintf("Partitioned %d strings into %d buckets on character %d", hi-lo, len(buckets), position),
+		Timestamp: time.Now(),
+	})
+	*stepNumber++
+
+	// Recurse into each bucket that still needs ordering on the next character
+	start := lo
+	for _, bucket := range buckets {
+		end := start + len(bucket.Strings)
+		if bucket.Char != "" && len(bucket.Strings) > 1 {
+			stepCallback(types.ExecutionStep{
+				StepNumber: *stepNumber,
+				Action:     "recurse_bucket",
+				Data: map[string]interface{}{
+					"strings":  append([]string{}, arr...),
+					"position": position + 1,
+					"range":    []int{start, end},
+					"bucket":   bucket,
+				},
+				Message:   fmt.Sprintf("Sorting the %d strings with %q at position %d by the next character", len(bucket.Strings), bucket.Char, position),
+				Timestamp: time.Now(),
+			})
+			*stepNumber++
+
+			ms.msdSort(ctx, arr, start, end, position+1, stepCallback, stepNumber)
+		}
+		start = end
+	}
+}
+
+// ValidateParameters validates the input parameters
+func (ms *MSDRadixSort) ValidateParameters(parameters map[string]interface{}) error {
+	_, err := stringList(parameters)
+	return err
+}
+
+// SelfTest verifies the MSD radix sort implementation against sort.Strings
+func (ms *MSDRadixSort) SelfTest() error {
+	expected := append([]string{}, defaultMSDStrings...)
+	sort.Strings(expected)
+
+	output, err := ms.Execute(context.Background(), nil, map[string]interface{}{}, func(types.ExecutionStep) {})
+	if err != nil {
+		return fmt.Errorf("execution failed: %v", err)
+	}
+
+	sorted := output.([]string)
+	for i := range expected {
+		if sorted[i] != expected[i] {
+			return fmt.Errorf("expected %v, got %v", expected, sorted)
+		}
+	}
+
+	return nil
+}
+
+// stringList reads the "strings" parameter, accepting either []string or a
+// decoded JSON array of strings
+func stringList(parameters map[string]interface{}) ([]string, error) {
+	raw, exists := parameters["strings"]
+	if !exists || raw == nil {
+		return defaultMSDStrings, nil
+	}
+
+	var values []string
+	switch list := raw.(type) {
+	case []string:
+		values = list
+	case []interface{}:
+		for i, item := range list {
+			s, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("strings[%d] must be a string", i)
+			}
+			values = append(values, s)
+		}
+	default:
+		return nil, fmt.Errorf("strings must be a list of strings")
+	}
+
+	if len(values) < 1 || len(values) > 50 {
+		return nil, fmt.Errorf("strings must contain between 1 and 50 entries")
+	}
+	for i, s := range values {
+		if len(s) > 30 {
+			return nil, fmt.Errorf("strings[%d] must be at most 30 characters", i)
+		}
+	}
+
+	return values, nil
+}
@@ -0,0 +1,52 @@
+package sorting
+
+import "algorthmia/internal/types"
+
+// progressTracker estimates how far a recursive sort has got as the
+// fraction of its total work finished so far, where the unit of work is
+// chosen per algorithm: elements merged, or elements in their final place
+type progressTracker struct {
+	done  int
+	total int
+}
+
+// newProgressTracker creates a tracker for the given amount of work
+func newProgressTracker(total int) *progressTracker {
+	return &progressTracker{total: total}
+}
+
+// advance records n more units of finished work
+func (p *progressTracker) advance(n int) {
+	p.done += n
+	if p.done > p.total {
+		p.done = p.total
+	}
+}
+
+// fraction returns the finished share of the work, from 0 to 1
+func (p *progressTracker) fraction() float64 {
+	if p.total == 0 {
+		return 1
+	}
+	return float64(p.done) / float64(p.total)
+}
+
+// wrap returns a step callback that stamps each step with the current
+// progress before passing it on
+func (p *progressTracker) wrap(stepCallback func(types.ExecutionStep)) func(types.ExecutionStep) {
+	return func(step types.ExecutionStep) {
+		progress := p.fraction()
+		step.Progress = &progress
+		stepCallback(step)
+	}
+}
+
+// mergeWork returns the total size of the ranges merge sort merges when
+// sorting arr[left..right], which is known before the sort starts
+func mergeWork(left, right int) int {
+	if left >= right {
+		return 0
+	}
+	mid := left + (right-left)/2
+	return mergeWork(left, mid) + mergeWork(mid+1, right) + right - left + 1
+}
@@ -0,0 +1,62 @@
+package sorting
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"algorthmia/internal/types"
+)
+
+// TestProgressMonotonic checks that the sorts estimating progress stamp
+// every step with a value that never decreases and ends at 1.0
+func TestProgressMonotonic(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	inputs := [][]int{
+		{2, 1},
+		{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		{10, 9, 8, 7, 6, 5, 4, 3, 2, 1},
+		{3, 3, 1, 3, 2, 2, 1, 3},
+		rng.Perm(37),
+		rng.Perm(64),
+	}
+
+	algorithms := []struct {
+		algorithm  types.AlgorithmExecutor
+		parameters map[string]interface{}
+	}{
+		{NewMergeSort(), map[string]interface{}{}},
+		{NewHeapSort(), map[string]interface{}{}},
+		{NewQuickSort(), map[string]interface{}{}},
+		{NewQuickSort(), map[string]interface{}{"pivot_strategy": "first"}},
+		{NewQuickSort(), map[string]interface{}{"introspective": true}},
+	}
+
+	for _, a := range algorithms {
+		id := a.algorithm.GetMetadata().ID
+		for _, input := range inputs {
+			last := 0.0
+			final := -1.0
+			_, err := a.algorithm.Execute(context.Background(), append([]int{}, input...), a.parameters, func(step types.ExecutionStep) {
+				if step.Progress == nil {
+					t.Errorf("%s %v: %s step has no progress", id, a.parameters, step.Action)
+					return
+				}
+				progress := *step.Progress
+				if progress < last || progress > 1 {
+					t.Errorf("%s %v on %v: progress went from %v to %v at %s", id, a.parameters, input, last, progress, step.Action)
+				}
+				last = progress
+				if step.StepNumber == -1 {
+					final = progress
+				}
+			})
+			if err != nil {
+				t.Fatalf("%s %v: %v", id, a.parameters, err)
+			}
+			if final != 1 {
+				t.Errorf("%s %v on %v: final progress %v, expected 1", id, a.parameters, input, final)
+			}
+		}
+	}
+}
@@ -1,7 +1,9 @@
 package sorting
 
 import (
+	"algorthmia/internal/algorithms/generators"
 	"algorthmia/internal/types"
+	"context"
 	"fmt"
 	"time"
 )
@@ -18,18 +20,25 @@ func NewQuickSort() *QuickSort {
 			ID:          "quick_sort",
 			Name:        "Quick Sort",
 			Category:    types.CategorySorting,
-			Description: "A divide-and-conquer algorithm that picks a pivot element and partitions the array around the pivot.",
-			BigO:        "Time: O(n log n) average, O(n²) worst case, Space: O(log n)",
+			Description: "A divide-and-conquer algorithm that picks a pivot element and partitions the array around the pivot. In introspective mode (Introsort) it heap sorts any region reached deeper than 2·log₂(n) partitions, as standard libraries do.",
+			BigO:        "Time: O(n log n) average, O(n²) worst case (O(n log n) when introspective), Space: O(log n)",
 			Parameters: []types.Parameter{
 				{
 					Name:        "array_size",
 					Type:        "int",
 					Description: "Size of the array to sort",
 					Default:     10,
-					Min:         intPtr(3),
-					Max:         intPtr(100),
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(100),
 					Required:    true,
 				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible array generation",
+					Default:     nil,
+					Required:    false,
+				},
 				{
 					Name:        "pivot_strategy",
 					Type:        "string",
@@ -37,7 +46,18 @@ func NewQuickSort() *QuickSort {
 					Default:     "middle",
 					Required:    false,
 				},
+				{
+					Name:        "introspective",
+					Type:        "bool",
+					Description: "Switch to heap sort for regions past a recursion depth of 2·log₂(n) (Introsort)",
+					Default:     false,
+					Required:    false,
+				},
+				inputPatternParameter(),
+				verifyParameter(),
 			},
+			InputType:  types.InputIntArray,
+			RelatedIDs: []string{"merge_sort", "heap_sort"},
 		},
 	}
 }
@@ -47,8 +67,18 @@ func (qs *QuickSort) GetMetadata() types.Algorithm {
 	return qs.metadata
 }
 
+// quickSortRun holds the per-execution settings and bookkeeping of a quick sort
+type quickSortRun struct {
+	pivotStrategy string
+	progress      *progressTracker
+	introspective bool
+	depthLimit    int    // Deepest partitioning level before switching to heap sort
+	maxDepth      int    // Deepest recursion level reached
+	strategies    []bool // Indices finished by heap sort
+}
+
 // Execute runs the quick sort algorithm
-func (qs *QuickSort) Execute(input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+func (qs *QuickSort) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
 	// Generate array if not provided
 	var arr []int
 	if input != nil {
@@ -57,13 +87,15 @@ func (qs *QuickSort) Execute(input interface{}, parameters map[string]interface{
 		} else {
 			return nil, fmt.Errorf("invalid input type, expected []int")
 		}
+	} else if demo, ok := demoArray(qs.metadata.ID, parameters); ok {
+		arr = demo
 	} else {
 		// Generate random array
 		arraySize := 10
 		if size, ok := parameters["array_size"].(int); ok {
 			arraySize = size
 		}
-		arr = generateRandomArray(arraySize)
+		arr = generators.Permutation(generators.NewRand(parameters), arraySize)
 	}
 
 	pivotStrategy := "middle"
@@ -71,6 +103,17 @@ func (qs *QuickSort) Execute(input interface{}, parameters map[string]interface{
 		pivotStrategy = strategy
 	}
 
+	introspective := false
+	if intro, ok := parameters["introspective"].(bool); ok {
+		introspective = intro
+	}
+
+	verifier := newSortVerifier(arr, parameters)
+
+	// Progress is the share of elements known to be in their final place
+	progress := newProgressTracker(len(arr))
+	stepCallback = progress.wrap(stepCallback)
+
 	// Send initial state
 	stepCallback(types.ExecutionStep{
 		StepNumber: 0,
@@ -78,6 +121,7 @@ func (qs *QuickSort) Execute(input interface{}, parameters map[string]interface{
 		Data: map[string]interface{}{
 			"array":          arr,
 			"pivot_strategy": pivotStrategy,
+			"introspective":  introspective,
 		},
 		Message:   "Starting Quick Sort",
 		Timestamp: time.Now(),
@@ -87,44 +131,166 @@ func (qs *QuickSort) Execute(input interface{}, parameters map[string]interface{
 	sortedArr := make([]int, len(arr))
 	copy(sortedArr, arr)
 
+	run := &quickSortRun{
+		pivotStrategy: pivotStrategy,
+		progress:      progress,
+		introspective: introspective,
+		depthLimit:    2 * floorLog2(len(arr)),
+		strategies:    make([]bool, len(arr)),
+	}
+
 	// Perform quick sort
-	qs.quickSort(sortedArr, 0, len(sortedArr)-1, stepCallback, pivotStrategy, 1)
+	qs.quickSort(ctx, sortedArr, 0, len(sortedArr)-1, stepCallback, run, 0, 1)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	completeData := map[string]interface{}{
+		"array":  sortedArr,
+		"sorted": true,
+	}
+	if introspective {
+		completeData["regions"] = run.regions()
+		completeData["max_depth"] = run.maxDepth
+	}
 
 	// Send final result
 	stepCallback(types.ExecutionStep{
 		StepNumber: -1, // Final step
 		Action:     "complete",
-		Data: map[string]interface{}{
-			"array":  sortedArr,
-			"sorted": true,
-		},
-		Message:   "Quick Sort completed",
-		Timestamp: time.Now(),
+		Data:       completeData,
+		Message:    "Quick Sort completed",
+		Timestamp:  time.Now(),
 	})
 
-	return sortedArr, nil
+	output := verifier.result(sortedArr, stepCallback)
+	if !introspective {
+		return output, nil
+	}
+
+	// Introsort reports how each region was finished alongside the array
+	result, ok := output.(map[string]interface{})
+	if !ok {
+		result = map[string]interface{}{"array": sortedArr}
+	}
+	result["regions"] = run.regions()
+	result["max_depth"] = run.maxDepth
+	return result, nil
 }
 
-// quickSort performs the recursive quick sort
-func (qs *QuickSort) quickSort(arr []int, low, high int, stepCallback func(types.ExecutionStep), pivotStrategy string, stepNumber int) int {
+// quickSort performs the recursive quick sort, handing regions past the
+// depth limit to heap sort in introspective mode
+func (qs *QuickSort) quickSort(ctx context.Context, arr []int, low, high int, stepCallback func(types.ExecutionStep), run *quickSortRun, depth, stepNumber int) int {
+	if ctx.Err() != nil {
+		return stepNumber
+	}
+	if depth > run.maxDepth {
+		run.maxDepth = depth
+	}
+
+	if low < high && run.introspective && depth > run.depthLimit {
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "switch_to_heapsort",
+			Data: map[string]interface{}{
+				"array":       arr,
+				"low":         low,
+				"high":        high,
+				"depth":       depth,
+				"depth_limit": run.depthLimit,
+			},
+			Message:   fmt.Sprintf("Depth %d exceeds the limit of %d; heap sorting indices %d to %d", depth, run.depthLimit, low, high),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+
+		heapSortRegion(arr[low:high+1], stepCallback, stepNumber)
+		for i := low; i <= high; i++ {
+			run.strategies[i] = true
+		}
+		run.progress.advance(high - low + 1)
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "heapsort_region",
+			Data: map[string]interface{}{
+				"array": arr,
+				"low":   low,
+				"high":  high,
+			},
+			Message:   fmt.Sprintf("Heap sorted indices %d to %d", low, high),
+			Timestamp: time.Now(),
+		})
+		return stepNumber + 1
+	}
+
 	if low < high {
 		// Partition the array and get pivot index
-		pivotIndex := qs.partition(arr, low, high, stepCallback, pivotStrategy, stepNumber)
+		pivotIndex := qs.partition(arr, low, high, stepCallback, run, stepNumber)
 		stepNumber++
 
 		// Recursively sort elements before and after partition
-		stepNumber = qs.quickSort(arr, low, pivotIndex-1, stepCallback, pivotStrategy, stepNumber)
-		stepNumber = qs.quickSort(arr, pivotIndex+1, high, stepCallback, pivotStrategy, stepNumber)
+		stepNumber = qs.quickSort(ctx, arr, low, pivotIndex-1, stepCallback, run, depth+1, stepNumber)
+		stepNumber = qs.quickSort(ctx, arr, pivotIndex+1, high, stepCallback, run, depth+1, stepNumber)
+	} else if low == high {
+		// A single element is already in its final place
+		run.progress.advance(1)
 	}
 
 	return stepNumber
 }
 
+// heapSortRegion sorts a subarray in place with the heap sort's heapify,
+// without emitting heap structure steps
+func heapSortRegion(region []int, stepCallback func(types.ExecutionStep), stepNumber int) {
+	n := len(region)
+	for i := n/2 - 1; i >= 0; i-- {
+		heapify(region, n, i, stepCallback, false, stepNumber)
+	}
+	for i := n - 1; i > 0; i-- {
+		region[0], region[i] = region[i], region[0]
+		heapify(region, i, 0, stepCallback, false, stepNumber)
+	}
+}
+
+// regions groups the array into consecutive runs finished by the same strategy
+func (run *quickSortRun) regions() []map[string]interface{} {
+	regions := []map[string]interface{}{}
+	for start := 0; start < len(run.strategies); {
+		end := start
+		for end+1 < len(run.strategies) && run.strategies[end+1] == run.strategies[start] {
+			end++
+		}
+
+		strategy := "quicksort"
+		if run.strategies[start] {
+			strategy = "heapsort"
+		}
+		regions = append(regions, map[string]interface{}{
+			"low":      start,
+			"high":     end,
+			"strategy": strategy,
+		})
+		start = end + 1
+	}
+	return regions
+}
+
+// floorLog2 returns ⌊log₂ n⌋ for n ≥ 1, and 0 otherwise
+func floorLog2(n int) int {
+	log := 0
+	for n > 1 {
+		n >>= 1
+		log++
+	}
+	return log
+}
+
 // partition partitions the array around a pivot
-func (qs *QuickSort) partition(arr []int, low, high int, stepCallback func(types.ExecutionStep), pivotStrategy string, stepNumber int) int {
+func (qs *QuickSort) partition(arr []int, low, high int, stepCallback func(types.ExecutionStep), run *quickSortRun, stepNumber int) int {
 	// Choose pivot based on strategy
 	var pivotIndex int
-	switch pivotStrategy {
+	switch run.pivotStrategy {
 	case "first":
 		pivotIndex = low
 	case "last":
@@ -196,6 +362,7 @@ func (qs *QuickSort) partition(arr []int, low, high int, stepCallback func(types
 
 	// Move pivot to its correct position
 	arr[i+1], arr[high] = arr[high], arr[i+1]
+	run.progress.advance(1)
 
 	stepCallback(types.ExecutionStep{
 		StepNumber: stepNumber,
@@ -235,5 +402,20 @@ func (qs *QuickSort) ValidateParameters(parameters map[string]interface{}) error
 		}
 	}
 
-	return nil
+	if introspective, exists := parameters["introspective"]; exists {
+		if _, ok := introspective.(bool); !ok {
+			return fmt.Errorf("introspective must be a boolean")
+		}
+	}
+
+	if err := validateInputPattern(parameters); err != nil {
+		return err
+	}
+
+	return validateVerify(parameters)
+}
+
+// SelfTest verifies the quick sort implementation against a known input
+func (qs *QuickSort) SelfTest() error {
+	return selfTestSort(qs)
 }
@@ -0,0 +1,86 @@
+package sorting
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"algorthmia/internal/types"
+)
+
+// TestIntrosortSwitchesOnSortedInput checks that sorted input with a
+// first-element pivot, quick sort's worst case, is handed to heap sort once
+// the recursion passes 2·⌊log₂ n⌋
+func TestIntrosortSwitchesOnSortedInput(t *testing.T) {
+	sorted := make([]int, 64)
+	for i := range sorted {
+		sorted[i] = i
+	}
+	depthLimit := 2 * floorLog2(len(sorted))
+
+	var switches []types.ExecutionStep
+	parameters := map[string]interface{}{"pivot_strategy": "first", "introspective": true, "verify": true}
+	output, err := NewQuickSort().Execute(context.Background(), append([]int{}, sorted...), parameters, func(step types.ExecutionStep) {
+		if step.Action == "switch_to_heapsort" {
+			switches = append(switches, step)
+		}
+	})
+	if err != nil {
+		t.Fatalf("executing: %v", err)
+	}
+
+	result := output.(map[string]interface{})
+	if correct, _ := result["correct"].(bool); !correct {
+		t.Fatalf("introsort returned %v", result["array"])
+	}
+	if len(switches) == 0 {
+		t.Fatalf("sorted input did not trigger the switch to heap sort")
+	}
+	for _, step := range switches {
+		if depth := step.Data["depth"].(int); depth != depthLimit+1 {
+			t.Errorf("switched at depth %d, expected %d", depth, depthLimit+1)
+		}
+	}
+	if maxDepth := result["max_depth"].(int); maxDepth != depthLimit+1 {
+		t.Errorf("recursion reached depth %d, expected it to stop at %d", maxDepth, depthLimit+1)
+	}
+
+	// The regions cover the array in order, and the tail was heap sorted
+	regions := result["regions"].([]map[string]interface{})
+	next := 0
+	for _, region := range regions {
+		if region["low"].(int) != next {
+			t.Fatalf("regions %v leave a gap before index %d", regions, region["low"])
+		}
+		next = region["high"].(int) + 1
+	}
+	if next != len(sorted) {
+		t.Errorf("regions %v end at %d, expected %d", regions, next, len(sorted))
+	}
+	if last := regions[len(regions)-1]; last["strategy"] != "heapsort" {
+		t.Errorf("last region %v was not heap sorted", last)
+	}
+}
+
+// TestQuickSortWithoutIntrosort checks that plain quick sort never switches
+// and keeps its plain array output, even on its worst case
+func TestQuickSortWithoutIntrosort(t *testing.T) {
+	input := make([]int, 64)
+	for i := range input {
+		input[i] = len(input) - i
+	}
+
+	output, err := NewQuickSort().Execute(context.Background(), input, map[string]interface{}{"pivot_strategy": "first"}, func(step types.ExecutionStep) {
+		if step.Action == "switch_to_heapsort" {
+			t.Fatalf("switched to heap sort without introspective")
+		}
+	})
+	if err != nil {
+		t.Fatalf("executing: %v", err)
+	}
+
+	sorted, ok := output.([]int)
+	if !ok || !sort.IntsAreSorted(sorted) {
+		t.Errorf("quick sort returned %v", output)
+	}
+}
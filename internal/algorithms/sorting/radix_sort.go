@@ -0,0 +1,248 @@
+package sorting
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"time"
+)
+
+// RadixSort implements least-significant-digit radix sort
+type RadixSort struct {
+	metadata types.Algorithm
+}
+
+// NewRadixSort creates a new RadixSort instance
+func NewRadixSort() *RadixSort {
+	return &RadixSort{
+		metadata: types.Algorithm{
+			ID:          "radix_sort",
+			Name:        "Radix Sort (LSD)",
+			Category:    types.CategorySorting,
+			Description: "A non-comparison sort that distributes numbers into buckets by one digit at a time, from least to most significant, reassembling them stably after every pass.",
+			BigO:        "Time: O(d · (n + b)), Space: O(n + b) where d is the number of digits and b the base",
+			Parameters: []types.Parameter{
+				{
+					Name:        "array_size",
+					Type:        "int",
+					Description: "Size of the array to sort",
+					Default:     10,
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(50),
+					Required:    true,
+				},
+				{
+					Name:        "max_value",
+					Type:        "int",
+					Description: "Maximum value in the array",
+					Default:     999,
+					Min:         types.IntPtr(1),
+					Max:         types.IntPtr(100000),
+					Required:    true,
+				},
+				{
+					Name:        "base",
+					Type:        "int",
+					Description: "Radix used to split numbers into digits: 2, 10 or 16",
+					Default:     10,
+					Required:    false,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible array generation",
+					Default:     nil,
+					Required:    false,
+				},
+				inputPatternParameter(),
+				verifyParameter(),
+			},
+			InputType:  types.InputIntArray,
+			RelatedIDs: []string{"counting_sort", "msd_radix_sort"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (rs *RadixSort) GetMetadata() types.Algorithm {
+	return rs.metadata
+}
+
+// Execute runs the radix sort algorithm
+func (rs *RadixSort) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	// Generate array if not provided
+	var arr []int
+	if input != nil {
+		if inputArr, ok := input.([]int); ok {
+			arr = inputArr
+		} else {
+			return nil, fmt.Errorf("invalid input type, expected []int")
+		}
+	} else if demo, ok := demoArray(rs.metadata.ID, parameters); ok {
+		arr = demo
+	} else {
+		// Generate random array
+		arraySize := 10
+		if size, ok := parameters["array_size"].(int); ok {
+			arraySize = size
+		}
+		maxValue := 999
+		if max, ok := parameters["max_value"].(int); ok {
+			maxValue = max
+		}
+
+		rng := generators.NewRand(parameters)
+		arr = make([]int, arraySize)
+		for i := range arr {
+			arr[i] = rng.Intn(maxValue + 1)
+		}
+	}
+
+	for _, v := range arr {
+		if v < 0 {
+			return nil, fmt.Errorf("radix sort requires non-negative integers, got %d", v)
+		}
+	}
+
+	base := 10
+	if b, ok := parameters["base"].(int); ok {
+		base = b
+	}
+
+	verifier := newSortVerifier(arr, parameters)
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"array": arr,
+			"base":  base,
+		},
+		Message:   fmt.Sprintf("Starting Radix Sort in base %d", base),
+		Timestamp: time.Now(),
+	})
+
+	max := 0
+	for _, v := range arr {
+		if v > max {
+			max = v
+		}
+	}
+
+	current := append([]int{}, arr...)
+	stepNumber := 1
+	passes := 0
+
+	// One stable counting pass per digit, least significant first
+	for place := 1; ; place *= base {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		count := make([]int, base)
+		buckets := make([][]int, base)
+		for _, v := range current {
+			digit := (v / place) % base
+			count[digit]++
+			buckets[digit] = append(buckets[digit], v)
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "count_digits",
+			Data: map[string]interface{}{
+				"array":         current,
+				"current_digit": passes,
+				"place_value":   place,
+				"count_array":   count,
+				"buckets":       buckets,
+			},
+			Message:   fmt.Sprintf("Distributed elements by digit %d (place value %d)", passes, place),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+
+		// Prefix sums give each bucket's end position in the output
+		for i := 1; i < base; i++ {
+			count[i] += count[i-1]
+		}
+
+		output := make([]int, len(current))
+		for i := len(current) - 1; i >= 0; i-- {
+			digit := (current[i] / place) % base
+			count[digit]--
+			output[count[digit]] = current[i]
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "build_output",
+			Data: map[string]interface{}{
+				"array":         current,
+				"current_digit": passes,
+				"place_value":   place,
+				"count_array":   count,
+				"output":        output,
+			},
+			Message:   fmt.Sprintf("Reassembled the array stably by digit %d", passes),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+
+		current = output
+		passes++
+
+		// Stop once no element has a digit at the next place value
+		if place > max/base {
+			break
+		}
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data: map[string]interface{}{
+			"array":  current,
+			"passes": passes,
+			"sorted": true,
+		},
+		Message:   fmt.Sprintf("Radix Sort completed in %d passes", passes),
+		Timestamp: time.Now(),
+	})
+
+	return verifier.result(current, stepCallback), nil
+}
+
+// ValidateParameters validates the input parameters
+func (rs *RadixSort) ValidateParameters(parameters map[string]interface{}) error {
+	if arraySize, ok := parameters["array_size"].(int); ok {
+		if arraySize < 3 || arraySize > 50 {
+			return fmt.Errorf("array_size must be between 3 and 50")
+		}
+	}
+
+	if maxValue, ok := parameters["max_value"].(int); ok {
+		if maxValue < 1 || maxValue > 100000 {
+			return fmt.Errorf("max_value must be between 1 and 100000")
+		}
+	}
+
+	if base, ok := parameters["base"].(int); ok {
+		if base != 2 && base != 10 && base != 16 {
+			return fmt.Errorf("base must be 2, 10 or 16")
+		}
+	}
+
+	if err := validateInputPattern(parameters); err != nil {
+		return err
+	}
+
+	return validateVerify(parameters)
+}
+
+// SelfTest verifies the radix sort implementation against a known input
+func (rs *RadixSort) SelfTest() error {
+	return selfTestSort(rs)
+}
@@ -0,0 +1,43 @@
+package sorting
+
+import (
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"sort"
+)
+
+// selfTestInput is the fixed input every sort is checked against
+var selfTestInput = []int{5, 3, 9, 1, 7, 2, 8, 2, 6, 4}
+
+// selfTestSort runs a sort on a fixed input and compares the output against sort.Ints
+func selfTestSort(algorithm types.AlgorithmExecutor) error {
+	input := make([]int, len(selfTestInput))
+	copy(input, selfTestInput)
+
+	expected := make([]int, len(selfTestInput))
+	copy(expected, selfTestInput)
+	sort.Ints(expected)
+
+	output, err := algorithm.Execute(context.Background(), input, map[string]interface{}{}, func(types.ExecutionStep) {})
+	if err != nil {
+		return fmt.Errorf("execution failed: %v", err)
+	}
+
+	sorted, ok := output.([]int)
+	if !ok {
+		return fmt.Errorf("unexpected output type %T", output)
+	}
+
+	if len(sorted) != len(expected) {
+		return fmt.Errorf("expected %d elements, got %d", len(expected), len(sorted))
+	}
+
+	for i := range expected {
+		if sorted[i] != expected[i] {
+			return fmt.Errorf("expected %v, got %v", expected, sorted)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package sorting
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"sort"
+	"testing"
+
+	"algorthmia/internal/types"
+)
+
+// arraySorts returns every sort that sorts an integer array
+func arraySorts() []types.AlgorithmExecutor {
+	return []types.AlgorithmExecutor{
+		NewBubbleSort(),
+		NewBucketSort(),
+		NewCombSort(),
+		NewCountingSort(),
+		NewCycleSort(),
+		NewGnomeSort(),
+		NewHeapSort(),
+		NewMergeSort(),
+		NewQuickSort(),
+		NewRadixSort(),
+		NewTimSort(),
+	}
+}
+
+// initialArray runs a sort and returns the array its initialize step shows
+func initialArray(t *testing.T, algorithm types.AlgorithmExecutor, parameters map[string]interface{}) []int {
+	t.Helper()
+
+	var initial []int
+	_, err := algorithm.Execute(context.Background(), nil, parameters, func(step types.ExecutionStep) {
+		if step.StepNumber == 0 && initial == nil {
+			initial = append([]int{}, step.Data["array"].([]int)...)
+		}
+	})
+	if err != nil {
+		t.Fatalf("%s: %v", algorithm.GetMetadata().ID, err)
+	}
+	return initial
+}
+
+// TestSortsDeclareSeed checks that every sort declares seed and that the
+// same seed generates the same array
+func TestSortsDeclareSeed(t *testing.T) {
+	for _, algorithm := range arraySorts() {
+		metadata := algorithm.GetMetadata()
+
+		declared := false
+		for _, parameter := range metadata.Parameters {
+			if parameter.Name == "seed" && parameter.Type == "int" && !parameter.Required {
+				declared = true
+			}
+		}
+		if !declared {
+			t.Errorf("%s does not declare an optional int seed", metadata.ID)
+			continue
+		}
+
+		parameters := map[string]interface{}{"array_size": 20, "seed": 42}
+		first := initialArray(t, algorithm, parameters)
+		second := initialArray(t, algorithm, parameters)
+		if len(first) != 20 || !reflect.DeepEqual(first, second) {
+			t.Errorf("%s generated %v and then %v from the same seed", metadata.ID, first, second)
+		}
+	}
+}
+
+// TestSortsAcceptIntArrayInput sorts supplied arrays, including negative
+// values, with every sort
+func TestSortsAcceptIntArrayInput(t *testing.T) {
+	// Bucket and radix sort are defined on non-negative integers only
+	nonNegativeOnly := map[string]bool{"bucket_sort": true, "radix_sort": true}
+
+	cases := []struct {
+		input    []int
+		negative bool
+	}{
+		{[]int{-3, 5, -1, 0, 2}, true},
+		{[]int{5, 3, 9, 1, 1, 0}, false},
+		{[]int{7}, false},
+		{[]int{4, 4, 4}, false},
+	}
+
+	for _, algorithm := range arraySorts() {
+		metadata := algorithm.GetMetadata()
+		if metadata.InputType != types.InputIntArray {
+			t.Errorf("%s declares input type %q, expected %q", metadata.ID, metadata.InputType, types.InputIntArray)
+		}
+
+		for _, c := range cases {
+			input := append([]int{}, c.input...)
+			output, err := algorithm.Execute(context.Background(), input, map[string]interface{}{}, func(types.ExecutionStep) {})
+
+			if c.negative && nonNegativeOnly[metadata.ID] {
+				if err == nil {
+					t.Errorf("%s accepted negative input %v", metadata.ID, c.input)
+				}
+				continue
+			}
+			if err != nil {
+				t.Errorf("%s rejected %v: %v", metadata.ID, c.input, err)
+				continue
+			}
+
+			expected := append([]int{}, c.input...)
+			sort.Ints(expected)
+			if !reflect.DeepEqual(output, expected) {
+				t.Errorf("%s sorted %v into %v, expected %v", metadata.ID, c.input, output, expected)
+			}
+			if !reflect.DeepEqual(input, c.input) {
+				t.Errorf("%s modified its input %v into %v", metadata.ID, c.input, input)
+			}
+		}
+	}
+}
+
+// TestSortsHandleExtremeValues checks that values at the limits of int are
+// sorted or rejected rather than overflowing into a panic
+func TestSortsHandleExtremeValues(t *testing.T) {
+	inputs := [][]int{
+		{math.MaxInt, 0, 5},
+		{math.MaxInt, math.MinInt, -1},
+	}
+
+	for _, algorithm := range arraySorts() {
+		for _, input := range inputs {
+			func() {
+				defer func() {
+					if recovered := recover(); recovered != nil {
+						t.Errorf("%s panicked on %v: %v", algorithm.GetMetadata().ID, input, recovered)
+					}
+				}()
+				algorithm.Execute(context.Background(), append([]int{}, input...), map[string]interface{}{}, func(types.ExecutionStep) {})
+			}()
+		}
+	}
+
+	// A range wider than int wraps max - min negative
+	if _, err := NewCountingSort().Execute(context.Background(), []int{math.MaxInt, -1}, map[string]interface{}{}, func(types.ExecutionStep) {}); err == nil {
+		t.Errorf("counting sort accepted a range wider than int")
+	}
+
+	output, err := NewBucketSort().Execute(context.Background(), []int{math.MaxInt, 0, 5}, map[string]interface{}{}, func(types.ExecutionStep) {})
+	if err != nil || !reflect.DeepEqual(output, []int{0, 5, math.MaxInt}) {
+		t.Errorf("bucket sort returned %v, %v for values up to MaxInt", output, err)
+	}
+}
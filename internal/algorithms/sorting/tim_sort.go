@@ -0,0 +1,262 @@
+package sorting
+
+import (
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"time"
+)
+
+// TimSort implements a simplified TimSort: natural run detection, insertion
+// sort to extend short runs, then pairwise run merging
+type TimSort struct {
+	metadata types.Algorithm
+}
+
+// NewTimSort creates a new TimSort instance
+func NewTimSort() *TimSort {
+	return &TimSort{
+		metadata: types.Algorithm{
+			ID:          "tim_sort",
+			Name:        "TimSort",
+			Category:    types.CategorySorting,
+			Description: "A hybrid sort that finds naturally ordered runs, extends short runs to a minimum length with insertion sort, then merges neighbouring runs until one remains.",
+			BigO:        "Time: O(n log n), O(n) on already ordered input, Space: O(n)",
+			Parameters: []types.Parameter{
+				{
+					Name:        "array_size",
+					Type:        "int",
+					Description: "Size of the array to sort",
+					Default:     40,
+					Min:         types.IntPtr(3),
+					Max:         types.IntPtr(100),
+					Required:    true,
+				},
+				{
+					Name:        "min_run",
+					Type:        "int",
+					Description: "Minimum run length; shorter natural runs are extended with insertion sort",
+					Default:     32,
+					Min:         types.IntPtr(2),
+					Max:         types.IntPtr(64),
+					Required:    false,
+				},
+				{
+					Name:        "seed",
+					Type:        "int",
+					Description: "Random seed for reproducible array generation",
+					Default:     nil,
+					Required:    false,
+				},
+				inputPatternParameter(),
+				verifyParameter(),
+			},
+			InputType:  types.InputIntArray,
+			RelatedIDs: []string{"merge_sort"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (ts *TimSort) GetMetadata() types.Algorithm {
+	return ts.metadata
+}
+
+// Execute runs the TimSort algorithm
+func (ts *TimSort) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	// Generate array if not provided
+	var arr []int
+	if input != nil {
+		if inputArr, ok := input.([]int); ok {
+			arr = inputArr
+		} else {
+			return nil, fmt.Errorf("invalid input type, expected []int")
+		}
+	} else if demo, ok := demoArray(ts.metadata.ID, parameters); ok {
+		arr = demo
+	} else {
+		// Generate random array
+		arraySize := 40
+		if size, ok := parameters["array_size"].(int); ok {
+			arraySize = size
+		}
+
+		arr = generators.Permutation(generators.NewRand(parameters), arraySize)
+	}
+
+	minRun := 32
+	if m, ok := parameters["min_run"].(int); ok {
+		minRun = m
+	}
+
+	verifier := newSortVerifier(arr, parameters)
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"array":   arr,
+			"min_run": minRun,
+		},
+		Message:   fmt.Sprintf("Starting TimSort with minimum run length %d", minRun),
+		Timestamp: time.Now(),
+	})
+
+	// Work on a copy to avoid modifying the original
+	sortedArr := make([]int, len(arr))
+	copy(sortedArr, arr)
+
+	n := len(sortedArr)
+	runs := [][]int{} // [start, end] inclusive
+	stepNumber := 1
+
+	for start := 0; start < n; {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		// Find the natural run starting here, reversing it if strictly descending
+		end := start
+		descending := false
+		if start+1 < n {
+			end = start + 1
+			descending = sortedArr[end] < sortedArr[start]
+			for end+1 < n && (sortedArr[end+1] < sortedArr[end]) == descending {
+				end++
+			}
+		}
+		if descending {
+			for i, j := start, end; i < j; i, j = i+1, j-1 {
+				sortedArr[i], sortedArr[j] = sortedArr[j], sortedArr[i]
+			}
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "detect_run",
+			Data: map[string]interface{}{
+				"array":      sortedArr,
+				"run_start":  start,
+				"run_end":    end,
+				"descending": descending,
+				"runs":       runs,
+			},
+			Message:   fmt.Sprintf("Found a natural run from %d to %d", start, end),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+
+		// Extend short runs to the minimum length with insertion sort
+		if end-start+1 < minRun && end < n-1 {
+			natural := end
+			end = start + minRun - 1
+			if end > n-1 {
+				end = n - 1
+			}
+			for i := natural + 1; i <= end; i++ {
+				key := sortedArr[i]
+				j := i - 1
+				for j >= start && sortedArr[j] > key {
+					sortedArr[j+1] = sortedArr[j]
+					j--
+				}
+				sortedArr[j+1] = key
+			}
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "extend_run",
+				Data: map[string]interface{}{
+					"array":       sortedArr,
+					"run_start":   start,
+					"natural_end": natural,
+					"run_end":     end,
+					"runs":        runs,
+				},
+				Message:   fmt.Sprintf("Extended the run to %d..%d with insertion sort", start, end),
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+		}
+
+		runs = append(runs, []int{start, end})
+		start = end + 1
+	}
+
+	// Merge neighbouring runs until a single run remains
+	for len(runs) > 1 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		merged := [][]int{}
+		for i := 0; i < len(runs); i += 2 {
+			if i+1 == len(runs) {
+				merged = append(merged, runs[i])
+				continue
+			}
+
+			left, mid, right := runs[i][0], runs[i][1], runs[i+1][1]
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "merge",
+				Data: map[string]interface{}{
+					"array":       sortedArr,
+					"left":        left,
+					"mid":         mid,
+					"right":       right,
+					"left_array":  sortedArr[left : mid+1],
+					"right_array": sortedArr[mid+1 : right+1],
+					"runs":        runs,
+				},
+				Message:   fmt.Sprintf("Merging runs from %d to %d", left, right),
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+
+			stepNumber = mergeHalves(sortedArr, left, mid, right, stepCallback, stepNumber)
+			merged = append(merged, []int{left, right})
+		}
+		runs = merged
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data: map[string]interface{}{
+			"array":  sortedArr,
+			"sorted": true,
+		},
+		Message:   "TimSort completed",
+		Timestamp: time.Now(),
+	})
+
+	return verifier.result(sortedArr, stepCallback), nil
+}
+
+// ValidateParameters validates the input parameters
+func (ts *TimSort) ValidateParameters(parameters map[string]interface{}) error {
+	if arraySize, ok := parameters["array_size"].(int); ok {
+		if arraySize < 3 || arraySize > 100 {
+			return fmt.Errorf("array_size must be between 3 and 100")
+		}
+	}
+
+	if minRun, ok := parameters["min_run"].(int); ok {
+		if minRun < 2 || minRun > 64 {
+			return fmt.Errorf("min_run must be between 2 and 64")
+		}
+	}
+
+	if err := validateInputPattern(parameters); err != nil {
+		return err
+	}
+
+	return validateVerify(parameters)
+}
+
+// SelfTest verifies the TimSort implementation against a known input
+func (ts *TimSort) SelfTest() error {
+	return selfTestSort(ts)
+}
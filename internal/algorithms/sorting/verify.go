@@ -0,0 +1,96 @@
+package sorting
+
+import (
+	"algorthmia/internal/types"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// verifyParameter is the optional flag that checks a sort's output against sort.Ints
+func verifyParameter() types.Parameter {
+	return types.Parameter{
+		Name:        "verify",
+		Type:        "bool",
+		Description: "Check the result against a reference sort; the output becomes {array, correct} instead of the plain array",
+		Default:     false,
+		Required:    false,
+	}
+}
+
+// validateVerify checks that the verify flag, if given, is a boolean
+func validateVerify(parameters map[string]interface{}) error {
+	if verify, exists := parameters["verify"]; exists {
+		if _, ok := verify.(bool); !ok {
+			return fmt.Errorf("verify must be a boolean")
+		}
+	}
+	return nil
+}
+
+// sortVerifier keeps a copy of a sort's input when verification is requested
+type sortVerifier struct {
+	original []int
+	enabled  bool
+}
+
+// newSortVerifier captures the input before it is sorted, if the verify flag is set
+func newSortVerifier(arr []int, parameters map[string]interface{}) *sortVerifier {
+	enabled, _ := parameters["verify"].(bool)
+	if !enabled {
+		return &sortVerifier{}
+	}
+
+	return &sortVerifier{
+		original: append([]int{}, arr...),
+		enabled:  true,
+	}
+}
+
+// result returns the sort's output. With verification enabled it emits a
+// verification step comparing the output against sort.Ints and wraps the
+// sorted array together with a correct flag, so verified runs change the
+// output's shape from an array to an object
+func (v *sortVerifier) result(sorted []int, stepCallback func(types.ExecutionStep)) interface{} {
+	if !v.enabled {
+		return sorted
+	}
+
+	expected := append([]int{}, v.original...)
+	sort.Ints(expected)
+
+	correct := len(sorted) == len(expected)
+	mismatch := -1
+	for i := 0; correct && i < len(expected); i++ {
+		if sorted[i] != expected[i] {
+			correct = false
+			mismatch = i
+		}
+	}
+
+	message := "Output matches the reference sort"
+	if !correct {
+		message = "Output does not match the reference sort"
+		if mismatch >= 0 {
+			message = fmt.Sprintf("Output differs from the reference sort at index %d", mismatch)
+		}
+	}
+
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "verification",
+		Data: map[string]interface{}{
+			"array":    sorted,
+			"expected": expected,
+			"correct":  correct,
+			"mismatch": mismatch,
+		},
+		Message:   message,
+		Timestamp: time.Now(),
+	})
+
+	return map[string]interface{}{
+		"array":   sorted,
+		"correct": correct,
+	}
+}
@@ -0,0 +1,92 @@
+package sorting
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"algorthmia/internal/types"
+)
+
+// brokenSort is a deliberately wrong sort: it swaps the first two elements
+// and stops, then reports its result through the shared verifier
+type brokenSort struct{}
+
+func (b *brokenSort) GetMetadata() types.Algorithm {
+	return types.Algorithm{ID: "broken_sort", Category: types.CategorySorting, InputType: types.InputIntArray}
+}
+
+func (b *brokenSort) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	arr := append([]int{}, input.([]int)...)
+	verifier := newSortVerifier(arr, parameters)
+
+	if len(arr) > 1 {
+		arr[0], arr[1] = arr[1], arr[0]
+	}
+	return verifier.result(arr, stepCallback), nil
+}
+
+func (b *brokenSort) ValidateParameters(parameters map[string]interface{}) error {
+	return validateVerify(parameters)
+}
+
+// verificationStep runs a sort with verify set and returns its output and
+// the verification step it emitted
+func verificationStep(t *testing.T, algorithm types.AlgorithmExecutor, input []int) (map[string]interface{}, *types.ExecutionStep) {
+	t.Helper()
+
+	var verification *types.ExecutionStep
+	output, err := algorithm.Execute(context.Background(), input, map[string]interface{}{"verify": true}, func(step types.ExecutionStep) {
+		if step.Action == "verification" {
+			verification = &step
+		}
+	})
+	if err != nil {
+		t.Fatalf("%s: %v", algorithm.GetMetadata().ID, err)
+	}
+
+	result, ok := output.(map[string]interface{})
+	if !ok {
+		t.Fatalf("%s: verified output is %T, expected an object", algorithm.GetMetadata().ID, output)
+	}
+	return result, verification
+}
+
+func TestVerifyCatchesBrokenSort(t *testing.T) {
+	result, step := verificationStep(t, &brokenSort{}, []int{1, 2, 3, 4})
+
+	if result["correct"] != false {
+		t.Errorf("broken sort verified as correct: %v", result)
+	}
+	if !reflect.DeepEqual(result["array"], []int{2, 1, 3, 4}) {
+		t.Errorf("verified output array is %v, expected the broken sort's own output", result["array"])
+	}
+	if step == nil {
+		t.Fatalf("no verification step was emitted")
+	}
+	if step.Data["correct"] != false || step.Data["mismatch"] != 0 {
+		t.Errorf("verification step reports correct %v at mismatch %v, expected false at 0", step.Data["correct"], step.Data["mismatch"])
+	}
+}
+
+func TestVerifyPassesRealSorts(t *testing.T) {
+	for _, algorithm := range arraySorts() {
+		result, step := verificationStep(t, algorithm, []int{9, 4, 7, 1, 8, 2})
+		if result["correct"] != true || step == nil || step.Data["correct"] != true {
+			t.Errorf("%s failed verification: %v", algorithm.GetMetadata().ID, result)
+		}
+		if !reflect.DeepEqual(result["array"], []int{1, 2, 4, 7, 8, 9}) {
+			t.Errorf("%s verified output array is %v", algorithm.GetMetadata().ID, result["array"])
+		}
+	}
+}
+
+func TestVerifyOffLeavesOutputPlain(t *testing.T) {
+	output, err := (&brokenSort{}).Execute(context.Background(), []int{1, 2}, map[string]interface{}{}, func(types.ExecutionStep) {})
+	if err != nil || !reflect.DeepEqual(output, []int{2, 1}) {
+		t.Errorf("unverified output is %v, %v; expected the plain array", output, err)
+	}
+	if err := (&brokenSort{}).ValidateParameters(map[string]interface{}{"verify": "yes"}); err == nil {
+		t.Errorf("a string verify flag was accepted")
+	}
+}
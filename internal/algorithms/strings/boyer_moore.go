@@ -0,0 +1,281 @@
+package strings
+
+import (
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"time"
+)
+
+// BoyerMoore implements Boyer-Moore string search with the bad-character
+// and good-suffix heuristics
+type BoyerMoore struct {
+	metadata types.Algorithm
+}
+
+// NewBoyerMoore creates a new BoyerMoore instance
+func NewBoyerMoore() *BoyerMoore {
+	return &BoyerMoore{
+		metadata: types.Algorithm{
+			ID:          "boyer_moore",
+			Name:        "Boyer-Moore",
+			Category:    types.CategoryStrings,
+			Description: "Compares the pattern against the text from right to left. On a mismatch two rules each propose a shift. The bad-character rule lines up the mismatched text character with its last occurrence in the pattern. The good-suffix rule lines up the suffix already matched with its next occurrence in the pattern. The larger shift is taken, so on typical text most characters are never compared.",
+			BigO:        "Time: O(n / m) best case, O(n · m) worst case, Space: O(m + σ) for text length n, pattern length m and alphabet size σ",
+			Parameters:  matchParameters(),
+			RelatedIDs:  []string{"kmp", "naive_string_match"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (bm *BoyerMoore) GetMetadata() types.Algorithm {
+	return bm.metadata
+}
+
+// goodSuffixShifts returns, for each pattern index j, how far to shift when
+// pattern[j:] has matched and pattern[j-1] mismatched; index 0 is the shift
+// after a full match
+func goodSuffixShifts(pattern string) []int {
+	m := len(pattern)
+	shift := make([]int, m+1)
+	border := make([]int, m+1) // border[i] starts the widest border of pattern[i:]
+
+	// Suffixes whose border reappears with a different preceding character
+	i, j := m, m+1
+	border[i] = j
+	for i > 0 {
+		for j <= m && pattern[i-1] != pattern[j-1] {
+			if shift[j] == 0 {
+				shift[j] = j - i
+			}
+			j = border[j]
+		}
+		i--
+		j--
+		border[i] = j
+	}
+
+	// Otherwise shift so the widest border of the whole pattern lines up
+	j = border[0]
+	for i := 0; i <= m; i++ {
+		if shift[i] == 0 {
+			shift[i] = j
+		}
+		if i == j {
+			j = border[j]
+		}
+	}
+
+	return shift
+}
+
+// Execute searches the text with both heuristics
+func (bm *BoyerMoore) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	text, pattern := textAndPattern(parameters)
+	m := len(pattern)
+
+	// Last index of each character in the pattern
+	lastOccurrence := map[string]int{}
+	for i := 0; i < m; i++ {
+		lastOccurrence[string(pattern[i])] = i
+	}
+	goodSuffix := goodSuffixShifts(pattern)
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"text":            text,
+			"pattern":         pattern,
+			"last_occurrence": lastOccurrence,
+			"good_suffix":     goodSuffix,
+		},
+		Message:   fmt.Sprintf("Searching for %q in a text of %d characters", pattern, len(text)),
+		Timestamp: time.Now(),
+	})
+
+	matches := []int{}
+	comparisons := 0
+	wins := map[string]int{"bad_character": 0, "good_suffix": 0, "tie": 0}
+	stepNumber := 1
+
+	for shift := 0; shift+m <= len(text); {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "align",
+			Data: map[string]interface{}{
+				"shift":       shift,
+				"comparisons": comparisons,
+			},
+			Message:   fmt.Sprintf("Pattern aligned at position %d", shift),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+
+		// Compare right to left
+		j := m - 1
+		for j >= 0 {
+			comparisons++
+			matched := pattern[j] == text[shift+j]
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "compare",
+				Data: map[string]interface{}{
+					"shift":         shift,
+					"text_index":    shift + j,
+					"pattern_index": j,
+					"matched":       matched,
+					"comparisons":   comparisons,
+				},
+				Message:   fmt.Sprintf("Compared text[%d]=%q with pattern[%d]=%q", shift+j, text[shift+j], j, pattern[j]),
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+
+			if !matched {
+				break
+			}
+			j--
+		}
+
+		var badCharacter interface{}
+		heuristic := "good_suffix"
+		distance := goodSuffix[j+1]
+		message := fmt.Sprintf("Good suffix: shifted by %d to line up the matched suffix again", distance)
+
+		if j < 0 {
+			matches = append(matches, shift)
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "match_found",
+				Data: map[string]interface{}{
+					"shift":       shift,
+					"matches":     matches,
+					"comparisons": comparisons,
+				},
+				Message:   fmt.Sprintf("Pattern found at position %d", shift),
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+
+			// Only the good-suffix rule applies after a full match
+			message = fmt.Sprintf("Good suffix: shifted by %d to the next possible overlap", distance)
+		} else {
+			mismatched := string(text[shift+j])
+			bad := j + 1
+			if last, exists := lastOccurrence[mismatched]; exists {
+				bad = j - last
+			}
+			if bad < 1 {
+				bad = 1
+			}
+			badCharacter = bad
+
+			switch {
+			case bad > distance:
+				heuristic = "bad_character"
+				distance = bad
+				message = fmt.Sprintf("Bad character %q: shifted by %d, beating the good suffix's %d", mismatched, bad, goodSuffix[j+1])
+			case bad == distance:
+				heuristic = "tie"
+				message = fmt.Sprintf("Both rules shift by %d", distance)
+			default:
+				message = fmt.Sprintf("Good suffix: shifted by %d, beating the bad character's %d", distance, bad)
+			}
+		}
+		wins[heuristic]++
+
+		if shift+distance+m <= len(text) {
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "shift",
+				Data: map[string]interface{}{
+					"shift":               shift + distance,
+					"distance":            distance,
+					"heuristic":           heuristic,
+					"bad_character_shift": badCharacter,
+					"good_suffix_shift":   goodSuffix[j+1],
+					"comparisons":         comparisons,
+				},
+				Message:   message,
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+		}
+
+		shift += distance
+	}
+
+	dominant := "tie"
+	if wins["bad_character"] > wins["good_suffix"] {
+		dominant = "bad_character"
+	} else if wins["good_suffix"] > wins["bad_character"] {
+		dominant = "good_suffix"
+	}
+
+	result := matchResult(matches, comparisons)
+	result["heuristic_wins"] = wins
+	result["dominant_heuristic"] = dominant
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data: map[string]interface{}{
+			"matches":            matches,
+			"comparisons":        comparisons,
+			"heuristic_wins":     wins,
+			"dominant_heuristic": dominant,
+		},
+		Message:   fmt.Sprintf("Boyer-Moore found %d matches with %d comparisons; the %s rule chose most shifts", len(matches), comparisons, dominant),
+		Timestamp: time.Now(),
+	})
+
+	return result, nil
+}
+
+// ValidateParameters validates the input parameters
+func (bm *BoyerMoore) ValidateParameters(parameters map[string]interface{}) error {
+	return validateTextAndPattern(parameters)
+}
+
+// SelfTest checks the good-suffix table of a textbook pattern, and the match
+// positions against naive matching on texts with overlapping matches
+func (bm *BoyerMoore) SelfTest() error {
+	if shifts := fmt.Sprint(goodSuffixShifts("ABBABAB")); shifts != "[5 5 5 5 2 5 4 1]" {
+		return fmt.Errorf("expected good-suffix shifts [5 5 5 5 2 5 4 1], got %s", shifts)
+	}
+
+	naive := NewNaiveMatch()
+	cases := [][2]string{
+		{"AABAACAADAABAABA", "AABA"},
+		{"AAAAAAAAAA", "AAA"},
+		{"HERE IS A SIMPLE EXAMPLE", "EXAMPLE"},
+		{"ABC", "D"},
+	}
+	for _, c := range cases {
+		parameters := map[string]interface{}{"text": c[0], "pattern": c[1]}
+		expected, err := naive.Execute(context.Background(), nil, parameters, func(types.ExecutionStep) {})
+		if err != nil {
+			return fmt.Errorf("naive matching failed: %v", err)
+		}
+		output, err := bm.Execute(context.Background(), nil, parameters, func(types.ExecutionStep) {})
+		if err != nil {
+			return fmt.Errorf("execution failed: %v", err)
+		}
+
+		want := fmt.Sprint(expected.(map[string]interface{})["matches"])
+		if got := fmt.Sprint(output.(map[string]interface{})["matches"]); got != want {
+			return fmt.Errorf("searching %q for %q: expected matches %s, got %s", c[0], c[1], want, got)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,383 @@
+package strings
+
+import (
+	"algorthmia/internal/types"
+	"container/heap"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+	"unicode/utf8"
+)
+
+// maxHuffmanText limits the text length, since decoding emits a step per bit
+const maxHuffmanText = 200
+
+// Huffman builds a Huffman code for a text, encodes the text and decodes
+// the bitstream back
+type Huffman struct {
+	metadata types.Algorithm
+}
+
+// NewHuffman creates a new Huffman instance
+func NewHuffman() *Huffman {
+	return &Huffman{
+		metadata: types.Algorithm{
+			ID:          "huffman_coding",
+			Name:        "Huffman Coding",
+			Category:    types.CategoryStrings,
+			Description: "Builds an optimal prefix code by repeatedly merging the two least frequent symbols or subtrees into one, so frequent symbols end up near the root with short codes. The text is encoded with the codes, then decoded by walking the tree from the root, left on 0 and right on 1, emitting a symbol at each leaf. No code is a prefix of another, so the bitstream needs no separators. The encoded length is compared with 8-bit bytes and with a fixed-width code of ceil(log2 k) bits per symbol.",
+			BigO:        "Time: O(n + k log k) for text length n and k distinct symbols, Space: O(k)",
+			Parameters: []types.Parameter{
+				{
+					Name:        "text",
+					Type:        "string",
+					Description: fmt.Sprintf("Text to encode and decode, 1 to %d characters", maxHuffmanText),
+					Default:     "abracadabra",
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (hc *Huffman) GetMetadata() types.Algorithm {
+	return hc.metadata
+}
+
+// huffmanNode is a leaf holding a symbol or an internal node joining two
+// subtrees. order breaks weight ties so the tree is deterministic
+type huffmanNode struct {
+	symbol      rune
+	weight      int
+	order       int
+	left, right *huffmanNode
+}
+
+// leaf reports whether the node holds a symbol
+func (n *huffmanNode) leaf() bool {
+	return n.left == nil && n.right == nil
+}
+
+// snapshot copies the subtree into nested maps for a step
+func (n *huffmanNode) snapshot() map[string]interface{} {
+	if n == nil {
+		return nil
+	}
+	node := map[string]interface{}{"weight": n.weight}
+	if n.leaf() {
+		node["symbol"] = string(n.symbol)
+		return node
+	}
+	node["left"] = n.left.snapshot()
+	node["right"] = n.right.snapshot()
+	return node
+}
+
+// huffmanQueue is a min-heap of subtrees by weight
+type huffmanQueue []*huffmanNode
+
+func (q huffmanQueue) Len() int { return len(q) }
+func (q huffmanQueue) Less(i, j int) bool {
+	if q[i].weight != q[j].weight {
+		return q[i].weight < q[j].weight
+	}
+	return q[i].order < q[j].order
+}
+func (q huffmanQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *huffmanQueue) Push(x interface{}) { *q = append(*q, x.(*huffmanNode)) }
+func (q *huffmanQueue) Pop() interface{} {
+	old := *q
+	node := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return node
+}
+
+// weights lists the queued subtree weights in priority order for a step
+func (q huffmanQueue) weights() []int {
+	sorted := append(huffmanQueue{}, q...)
+	sort.Sort(sorted)
+	weights := make([]int, len(sorted))
+	for i, node := range sorted {
+		weights[i] = node.weight
+	}
+	return weights
+}
+
+// forest copies the queued subtrees in priority order for a step
+func (q huffmanQueue) forest() []map[string]interface{} {
+	sorted := append(huffmanQueue{}, q...)
+	sort.Sort(sorted)
+	trees := make([]map[string]interface{}, len(sorted))
+	for i, node := range sorted {
+		trees[i] = node.snapshot()
+	}
+	return trees
+}
+
+// huffmanCode is the code of one leaf
+type huffmanCode struct {
+	symbol rune
+	weight int
+	code   string
+}
+
+// leafCodes lists the code of every leaf below node, left to right
+func leafCodes(node *huffmanNode, prefix string) []huffmanCode {
+	if node.leaf() {
+		// A text of one distinct symbol still needs a one-bit code
+		if prefix == "" {
+			prefix = "0"
+		}
+		return []huffmanCode{{symbol: node.symbol, weight: node.weight, code: prefix}}
+	}
+	return append(leafCodes(node.left, prefix+"0"), leafCodes(node.right, prefix+"1")...)
+}
+
+// Execute builds the code, encodes the text and decodes it again
+func (hc *Huffman) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	text := "abracadabra"
+	if t, ok := parameters["text"].(string); ok {
+		text = t
+	}
+	if text == "" {
+		return nil, fmt.Errorf("text must not be empty")
+	}
+
+	// Count symbols, ordering leaves by first appearance for tie-breaking
+	frequencies := map[string]int{}
+	queue := &huffmanQueue{}
+	leaves := map[rune]*huffmanNode{}
+	order := 0
+	for _, symbol := range text {
+		frequencies[string(symbol)]++
+		if node, exists := leaves[symbol]; exists {
+			node.weight++
+			continue
+		}
+		leaves[symbol] = &huffmanNode{symbol: symbol, weight: 1, order: order}
+		order++
+	}
+	for _, node := range leaves {
+		*queue = append(*queue, node)
+	}
+	heap.Init(queue)
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"text":        text,
+			"frequencies": frequencies,
+			"queue":       queue.weights(),
+		},
+		Message:   fmt.Sprintf("Counted %d distinct symbols in %d characters", len(leaves), utf8.RuneCountInString(text)),
+		Timestamp: time.Now(),
+	})
+
+	stepNumber := 1
+
+	for queue.Len() > 1 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		left := heap.Pop(queue).(*huffmanNode)
+		right := heap.Pop(queue).(*huffmanNode)
+		merged := &huffmanNode{weight: left.weight + right.weight, order: order, left: left, right: right}
+		order++
+		heap.Push(queue, merged)
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "merge_nodes",
+			Data: map[string]interface{}{
+				"left_weight":  left.weight,
+				"right_weight": right.weight,
+				"merged":       merged.snapshot(),
+				"queue":        queue.weights(),
+				"forest":       queue.forest(),
+			},
+			Message:   fmt.Sprintf("Merged the two lightest subtrees, %d and %d, into one of weight %d", left.weight, right.weight, merged.weight),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+	}
+
+	// Walk the finished tree, reading each leaf's code off its path
+	root := (*queue)[0]
+	codes := map[string]string{}
+	for _, leaf := range leafCodes(root, "") {
+		codes[string(leaf.symbol)] = leaf.code
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "assign_code",
+			Data: map[string]interface{}{
+				"symbol":    string(leaf.symbol),
+				"frequency": leaf.weight,
+				"code":      leaf.code,
+				"codes":     copyCodes(codes),
+			},
+			Message:   fmt.Sprintf("%q, seen %d times, gets the %d-bit code %s", leaf.symbol, leaf.weight, len(leaf.code), leaf.code),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+	}
+
+	encoded := ""
+	for _, symbol := range text {
+		encoded += codes[string(symbol)]
+	}
+
+	stepCallback(types.ExecutionStep{
+		StepNumber: stepNumber,
+		Action:     "encode",
+		Data: map[string]interface{}{
+			"tree":    root.snapshot(),
+			"codes":   codes,
+			"encoded": encoded,
+		},
+		Message:   fmt.Sprintf("Encoded the text in %d bits", len(encoded)),
+		Timestamp: time.Now(),
+	})
+	stepNumber++
+
+	// Decode by walking from the root, one branch per bit
+	decoded := []rune{}
+	node := root
+	path := ""
+	for i, bit := range encoded {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		path += string(bit)
+		switch {
+		case root.leaf():
+			// The one-bit code of a single-symbol text names the root itself
+		case bit == '0':
+			node = node.left
+		default:
+			node = node.right
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "traverse_bit",
+			Data: map[string]interface{}{
+				"bit_index": i,
+				"bit":       string(bit),
+				"path":      path,
+				"leaf":      node.leaf(),
+			},
+			Message:   fmt.Sprintf("Bit %d is %c: followed the path to %s", i, bit, path),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+
+		if !node.leaf() {
+			continue
+		}
+
+		decoded = append(decoded, node.symbol)
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "emit_symbol",
+			Data: map[string]interface{}{
+				"symbol":  string(node.symbol),
+				"code":    codes[string(node.symbol)],
+				"decoded": string(decoded),
+			},
+			Message:   fmt.Sprintf("Reached the leaf for %q; back to the root", node.symbol),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+
+		node = root
+		path = ""
+	}
+
+	roundTripOK := string(decoded) == text
+	originalBits := 8 * len(text)
+
+	// A fixed-width code for k symbols needs ceil(log2 k) bits, at least one
+	width := 1
+	for 1<<width < len(codes) {
+		width++
+	}
+	fixedWidthBits := width * utf8.RuneCountInString(text)
+
+	result := map[string]interface{}{
+		"codes":             codes,
+		"encoded":           encoded,
+		"encoded_bits":      len(encoded),
+		"original_bits":     originalBits,
+		"ratio":             float64(len(encoded)) / float64(originalBits),
+		"fixed_width_bits":  fixedWidthBits,
+		"fixed_width_ratio": float64(len(encoded)) / float64(fixedWidthBits),
+		"decoded":           string(decoded),
+		"round_trip_ok":     roundTripOK,
+		"tree":              root.snapshot(),
+	}
+
+	message := fmt.Sprintf("Encoded %d bytes in %d bits and decoded them back exactly", len(text), len(encoded))
+	if !roundTripOK {
+		message = "Decoding did not reproduce the original text"
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data:       result,
+		Message:    message,
+		Timestamp:  time.Now(),
+	})
+
+	return result, nil
+}
+
+// copyCodes copies the codes assigned so far for a step
+func copyCodes(codes map[string]string) map[string]string {
+	copied := make(map[string]string, len(codes))
+	for symbol, code := range codes {
+		copied[symbol] = code
+	}
+	return copied
+}
+
+// ValidateParameters validates the input parameters
+func (hc *Huffman) ValidateParameters(parameters map[string]interface{}) error {
+	if text, exists := parameters["text"]; exists {
+		t, ok := text.(string)
+		if !ok {
+			return fmt.Errorf("text must be a string")
+		}
+		if length := utf8.RuneCountInString(t); length < 1 || length > maxHuffmanText {
+			return fmt.Errorf("text must be between 1 and %d characters", maxHuffmanText)
+		}
+	}
+	return nil
+}
+
+// SelfTest checks that decoding reproduces the text and that the encoding
+// has the optimal length
+func (hc *Huffman) SelfTest() error {
+	output, err := hc.Execute(context.Background(), nil, map[string]interface{}{"text": "abracadabra"}, func(types.ExecutionStep) {})
+	if err != nil {
+		return fmt.Errorf("execution failed: %v", err)
+	}
+
+	result := output.(map[string]interface{})
+	if !result["round_trip_ok"].(bool) || result["decoded"].(string) != "abracadabra" {
+		return fmt.Errorf("decoding abracadabra gave %q", result["decoded"])
+	}
+
+	// a:5 b:2 r:2 c:1 d:1 has an optimal cost of 23 bits
+	if bits := result["encoded_bits"].(int); bits != 23 {
+		return fmt.Errorf("expected 23 bits for abracadabra, got %d", bits)
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package strings
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"algorthmia/internal/types"
+)
+
+// decodeWithCodes decodes a bitstream with a code table alone, independent
+// of the tree the algorithm decoded with
+func decodeWithCodes(t *testing.T, encoded string, codes map[string]string) string {
+	t.Helper()
+
+	symbols := make(map[string]string, len(codes))
+	for symbol, code := range codes {
+		symbols[code] = symbol
+	}
+
+	decoded, code := "", ""
+	for _, bit := range encoded {
+		code += string(bit)
+		if symbol, ok := symbols[code]; ok {
+			decoded += symbol
+			code = ""
+		}
+	}
+	if code != "" {
+		t.Fatalf("bits %q are left over after decoding %q", code, decoded)
+	}
+	return decoded
+}
+
+// TestHuffmanRoundTrip checks that decode(encode(text)) == text, both as the
+// algorithm decodes and with the code table alone
+func TestHuffmanRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	random := make([]byte, 200)
+	for i := range random {
+		random[i] = "abcdefgh"[rng.Intn(8)]
+	}
+
+	texts := []string{"abracadabra", "aaaa", "x", "naïve café ☕☕", "the quick brown fox jumps over the lazy dog", string(random)}
+	for _, text := range texts {
+		emitted := ""
+		output, err := NewHuffman().Execute(context.Background(), nil, map[string]interface{}{"text": text}, func(step types.ExecutionStep) {
+			if step.Action == "emit_symbol" {
+				emitted += step.Data["symbol"].(string)
+			}
+		})
+		if err != nil {
+			t.Fatalf("%q: %v", text, err)
+		}
+
+		result := output.(map[string]interface{})
+		if !result["round_trip_ok"].(bool) || result["decoded"] != text {
+			t.Errorf("%q decoded to %q", text, result["decoded"])
+		}
+		if emitted != text {
+			t.Errorf("%q: emit_symbol steps spell %q", text, emitted)
+		}
+
+		codes := result["codes"].(map[string]string)
+		encoded := result["encoded"].(string)
+		if decoded := decodeWithCodes(t, encoded, codes); decoded != text {
+			t.Errorf("%q: the code table decodes %q", text, decoded)
+		}
+
+		// No code may be the prefix of another, or decoding is ambiguous
+		for a, codeA := range codes {
+			for b, codeB := range codes {
+				if a != b && len(codeA) <= len(codeB) && codeB[:len(codeA)] == codeA {
+					t.Errorf("%q: code %s for %q is a prefix of %s for %q", text, codeA, a, codeB, b)
+				}
+			}
+		}
+	}
+}
+
+func TestHuffmanFixedWidthComparison(t *testing.T) {
+	output, err := NewHuffman().Execute(context.Background(), nil, map[string]interface{}{"text": "abracadabra"}, func(types.ExecutionStep) {})
+	if err != nil {
+		t.Fatalf("executing: %v", err)
+	}
+
+	// Five symbols need 3 bits each at a fixed width
+	if bits := output.(map[string]interface{})["fixed_width_bits"].(int); bits != 33 {
+		t.Errorf("fixed-width bits %d, expected 33", bits)
+	}
+}
@@ -0,0 +1,239 @@
+package strings
+
+import (
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"time"
+)
+
+// KMP implements Knuth-Morris-Pratt string matching
+type KMP struct {
+	metadata types.Algorithm
+}
+
+// NewKMP creates a new KMP instance
+func NewKMP() *KMP {
+	return &KMP{
+		metadata: types.Algorithm{
+			ID:          "kmp",
+			Name:        "Knuth-Morris-Pratt",
+			Category:    types.CategoryStrings,
+			Description: "Precomputes for every prefix of the pattern the length of its longest proper prefix that is also a suffix (the LPS table). On a mismatch the pattern is shifted so that this prefix lines up with the text already matched, so the text is never re-read and no character of it is compared more than twice.",
+			BigO:        "Time: O(n + m), Space: O(m) for text length n and pattern length m",
+			Parameters:  matchParameters(),
+			RelatedIDs:  []string{"naive_string_match"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (k *KMP) GetMetadata() types.Algorithm {
+	return k.metadata
+}
+
+// Execute builds the LPS table and then scans the text
+func (k *KMP) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	text, pattern := textAndPattern(parameters)
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"text":    text,
+			"pattern": pattern,
+		},
+		Message:   fmt.Sprintf("Searching for %q in a text of %d characters", pattern, len(text)),
+		Timestamp: time.Now(),
+	})
+
+	stepNumber := 1
+
+	// lps[i] is the length of the longest proper prefix of pattern[:i+1]
+	// that is also its suffix
+	lps := make([]int, len(pattern))
+	lpsComparisons := 0
+	emitLPS := func(index int, fallbacks []int) {
+		message := fmt.Sprintf("LPS[%d] = %d: %q ends with its prefix %q", index, lps[index], pattern[:index+1], pattern[:lps[index]])
+		if lps[index] == 0 {
+			message = fmt.Sprintf("LPS[%d] = 0: no proper prefix of %q is also its suffix", index, pattern[:index+1])
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "build_lps",
+			Data: map[string]interface{}{
+				"index":     index,
+				"value":     lps[index],
+				"lps":       append([]int{}, lps[:index+1]...),
+				"fallbacks": fallbacks,
+			},
+			Message:   message,
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+	}
+
+	emitLPS(0, []int{})
+	length := 0
+	fallbacks := []int{}
+	for i := 1; i < len(pattern); {
+		lpsComparisons++
+		if pattern[i] == pattern[length] {
+			length++
+			lps[i] = length
+			emitLPS(i, fallbacks)
+			fallbacks = []int{}
+			i++
+		} else if length > 0 {
+			// Try the next shorter prefix that is also a suffix
+			length = lps[length-1]
+			fallbacks = append(fallbacks, length)
+		} else {
+			lps[i] = 0
+			emitLPS(i, fallbacks)
+			fallbacks = []int{}
+			i++
+		}
+	}
+
+	matches := []int{}
+	comparisons := 0
+
+	// emitShift reports the pattern's new alignment, and the LPS entry that
+	// chose it when one did
+	emitShift := func(textIndex, patternIndex int, lpsIndex interface{}, message string) {
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "shift",
+			Data: map[string]interface{}{
+				"text_index":    textIndex,
+				"pattern_index": patternIndex,
+				"alignment":     textIndex - patternIndex,
+				"lps_index":     lpsIndex,
+				"comparisons":   comparisons,
+			},
+			Message:   message,
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+	}
+
+	i, j := 0, 0
+	for i < len(text) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		comparisons++
+		matched := text[i] == pattern[j]
+
+		action := "mismatch"
+		if matched {
+			action = "match"
+		}
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     action,
+			Data: map[string]interface{}{
+				"text_index":    i,
+				"pattern_index": j,
+				"comparisons":   comparisons,
+			},
+			Message:   fmt.Sprintf("Compared text[%d]=%q with pattern[%d]=%q", i, text[i], j, pattern[j]),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+
+		switch {
+		case matched:
+			i++
+			j++
+			if j < len(pattern) {
+				continue
+			}
+
+			matches = append(matches, i-j)
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "match_found",
+				Data: map[string]interface{}{
+					"shift":       i - j,
+					"matches":     matches,
+					"comparisons": comparisons,
+				},
+				Message:   fmt.Sprintf("Pattern found at position %d", i-j),
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+
+			j = lps[len(pattern)-1]
+			if i < len(text) {
+				emitShift(i, j, len(pattern)-1, fmt.Sprintf("LPS[%d] = %d: the match's last %d characters stay aligned", len(pattern)-1, j, j))
+			}
+
+		case j > 0:
+			previous := j
+			j = lps[j-1]
+			emitShift(i, j, previous-1, fmt.Sprintf("LPS[%d] = %d: resuming at pattern[%d] without moving back in the text", previous-1, j, j))
+
+		default:
+			i++
+			if i < len(text) {
+				emitShift(i, 0, nil, fmt.Sprintf("Nothing matched: shifted the pattern to position %d", i))
+			}
+		}
+	}
+
+	result := matchResult(matches, comparisons)
+	result["lps"] = lps
+	result["lps_comparisons"] = lpsComparisons
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data: map[string]interface{}{
+			"matches":     matches,
+			"comparisons": comparisons,
+			"lps":         lps,
+		},
+		Message:   fmt.Sprintf("KMP found %d matches with %d comparisons, plus %d to build the LPS table", len(matches), comparisons, lpsComparisons),
+		Timestamp: time.Now(),
+	})
+
+	return result, nil
+}
+
+// ValidateParameters validates the input parameters
+func (k *KMP) ValidateParameters(parameters map[string]interface{}) error {
+	return validateTextAndPattern(parameters)
+}
+
+// SelfTest checks the LPS table of a pattern with repeated fallbacks, and
+// the match positions and comparison bound on a text with overlapping matches
+func (k *KMP) SelfTest() error {
+	output, err := k.Execute(context.Background(), nil, map[string]interface{}{"text": "AAACAAAAAC", "pattern": "AAACAAAA"}, func(types.ExecutionStep) {})
+	if err != nil {
+		return fmt.Errorf("execution failed: %v", err)
+	}
+	if lps := fmt.Sprint(output.(map[string]interface{})["lps"]); lps != "[0 1 2 0 1 2 3 3]" {
+		return fmt.Errorf("expected LPS [0 1 2 0 1 2 3 3], got %s", lps)
+	}
+
+	text := "AABAACAADAABAABA"
+	output, err = k.Execute(context.Background(), nil, map[string]interface{}{"text": text, "pattern": "AABA"}, func(types.ExecutionStep) {})
+	if err != nil {
+		return fmt.Errorf("execution failed: %v", err)
+	}
+
+	result := output.(map[string]interface{})
+	if matches := fmt.Sprint(result["matches"]); matches != "[0 9 12]" {
+		return fmt.Errorf("expected matches [0 9 12], got %s", matches)
+	}
+	if comparisons := result["comparisons"].(int); comparisons > 2*len(text) {
+		return fmt.Errorf("%d comparisons exceed twice the text length", comparisons)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package strings
+
+import (
+	"algorthmia/internal/types"
+	"fmt"
+)
+
+// Default text and pattern searched by the string matchers
+const (
+	defaultMatchText    = "ABABDABACDABABCABAB"
+	defaultMatchPattern = "ABAB"
+)
+
+// matchParameters returns the text and pattern parameters shared by the string matchers
+func matchParameters() []types.Parameter {
+	return []types.Parameter{
+		{
+			Name:        "text",
+			Type:        "string",
+			Description: "Text to search, up to 1000 characters",
+			Default:     defaultMatchText,
+			Required:    true,
+		},
+		{
+			Name:        "pattern",
+			Type:        "string",
+			Description: "Pattern to find, 1 to 100 characters",
+			Default:     defaultMatchPattern,
+			Required:    true,
+		},
+	}
+}
+
+// textAndPattern reads the text and pattern parameters, falling back to the defaults
+func textAndPattern(parameters map[string]interface{}) (string, string) {
+	text := defaultMatchText
+	if t, ok := parameters["text"].(string); ok {
+		text = t
+	}
+
+	pattern := defaultMatchPattern
+	if p, ok := parameters["pattern"].(string); ok {
+		pattern = p
+	}
+
+	return text, pattern
+}
+
+// validateTextAndPattern checks the text and pattern parameters
+func validateTextAndPattern(parameters map[string]interface{}) error {
+	if text, exists := parameters["text"]; exists {
+		t, ok := text.(string)
+		if !ok {
+			return fmt.Errorf("text must be a string")
+		}
+		if len(t) > 1000 {
+			return fmt.Errorf("text must be at most 1000 characters")
+		}
+	}
+
+	if pattern, exists := parameters["pattern"]; exists {
+		p, ok := pattern.(string)
+		if !ok {
+			return fmt.Errorf("pattern must be a string")
+		}
+		if len(p) < 1 || len(p) > 100 {
+			return fmt.Errorf("pattern must be between 1 and 100 characters")
+		}
+	}
+
+	return nil
+}
+
+// matchResult builds the output every string matcher returns, so matchers
+// can be compared on the same text: the match start positions and the
+// number of character comparisons made
+func matchResult(matches []int, comparisons int) map[string]interface{} {
+	return map[string]interface{}{
+		"matches":     matches,
+		"comparisons": comparisons,
+	}
+}
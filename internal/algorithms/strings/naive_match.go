@@ -0,0 +1,156 @@
+package strings
+
+import (
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"time"
+)
+
+// NaiveMatch implements brute-force string matching
+type NaiveMatch struct {
+	metadata types.Algorithm
+}
+
+// NewNaiveMatch creates a new NaiveMatch instance
+func NewNaiveMatch() *NaiveMatch {
+	return &NaiveMatch{
+		metadata: types.Algorithm{
+			ID:          "naive_string_match",
+			Name:        "Naive String Matching",
+			Category:    types.CategoryStrings,
+			Description: "Aligns the pattern at every position of the text and compares characters left to right until a mismatch or a full match, then shifts the pattern by one. The baseline the smarter matchers are measured against.",
+			BigO:        "Time: O(n · m), Space: O(1) for text length n and pattern length m",
+			Parameters:  matchParameters(),
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (nm *NaiveMatch) GetMetadata() types.Algorithm {
+	return nm.metadata
+}
+
+// Execute runs naive string matching
+func (nm *NaiveMatch) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	text, pattern := textAndPattern(parameters)
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"text":    text,
+			"pattern": pattern,
+		},
+		Message:   fmt.Sprintf("Searching for %q in a text of %d characters", pattern, len(text)),
+		Timestamp: time.Now(),
+	})
+
+	matches := []int{}
+	comparisons := 0
+	stepNumber := 1
+
+	for shift := 0; shift+len(pattern) <= len(text); shift++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		j := 0
+		for j < len(pattern) {
+			comparisons++
+			matched := text[shift+j] == pattern[j]
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "compare",
+				Data: map[string]interface{}{
+					"shift":         shift,
+					"text_index":    shift + j,
+					"pattern_index": j,
+					"matched":       matched,
+					"comparisons":   comparisons,
+				},
+				Message:   fmt.Sprintf("Compared text[%d]=%q with pattern[%d]=%q", shift+j, text[shift+j], j, pattern[j]),
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+
+			if !matched {
+				break
+			}
+			j++
+		}
+
+		if j == len(pattern) {
+			matches = append(matches, shift)
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "match_found",
+				Data: map[string]interface{}{
+					"shift":       shift,
+					"matches":     matches,
+					"comparisons": comparisons,
+				},
+				Message:   fmt.Sprintf("Pattern found at position %d", shift),
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+		}
+
+		if shift+len(pattern) < len(text) {
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "shift",
+				Data: map[string]interface{}{
+					"shift":       shift + 1,
+					"comparisons": comparisons,
+				},
+				Message:   fmt.Sprintf("Shifted the pattern to position %d", shift+1),
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+		}
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data: map[string]interface{}{
+			"matches":     matches,
+			"comparisons": comparisons,
+		},
+		Message:   fmt.Sprintf("Naive matching found %d matches with %d comparisons", len(matches), comparisons),
+		Timestamp: time.Now(),
+	})
+
+	return matchResult(matches, comparisons), nil
+}
+
+// ValidateParameters validates the input parameters
+func (nm *NaiveMatch) ValidateParameters(parameters map[string]interface{}) error {
+	return validateTextAndPattern(parameters)
+}
+
+// SelfTest checks the match positions on a text with overlapping matches
+func (nm *NaiveMatch) SelfTest() error {
+	parameters := map[string]interface{}{"text": "AABAACAADAABAABA", "pattern": "AABA"}
+	output, err := nm.Execute(context.Background(), nil, parameters, func(types.ExecutionStep) {})
+	if err != nil {
+		return fmt.Errorf("execution failed: %v", err)
+	}
+
+	matches := output.(map[string]interface{})["matches"].([]int)
+	expected := []int{0, 9, 12}
+	if len(matches) != len(expected) {
+		return fmt.Errorf("expected matches %v, got %v", expected, matches)
+	}
+	for i := range expected {
+		if matches[i] != expected[i] {
+			return fmt.Errorf("expected matches %v, got %v", expected, matches)
+		}
+	}
+
+	return nil
+}
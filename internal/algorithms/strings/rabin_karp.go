@@ -0,0 +1,266 @@
+package strings
+
+import (
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"time"
+)
+
+// RabinKarp implements Rabin-Karp string matching with a rolling hash
+type RabinKarp struct {
+	metadata types.Algorithm
+}
+
+// NewRabinKarp creates a new RabinKarp instance
+func NewRabinKarp() *RabinKarp {
+	parameters := append(matchParameters(),
+		types.Parameter{
+			Name:        "base",
+			Type:        "int",
+			Description: "Base of the polynomial hash",
+			Default:     256,
+			Min:         types.IntPtr(2),
+			Max:         types.IntPtr(1000),
+			Required:    false,
+		},
+		types.Parameter{
+			Name:        "modulus",
+			Type:        "int",
+			Description: "Modulus of the hash; small values cause more spurious hits",
+			Default:     101,
+			Min:         types.IntPtr(2),
+			Max:         types.IntPtr(1000003),
+			Required:    false,
+		},
+	)
+
+	return &RabinKarp{
+		metadata: types.Algorithm{
+			ID:          "rabin_karp",
+			Name:        "Rabin-Karp",
+			Category:    types.CategoryStrings,
+			Description: "Compares a hash of each text window with the pattern's hash, and only compares characters when the hashes are equal. The hash of the next window is rolled from the current one in constant time by removing the leading character and adding the next. Equal hashes of different strings are spurious hits, which the character check rejects.",
+			BigO:        "Time: O(n + m) expected, O(n · m) worst case with many spurious hits, Space: O(1) for text length n and pattern length m",
+			Parameters:  parameters,
+			RelatedIDs:  []string{"naive_string_match", "kmp"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (rk *RabinKarp) GetMetadata() types.Algorithm {
+	return rk.metadata
+}
+
+// Execute slides a hashed window over the text, verifying hash matches
+func (rk *RabinKarp) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	text, pattern := textAndPattern(parameters)
+
+	base := 256
+	if b, ok := parameters["base"].(int); ok {
+		base = b
+	}
+
+	modulus := 101
+	if m, ok := parameters["modulus"].(int); ok {
+		modulus = m
+	}
+
+	m := len(pattern)
+
+	// high is base^(m-1), the weight of a window's leading character
+	high := 1
+	for i := 1; i < m; i++ {
+		high = high * base % modulus
+	}
+
+	// hash computes the polynomial hash of s
+	hash := func(s string) int {
+		h := 0
+		for i := 0; i < len(s); i++ {
+			h = (h*base + int(s[i])) % modulus
+		}
+		return h
+	}
+	patternHash := hash(pattern)
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"text":         text,
+			"pattern":      pattern,
+			"base":         base,
+			"modulus":      modulus,
+			"pattern_hash": patternHash,
+		},
+		Message:   fmt.Sprintf("Searching for %q, whose hash is %d, in a text of %d characters", pattern, patternHash, len(text)),
+		Timestamp: time.Now(),
+	})
+
+	matches := []int{}
+	comparisons := 0
+	hashMatches := 0
+	spuriousHits := 0
+	stepNumber := 1
+
+	windowHash := 0
+	if m <= len(text) {
+		windowHash = hash(text[:m])
+	}
+
+	for shift := 0; shift+m <= len(text); shift++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if shift > 0 {
+			// Roll the hash: drop text[shift-1], add text[shift+m-1]
+			windowHash = (windowHash - int(text[shift-1])*high%modulus + modulus) % modulus
+			windowHash = (windowHash*base + int(text[shift+m-1])) % modulus
+		}
+
+		hashMatch := windowHash == patternHash
+		message := fmt.Sprintf("Window %q at %d hashes to %d, not %d", text[shift:shift+m], shift, windowHash, patternHash)
+		if hashMatch {
+			hashMatches++
+			message = fmt.Sprintf("Window %q at %d hashes to %d, the pattern's hash: verifying", text[shift:shift+m], shift, windowHash)
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "hash_window",
+			Data: map[string]interface{}{
+				"shift":        shift,
+				"window":       text[shift : shift+m],
+				"window_hash":  windowHash,
+				"pattern_hash": patternHash,
+				"hash_match":   hashMatch,
+			},
+			Message:   message,
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+
+		if !hashMatch {
+			continue
+		}
+
+		// Equal hashes may still be different strings, so check each character
+		j := 0
+		for j < m {
+			comparisons++
+			matched := text[shift+j] == pattern[j]
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "verify",
+				Data: map[string]interface{}{
+					"shift":         shift,
+					"text_index":    shift + j,
+					"pattern_index": j,
+					"matched":       matched,
+					"comparisons":   comparisons,
+				},
+				Message:   fmt.Sprintf("Compared text[%d]=%q with pattern[%d]=%q", shift+j, text[shift+j], j, pattern[j]),
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+
+			if !matched {
+				break
+			}
+			j++
+		}
+
+		if j == m {
+			matches = append(matches, shift)
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "match_found",
+				Data: map[string]interface{}{
+					"shift":       shift,
+					"matches":     matches,
+					"comparisons": comparisons,
+				},
+				Message:   fmt.Sprintf("Pattern found at position %d", shift),
+				Timestamp: time.Now(),
+			})
+		} else {
+			spuriousHits++
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "spurious_hit",
+				Data: map[string]interface{}{
+					"shift":         shift,
+					"window":        text[shift : shift+m],
+					"spurious_hits": spuriousHits,
+				},
+				Message:   fmt.Sprintf("%q shares the pattern's hash but differs: a spurious hit", text[shift:shift+m]),
+				Timestamp: time.Now(),
+			})
+		}
+		stepNumber++
+	}
+
+	result := matchResult(matches, comparisons)
+	result["hash_matches"] = hashMatches
+	result["spurious_hits"] = spuriousHits
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data: map[string]interface{}{
+			"matches":       matches,
+			"comparisons":   comparisons,
+			"spurious_hits": spuriousHits,
+		},
+		Message:   fmt.Sprintf("Rabin-Karp found %d matches with %d comparisons; %d of %d hash matches were spurious", len(matches), comparisons, spuriousHits, hashMatches),
+		Timestamp: time.Now(),
+	})
+
+	return result, nil
+}
+
+// ValidateParameters validates the input parameters
+func (rk *RabinKarp) ValidateParameters(parameters map[string]interface{}) error {
+	if base, ok := parameters["base"].(int); ok {
+		if base < 2 || base > 1000 {
+			return fmt.Errorf("base must be between 2 and 1000")
+		}
+	}
+
+	if modulus, ok := parameters["modulus"].(int); ok {
+		if modulus < 2 || modulus > 1000003 {
+			return fmt.Errorf("modulus must be between 2 and 1000003")
+		}
+	}
+
+	return validateTextAndPattern(parameters)
+}
+
+// SelfTest checks match positions with the default hash, and that a tiny
+// modulus produces spurious hits without changing the matches
+func (rk *RabinKarp) SelfTest() error {
+	for _, modulus := range []int{101, 2} {
+		parameters := map[string]interface{}{"text": "AABAACAADAABAABA", "pattern": "AABA", "base": 256, "modulus": modulus}
+		output, err := rk.Execute(context.Background(), nil, parameters, func(types.ExecutionStep) {})
+		if err != nil {
+			return fmt.Errorf("execution failed: %v", err)
+		}
+
+		result := output.(map[string]interface{})
+		if matches := fmt.Sprint(result["matches"]); matches != "[0 9 12]" {
+			return fmt.Errorf("modulus %d: expected matches [0 9 12], got %s", modulus, matches)
+		}
+		if modulus == 2 && result["spurious_hits"].(int) == 0 {
+			return fmt.Errorf("modulus 2: expected spurious hits")
+		}
+	}
+
+	return nil
+}
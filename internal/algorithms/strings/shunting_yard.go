@@ -0,0 +1,369 @@
+package strings
+
+import (
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+	"unicode"
+)
+
+// ShuntingYard implements Dijkstra's shunting-yard algorithm for converting
+// infix arithmetic to reverse Polish notation, followed by RPN evaluation
+type ShuntingYard struct {
+	metadata types.Algorithm
+}
+
+// NewShuntingYard creates a new ShuntingYard instance
+func NewShuntingYard() *ShuntingYard {
+	return &ShuntingYard{
+		metadata: types.Algorithm{
+			ID:          "shunting_yard",
+			Name:        "Shunting-Yard",
+			Category:    types.CategoryStrings,
+			Description: "Parses an infix arithmetic expression into reverse Polish notation using an operator stack and output queue, then evaluates the RPN with an operand stack.",
+			BigO:        "Time: O(n), Space: O(n) where n is the number of tokens",
+			Parameters: []types.Parameter{
+				{
+					Name:        "expression",
+					Type:        "string",
+					Description: "Infix arithmetic expression using numbers, + - * / ^ and parentheses",
+					Default:     "3 + 4 * (2 - 1) ^ 2",
+					Required:    true,
+				},
+			},
+			RelatedIDs: []string{"expression_tree"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (sy *ShuntingYard) GetMetadata() types.Algorithm {
+	return sy.metadata
+}
+
+// ExpressionError describes why an expression could not be parsed
+type ExpressionError struct {
+	Position int    `json:"position"`
+	Token    string `json:"token,omitempty"`
+	Reason   string `json:"reason"`
+}
+
+// Error implements the error interface
+func (e *ExpressionError) Error() string {
+	if e.Token != "" {
+		return fmt.Sprintf("invalid expression at position %d (%q): %s", e.Position, e.Token, e.Reason)
+	}
+	return fmt.Sprintf("invalid expression at position %d: %s", e.Position, e.Reason)
+}
+
+// Token is a single lexical element of an arithmetic expression
+type Token struct {
+	Value    string `json:"value"`
+	Kind     string `json:"kind"` // "number", "operator", "left_paren", "right_paren"
+	Position int    `json:"position"`
+}
+
+// Execute runs the shunting-yard algorithm
+func (sy *ShuntingYard) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	expression := "3 + 4 * (2 - 1) ^ 2"
+	if e, ok := parameters["expression"].(string); ok {
+		expression = e
+	}
+
+	tokens, err := ParseExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"expression": expression,
+			"tokens":     tokens,
+		},
+		Message:   fmt.Sprintf("Starting Shunting-Yard on %q", expression),
+		Timestamp: time.Now(),
+	})
+
+	operators := []string{}
+	output := []string{}
+	stepNumber := 1
+
+	emit := func(action, message string, current Token) {
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     action,
+			Data: map[string]interface{}{
+				"token":          current,
+				"operator_stack": append([]string{}, operators...),
+				"output_queue":   append([]string{}, output...),
+				"phase":          "parsing",
+			},
+			Message:   message,
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+	}
+
+	for _, t := range tokens {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		emit("read_token", fmt.Sprintf("Read %s %q", t.Kind, t.Value), t)
+
+		switch t.Kind {
+		case "number":
+			output = append(output, t.Value)
+			emit("pop_to_output", fmt.Sprintf("Moved number %s to the output queue", t.Value), t)
+
+		case "operator":
+			// Pop operators that bind at least as tightly as the incoming one
+			for len(operators) > 0 {
+				top := operators[len(operators)-1]
+				if top == "(" {
+					break
+				}
+				if Precedence[top] < Precedence[t.Value] || (Precedence[top] == Precedence[t.Value] && RightAssociative[t.Value]) {
+					break
+				}
+
+				operators = operators[:len(operators)-1]
+				output = append(output, top)
+				emit("pop_to_output", fmt.Sprintf("Popped %s to the output queue before %s", top, t.Value), t)
+			}
+
+			operators = append(operators, t.Value)
+			emit("push_operator", fmt.Sprintf("Pushed operator %s onto the stack", t.Value), t)
+
+		case "left_paren":
+			operators = append(operators, t.Value)
+			emit("handle_paren", "Pushed ( onto the stack", t)
+
+		case "right_paren":
+			for operators[len(operators)-1] != "(" {
+				top := operators[len(operators)-1]
+				operators = operators[:len(operators)-1]
+				output = append(output, top)
+				emit("pop_to_output", fmt.Sprintf("Popped %s to the output queue inside parentheses", top), t)
+			}
+
+			operators = operators[:len(operators)-1]
+			emit("handle_paren", "Discarded the matching ( from the stack", t)
+		}
+	}
+
+	for len(operators) > 0 {
+		top := operators[len(operators)-1]
+		operators = operators[:len(operators)-1]
+		output = append(output, top)
+		emit("pop_to_output", fmt.Sprintf("Popped remaining operator %s to the output queue", top), Token{})
+	}
+
+	// Evaluate the reverse Polish notation with an operand stack
+	operands := []float64{}
+	for _, value := range output {
+		if Precedence[value] == 0 {
+			number, _ := strconv.ParseFloat(value, 64)
+			operands = append(operands, number)
+		} else {
+			right := operands[len(operands)-1]
+			left := operands[len(operands)-2]
+			operands = operands[:len(operands)-2]
+
+			result, err := ApplyOperator(value, left, right)
+			if err != nil {
+				return nil, err
+			}
+			operands = append(operands, result)
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "evaluate_token",
+			Data: map[string]interface{}{
+				"token":         value,
+				"rpn":           output,
+				"operand_stack": append([]float64{}, operands...),
+				"phase":         "evaluating",
+			},
+			Message:   fmt.Sprintf("Evaluated RPN token %s", value),
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+	}
+
+	result := operands[0]
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data: map[string]interface{}{
+			"expression": expression,
+			"rpn":        output,
+			"result":     result,
+		},
+		Message:   fmt.Sprintf("Expression evaluates to %g", result),
+		Timestamp: time.Now(),
+	})
+
+	return map[string]interface{}{
+		"rpn":    output,
+		"result": result,
+	}, nil
+}
+
+// ValidateParameters validates the input parameters
+func (sy *ShuntingYard) ValidateParameters(parameters map[string]interface{}) error {
+	if expression, ok := parameters["expression"].(string); ok {
+		if len(expression) > 200 {
+			return fmt.Errorf("expression must be at most 200 characters")
+		}
+		if _, err := ParseExpression(expression); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SelfTest verifies precedence, associativity and parentheses on a known expression
+func (sy *ShuntingYard) SelfTest() error {
+	output, err := sy.Execute(context.Background(), nil, map[string]interface{}{"expression": "2 ^ 3 ^ 2 - (10 - 4) / 3 * 2"}, func(types.ExecutionStep) {})
+	if err != nil {
+		return fmt.Errorf("execution failed: %v", err)
+	}
+
+	if result := output.(map[string]interface{})["result"].(float64); result != 508 {
+		return fmt.Errorf("expected 508, got %g", result)
+	}
+
+	return nil
+}
+
+// Precedence ranks the supported binary operators
+var Precedence = map[string]int{
+	"+": 1,
+	"-": 1,
+	"*": 2,
+	"/": 2,
+	"^": 3,
+}
+
+// RightAssociative marks operators that group from the right
+var RightAssociative = map[string]bool{
+	"^": true,
+}
+
+// Tokenize splits an expression into numbers, operators and parentheses
+func Tokenize(expression string) ([]Token, error) {
+	tokens := []Token{}
+	runes := []rune(expression)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsDigit(r) || r == '.':
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			value := string(runes[start:i])
+			if _, err := strconv.ParseFloat(value, 64); err != nil {
+				return nil, &ExpressionError{Position: start, Token: value, Reason: "malformed number"}
+			}
+			tokens = append(tokens, Token{Value: value, Kind: "number", Position: start})
+		case Precedence[string(r)] > 0:
+			tokens = append(tokens, Token{Value: string(r), Kind: "operator", Position: i})
+			i++
+		case r == '(':
+			tokens = append(tokens, Token{Value: "(", Kind: "left_paren", Position: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, Token{Value: ")", Kind: "right_paren", Position: i})
+			i++
+		default:
+			return nil, &ExpressionError{Position: i, Token: string(r), Reason: "unsupported character"}
+		}
+	}
+
+	return tokens, nil
+}
+
+// ParseExpression tokenizes an expression and checks that parentheses are
+// balanced and operands and operators alternate correctly
+func ParseExpression(expression string) ([]Token, error) {
+	tokens, err := Tokenize(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tokens) == 0 {
+		return nil, &ExpressionError{Position: 0, Reason: "expression is empty"}
+	}
+
+	depth := 0
+	expectOperand := true
+	for _, t := range tokens {
+		switch t.Kind {
+		case "number":
+			if !expectOperand {
+				return nil, &ExpressionError{Position: t.Position, Token: t.Value, Reason: "expected an operator"}
+			}
+			expectOperand = false
+		case "operator":
+			if expectOperand {
+				return nil, &ExpressionError{Position: t.Position, Token: t.Value, Reason: "expected a number or ("}
+			}
+			expectOperand = true
+		case "left_paren":
+			if !expectOperand {
+				return nil, &ExpressionError{Position: t.Position, Token: t.Value, Reason: "expected an operator"}
+			}
+			depth++
+		case "right_paren":
+			if expectOperand {
+				return nil, &ExpressionError{Position: t.Position, Token: t.Value, Reason: "expected a number or ("}
+			}
+			if depth == 0 {
+				return nil, &ExpressionError{Position: t.Position, Token: t.Value, Reason: "unmatched )"}
+			}
+			depth--
+		}
+	}
+
+	if expectOperand {
+		return nil, &ExpressionError{Position: len([]rune(expression)), Reason: "expression ends with an operator"}
+	}
+	if depth > 0 {
+		return nil, &ExpressionError{Position: len([]rune(expression)), Reason: "unmatched ("}
+	}
+
+	return tokens, nil
+}
+
+// ApplyOperator applies a binary operator to two operands
+func ApplyOperator(operator string, left, right float64) (float64, error) {
+	switch operator {
+	case "+":
+		return left + right, nil
+	case "-":
+		return left - right, nil
+	case "*":
+		return left * right, nil
+	case "/":
+		if right == 0 {
+			return 0, fmt.Errorf("division by zero")
+		}
+		return left / right, nil
+	case "^":
+		return math.Pow(left, right), nil
+	}
+	return 0, fmt.Errorf("unsupported operator %s", operator)
+}
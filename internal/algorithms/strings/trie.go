@@ -0,0 +1,336 @@
+package strings
+
+import (
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"sort"
+	stdstrings "strings"
+	"time"
+)
+
+// Limits on the word_list parameter
+const (
+	maxTrieWords      = 30
+	maxTrieWordLength = 20
+)
+
+// Trie builds a prefix tree from a list of words and answers a prefix query
+type Trie struct {
+	metadata types.Algorithm
+}
+
+// NewTrie creates a new Trie instance
+func NewTrie() *Trie {
+	return &Trie{
+		metadata: types.Algorithm{
+			ID:          "trie",
+			Name:        "Trie Prefix Search",
+			Category:    types.CategoryStrings,
+			Description: "Stores words in a tree with one character per edge, so words sharing a prefix share the path for it. Each word is inserted by walking from the root and creating any missing child nodes. A prefix query walks the same way one character at a time, and every word below the node it reaches starts with the prefix.",
+			BigO:        "Time: O(L) to insert a word of length L, O(p + k) to find the k matches of a prefix of length p, Space: O(total characters)",
+			Parameters: []types.Parameter{
+				{
+					Name:        "word_list",
+					Type:        "string",
+					Description: fmt.Sprintf("Comma-separated words of letters only, up to %d words of at most %d letters; matching ignores case", maxTrieWords, maxTrieWordLength),
+					Default:     "car,cart,care,cat,dog,dot,do",
+					Required:    true,
+				},
+				{
+					Name:        "prefix",
+					Type:        "string",
+					Description: "Prefix to search for; empty matches every word",
+					Default:     "car",
+					Required:    false,
+				},
+			},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (t *Trie) GetMetadata() types.Algorithm {
+	return t.metadata
+}
+
+// trieNode is a node of a trie; a word ends at nodes marked end
+type trieNode struct {
+	children map[rune]*trieNode
+	end      bool
+}
+
+// newTrieNode creates a node with no children
+func newTrieNode() *trieNode {
+	return &trieNode{children: map[rune]*trieNode{}}
+}
+
+// snapshot copies the subtree into nested maps for a step, with children
+// keyed by their character
+func (n *trieNode) snapshot() map[string]interface{} {
+	children := map[string]interface{}{}
+	for char, child := range n.children {
+		children[string(char)] = child.snapshot()
+	}
+	return map[string]interface{}{
+		"end":      n.end,
+		"children": children,
+	}
+}
+
+// parseWordList splits a comma-separated word list, trimming spaces,
+// lowercasing and dropping repeated words
+func parseWordList(value string) ([]string, error) {
+	words := []string{}
+	seen := map[string]bool{}
+	for _, word := range stdstrings.Split(value, ",") {
+		word = stdstrings.ToLower(stdstrings.TrimSpace(word))
+		if word == "" {
+			continue
+		}
+		if err := validateTrieWord(word, "word"); err != nil {
+			return nil, err
+		}
+		if !seen[word] {
+			seen[word] = true
+			words = append(words, word)
+		}
+	}
+
+	if len(words) == 0 {
+		return nil, fmt.Errorf("word_list must contain at least one word")
+	}
+	if len(words) > maxTrieWords {
+		return nil, fmt.Errorf("word_list must contain at most %d distinct words", maxTrieWords)
+	}
+	return words, nil
+}
+
+// validateTrieWord checks that a word or prefix is letters only and short enough
+func validateTrieWord(word, kind string) error {
+	if len(word) > maxTrieWordLength {
+		return fmt.Errorf("%s %q is longer than %d letters", kind, word, maxTrieWordLength)
+	}
+	for _, char := range word {
+		if char < 'a' || char > 'z' {
+			return fmt.Errorf("%s %q must contain only letters", kind, word)
+		}
+	}
+	return nil
+}
+
+// Execute inserts every word and then collects the words under the prefix
+func (t *Trie) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	wordList := "car,cart,care,cat,dog,dot,do"
+	if w, ok := parameters["word_list"].(string); ok {
+		wordList = w
+	}
+	words, err := parseWordList(wordList)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := "car"
+	if p, ok := parameters["prefix"].(string); ok {
+		prefix = stdstrings.ToLower(stdstrings.TrimSpace(p))
+	}
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"words":  words,
+			"prefix": prefix,
+		},
+		Message:   fmt.Sprintf("Inserting %d words, then searching for the prefix %q", len(words), prefix),
+		Timestamp: time.Now(),
+	})
+
+	root := newTrieNode()
+	nodes := 1
+	stepNumber := 1
+
+	for _, word := range words {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		node := root
+		for i, char := range word {
+			child, exists := node.children[char]
+			if !exists {
+				child = newTrieNode()
+				node.children[char] = child
+				nodes++
+			}
+			node = child
+			if i == len(word)-1 {
+				node.end = true
+			}
+
+			message := fmt.Sprintf("Followed the existing %q of %q", char, word)
+			if !exists {
+				message = fmt.Sprintf("Added a node for %q of %q", char, word)
+			}
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "insert_char",
+				Data: map[string]interface{}{
+					"trie":        root.snapshot(),
+					"word":        word,
+					"index":       i,
+					"char":        string(char),
+					"path":        word[:i+1],
+					"created":     !exists,
+					"end_of_word": i == len(word)-1,
+				},
+				Message:   message,
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+		}
+	}
+
+	// Walk the prefix from the root, one node per character
+	traversed := 0
+	node := root
+	for i, char := range prefix {
+		child, exists := node.children[char]
+		traversed++
+
+		message := fmt.Sprintf("No child for %q: no word starts with %q", char, prefix[:i+1])
+		if exists {
+			message = fmt.Sprintf("Followed %q to the node for %q", char, prefix[:i+1])
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "traverse_char",
+			Data: map[string]interface{}{
+				"trie":  root.snapshot(),
+				"index": i,
+				"char":  string(char),
+				"path":  prefix[:i+1],
+				"found": exists,
+			},
+			Message:   message,
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+
+		if !exists {
+			node = nil
+			break
+		}
+		node = child
+	}
+
+	// Every word below the prefix node matches, collected in alphabetical order
+	matches := []string{}
+	var collect func(node *trieNode, path string)
+	collect = func(node *trieNode, path string) {
+		if node.end {
+			matches = append(matches, path)
+
+			stepCallback(types.ExecutionStep{
+				StepNumber: stepNumber,
+				Action:     "match_found",
+				Data: map[string]interface{}{
+					"word":    path,
+					"matches": append([]string{}, matches...),
+				},
+				Message:   fmt.Sprintf("%q starts with %q", path, prefix),
+				Timestamp: time.Now(),
+			})
+			stepNumber++
+		}
+
+		chars := []rune{}
+		for char := range node.children {
+			chars = append(chars, char)
+		}
+		sort.Slice(chars, func(i, j int) bool { return chars[i] < chars[j] })
+		for _, char := range chars {
+			traversed++
+			collect(node.children[char], path+string(char))
+		}
+	}
+	if node != nil {
+		collect(node, prefix)
+	}
+
+	result := map[string]interface{}{
+		"prefix":          prefix,
+		"matches":         matches,
+		"nodes_traversed": traversed,
+		"trie_nodes":      nodes,
+		"trie":            root.snapshot(),
+	}
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data:       result,
+		Message:    fmt.Sprintf("%d of %d words start with %q; %d nodes traversed", len(matches), len(words), prefix, traversed),
+		Timestamp:  time.Now(),
+	})
+
+	return result, nil
+}
+
+// ValidateParameters validates the input parameters
+func (t *Trie) ValidateParameters(parameters map[string]interface{}) error {
+	if wordList, exists := parameters["word_list"]; exists {
+		w, ok := wordList.(string)
+		if !ok {
+			return fmt.Errorf("word_list must be a string")
+		}
+		if _, err := parseWordList(w); err != nil {
+			return err
+		}
+	}
+
+	if prefix, exists := parameters["prefix"]; exists {
+		p, ok := prefix.(string)
+		if !ok {
+			return fmt.Errorf("prefix must be a string")
+		}
+		if err := validateTrieWord(stdstrings.ToLower(stdstrings.TrimSpace(p)), "prefix"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SelfTest checks the matches and traversal count of a known prefix query,
+// including a word that is a prefix of others
+func (t *Trie) SelfTest() error {
+	parameters := map[string]interface{}{"word_list": "car, Cart,care,cat,dog,car", "prefix": "car"}
+	output, err := t.Execute(context.Background(), nil, parameters, func(types.ExecutionStep) {})
+	if err != nil {
+		return fmt.Errorf("execution failed: %v", err)
+	}
+
+	result := output.(map[string]interface{})
+	if matches := fmt.Sprint(result["matches"]); matches != "[car care cart]" {
+		return fmt.Errorf("expected matches [car care cart], got %s", matches)
+	}
+	// Three prefix nodes, then the e and t children below them
+	if traversed := result["nodes_traversed"].(int); traversed != 5 {
+		return fmt.Errorf("expected 5 nodes traversed, got %d", traversed)
+	}
+
+	parameters["prefix"] = "cow"
+	output, err = t.Execute(context.Background(), nil, parameters, func(types.ExecutionStep) {})
+	if err != nil {
+		return fmt.Errorf("execution failed: %v", err)
+	}
+	if matches := output.(map[string]interface{})["matches"].([]string); len(matches) != 0 {
+		return fmt.Errorf("expected no matches for cow, got %v", matches)
+	}
+
+	return nil
+}
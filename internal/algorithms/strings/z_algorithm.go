@@ -0,0 +1,185 @@
+package strings
+
+import (
+	"algorthmia/internal/types"
+	"context"
+	"fmt"
+	"time"
+)
+
+// zSeparator joins the pattern and text. It is treated as matching no
+// character, so it works even when the text contains it
+const zSeparator = "$"
+
+// ZAlgorithm implements string matching with the Z-array
+type ZAlgorithm struct {
+	metadata types.Algorithm
+}
+
+// NewZAlgorithm creates a new ZAlgorithm instance
+func NewZAlgorithm() *ZAlgorithm {
+	return &ZAlgorithm{
+		metadata: types.Algorithm{
+			ID:          "z_algorithm",
+			Name:        "Z-Algorithm",
+			Category:    types.CategoryStrings,
+			Description: "Computes, for every position of pattern + separator + text, the length of the longest substring starting there that is also a prefix (its Z value). The rightmost such match seen so far, the Z-box [L, R], lets most values be copied from earlier ones instead of compared again. A Z value equal to the pattern length marks a match.",
+			BigO:        "Time: O(n + m), Space: O(n + m) for text length n and pattern length m",
+			Parameters:  matchParameters(),
+			RelatedIDs:  []string{"kmp", "naive_string_match"},
+		},
+	}
+}
+
+// GetMetadata returns the algorithm metadata
+func (za *ZAlgorithm) GetMetadata() types.Algorithm {
+	return za.metadata
+}
+
+// Execute builds the Z-array and reads the matches from it
+func (za *ZAlgorithm) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	text, pattern := textAndPattern(parameters)
+	m := len(pattern)
+	combined := pattern + zSeparator + text
+
+	// Send initial state
+	stepCallback(types.ExecutionStep{
+		StepNumber: 0,
+		Action:     "initialize",
+		Data: map[string]interface{}{
+			"text":     text,
+			"pattern":  pattern,
+			"combined": combined,
+		},
+		Message:   fmt.Sprintf("Computing Z values of %q; a value of %d marks a match", combined, m),
+		Timestamp: time.Now(),
+	})
+
+	matches := []int{}
+	comparisons := 0
+	stepNumber := 1
+
+	// equal compares two positions of combined; the separator matches nothing
+	equal := func(i, j int) bool {
+		comparisons++
+		return i != m && j != m && combined[i] == combined[j]
+	}
+
+	z := make([]int, len(combined))
+	l, r := 0, 0 // Z-box: combined[l:r] equals combined[:r-l]
+	for i := 1; i < len(combined); i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		reason := "outside"
+		if i < r {
+			// Inside the Z-box: start from the value at the mirrored position
+			z[i] = z[i-l]
+			if z[i] > r-i {
+				z[i] = r - i
+			}
+			reason = "copied"
+		}
+
+		// Extend only when the copied value reaches the end of the box
+		if i+z[i] >= r {
+			copied := z[i]
+			for i+z[i] < len(combined) && equal(z[i], i+z[i]) {
+				z[i]++
+			}
+			if reason == "copied" && z[i] > copied {
+				reason = "extended"
+			}
+		}
+
+		if i+z[i] > r {
+			l, r = i, i+z[i]
+		}
+
+		message := fmt.Sprintf("Z[%d] = %d, compared outside the Z-box", i, z[i])
+		switch reason {
+		case "copied":
+			message = fmt.Sprintf("Z[%d] = %d, copied from Z[%d] inside the Z-box", i, z[i], i-l)
+		case "extended":
+			message = fmt.Sprintf("Z[%d] = %d, extended past the end of the Z-box", i, z[i])
+		}
+
+		match := z[i] == m
+		if match {
+			matches = append(matches, i-m-1)
+			message += fmt.Sprintf(": pattern found at position %d", i-m-1)
+		}
+
+		stepCallback(types.ExecutionStep{
+			StepNumber: stepNumber,
+			Action:     "compute_z",
+			Data: map[string]interface{}{
+				"index":       i,
+				"z":           z[i],
+				"l":           l,
+				"r":           r - 1,
+				"reason":      reason,
+				"match":       match,
+				"comparisons": comparisons,
+			},
+			Message:   message,
+			Timestamp: time.Now(),
+		})
+		stepNumber++
+	}
+
+	result := matchResult(matches, comparisons)
+	result["z_array"] = z
+
+	// Send final result
+	stepCallback(types.ExecutionStep{
+		StepNumber: -1, // Final step
+		Action:     "complete",
+		Data: map[string]interface{}{
+			"matches":     matches,
+			"comparisons": comparisons,
+			"z_array":     z,
+		},
+		Message:   fmt.Sprintf("Z-algorithm found %d matches with %d comparisons", len(matches), comparisons),
+		Timestamp: time.Now(),
+	})
+
+	return result, nil
+}
+
+// ValidateParameters validates the input parameters
+func (za *ZAlgorithm) ValidateParameters(parameters map[string]interface{}) error {
+	return validateTextAndPattern(parameters)
+}
+
+// SelfTest checks a known Z-array, matches in a text containing the
+// separator, and the linear comparison bound
+func (za *ZAlgorithm) SelfTest() error {
+	output, err := za.Execute(context.Background(), nil, map[string]interface{}{"text": "AABCAAB", "pattern": "AAB"}, func(types.ExecutionStep) {})
+	if err != nil {
+		return fmt.Errorf("execution failed: %v", err)
+	}
+	if zArray := fmt.Sprint(output.(map[string]interface{})["z_array"]); zArray != "[0 1 0 0 3 1 0 0 3 1 0]" {
+		return fmt.Errorf("expected Z-array [0 1 0 0 3 1 0 0 3 1 0], got %s", zArray)
+	}
+
+	for _, c := range [][3]string{
+		{"AABAACAADAABAABA", "AABA", "[0 9 12]"},
+		{"a$b$a$", "a$", "[0 4]"},
+	} {
+		output, err := za.Execute(context.Background(), nil, map[string]interface{}{"text": c[0], "pattern": c[1]}, func(types.ExecutionStep) {})
+		if err != nil {
+			return fmt.Errorf("execution failed: %v", err)
+		}
+
+		result := output.(map[string]interface{})
+		if matches := fmt.Sprint(result["matches"]); matches != c[2] {
+			return fmt.Errorf("searching %q for %q: expected matches %s, got %s", c[0], c[1], c[2], matches)
+		}
+		if comparisons := result["comparisons"].(int); comparisons > 2*(len(c[0])+len(c[1])+1) {
+			return fmt.Errorf("%d comparisons exceed twice the combined length", comparisons)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"algorthmia/internal/algorithms"
+)
+
+// ReloadRegistry replaces the exposed algorithm set with the one selected
+// by the allow and deny lists in the request body, without a restart. An
+// empty body restores the lists configured with ALGORITHMS_ALLOW and
+// ALGORITHMS_DENY
+func (h *Handlers) ReloadRegistry(w http.ResponseWriter, r *http.Request) {
+	// Decode even when no length is declared, since a chunked body may be
+	// empty; io.EOF means there was no body at all
+	filter := algorithms.Filter{Allow: h.config.AlgorithmsAllow, Deny: h.config.AlgorithmsDeny}
+	var requested algorithms.Filter
+	switch err := json.NewDecoder(r.Body).Decode(&requested); {
+	case err == nil:
+		filter = requested
+	case !errors.Is(err, io.EOF):
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	ids, err := h.algorithmRegistry.Reload(filter)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid filter: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	log.Printf("Registry reloaded: exposing %d algorithms", len(ids))
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"algorithms": ids,
+		"count":      len(ids),
+	})
+}
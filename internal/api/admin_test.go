@@ -0,0 +1,191 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"algorthmia/internal/config"
+	"algorthmia/internal/types"
+)
+
+const testAPIKey = "test-key"
+
+// reloadRegistry posts a filter, or an empty body when filter is nil, to
+// the reload endpoint and returns the response with the exposed IDs
+func reloadRegistry(t *testing.T, url, key string, filter interface{}) (*http.Response, []string) {
+	t.Helper()
+
+	body := []byte{}
+	if filter != nil {
+		var err error
+		if body, err = json.Marshal(filter); err != nil {
+			t.Fatalf("encoding filter: %v", err)
+		}
+	}
+
+	request, err := http.NewRequest(http.MethodPost, url+"/api/v1/admin/registry/reload", bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("building request: %v", err)
+	}
+	if key != "" {
+		request.Header.Set(apiKeyHeader, key)
+	}
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatalf("reloading: %v", err)
+	}
+	defer response.Body.Close()
+
+	var result struct {
+		Algorithms []string `json:"algorithms"`
+	}
+	if response.StatusCode == http.StatusOK {
+		if err := json.NewDecoder(response.Body).Decode(&result); err != nil {
+			t.Fatalf("decoding reload response: %v", err)
+		}
+	}
+	return response, result.Algorithms
+}
+
+// authConfig enables API key authentication with a single test key
+func authConfig() *config.Config {
+	return &config.Config{
+		APIAuthEnabled: true,
+		APIKeys:        map[string]string{testAPIKey: "test"},
+	}
+}
+
+func TestReloadRequiresAuthentication(t *testing.T) {
+	open := newTestServer(t, &config.Config{})
+	if response, _ := reloadRegistry(t, open.URL, "", nil); response.StatusCode != http.StatusNotFound {
+		t.Errorf("reload without authentication configured: status %d, expected %d", response.StatusCode, http.StatusNotFound)
+	}
+
+	protected := newTestServer(t, authConfig())
+	if response, _ := reloadRegistry(t, protected.URL, "", nil); response.StatusCode != http.StatusUnauthorized {
+		t.Errorf("reload without a key: status %d, expected %d", response.StatusCode, http.StatusUnauthorized)
+	}
+	if response, _ := reloadRegistry(t, protected.URL, testAPIKey, nil); response.StatusCode != http.StatusOK {
+		t.Errorf("reload with a key: status %d, expected %d", response.StatusCode, http.StatusOK)
+	}
+}
+
+// TestReloadChunkedEmptyBody sends an empty body with no declared length,
+// as chunked requests do, which must reload like any other empty body
+func TestReloadChunkedEmptyBody(t *testing.T) {
+	cfg := authConfig()
+	cfg.AlgorithmsDeny = []string{"bubble_sort"}
+	server := newTestServer(t, cfg)
+
+	request, err := http.NewRequest(http.MethodPost, server.URL+"/api/v1/admin/registry/reload", io.NopCloser(bytes.NewReader(nil)))
+	if err != nil {
+		t.Fatalf("building request: %v", err)
+	}
+	request.ContentLength = -1
+	request.TransferEncoding = []string{"chunked"}
+	request.Header.Set(apiKeyHeader, testAPIKey)
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatalf("reloading: %v", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("reload with a chunked empty body: status %d, expected %d", response.StatusCode, http.StatusOK)
+	}
+	var result struct {
+		Algorithms []string `json:"algorithms"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&result); err != nil {
+		t.Fatalf("decoding reload response: %v", err)
+	}
+	for _, id := range result.Algorithms {
+		if id == "bubble_sort" {
+			t.Errorf("chunked empty reload exposed bubble_sort despite ALGORITHMS_DENY")
+		}
+	}
+}
+
+func TestReloadEmptyBodyRestoresConfiguredFilter(t *testing.T) {
+	cfg := authConfig()
+	cfg.AlgorithmsDeny = []string{"bubble_sort"}
+	server := newTestServer(t, cfg)
+
+	response, ids := reloadRegistry(t, server.URL, testAPIKey, map[string]interface{}{"allow": []string{"bubble_sort", "quick_sort"}})
+	if response.StatusCode != http.StatusOK || len(ids) != 2 {
+		t.Fatalf("reload with an allow list: status %d, exposing %v", response.StatusCode, ids)
+	}
+
+	response, ids = reloadRegistry(t, server.URL, testAPIKey, nil)
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("reload with an empty body: status %d", response.StatusCode)
+	}
+	exposed := map[string]bool{}
+	for _, id := range ids {
+		exposed[id] = true
+	}
+	if exposed["bubble_sort"] {
+		t.Errorf("empty reload exposed bubble_sort despite ALGORITHMS_DENY")
+	}
+	if !exposed["quick_sort"] || !exposed["merge_sort"] {
+		t.Errorf("empty reload did not restore the other algorithms: %v", ids)
+	}
+
+	if response, _ := reloadRegistry(t, server.URL, testAPIKey, map[string]interface{}{"deny": []string{"no_such_sort"}}); response.StatusCode != http.StatusBadRequest {
+		t.Errorf("reload naming an unknown ID: status %d, expected %d", response.StatusCode, http.StatusBadRequest)
+	}
+}
+
+// TestReloadWhileListing swaps the registry while other requests list and
+// look up algorithms. Run with -race
+func TestReloadWhileListing(t *testing.T) {
+	server := newTestServer(t, authConfig())
+	filters := []interface{}{
+		map[string]interface{}{"allow": []string{"bubble_sort"}},
+		map[string]interface{}{"deny": []string{"bubble_sort"}},
+		nil,
+	}
+
+	done := make(chan struct{})
+	var readers sync.WaitGroup
+	for reader := 0; reader < 3; reader++ {
+		readers.Add(1)
+		go func() {
+			defer readers.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+
+				var listing struct {
+					Algorithms []types.Algorithm `json:"algorithms"`
+					Count      int               `json:"count"`
+				}
+				if err := fetchJSON(server.URL+"/api/v1/algorithms", &listing); err != nil {
+					t.Errorf("listing: %v", err)
+					return
+				}
+				if len(listing.Algorithms) != listing.Count {
+					t.Errorf("listing has %d algorithms but a count of %d", len(listing.Algorithms), listing.Count)
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < 30; i++ {
+		if response, _ := reloadRegistry(t, server.URL, testAPIKey, filters[i%len(filters)]); response.StatusCode != http.StatusOK {
+			t.Errorf("reload %d: status %d", i, response.StatusCode)
+		}
+	}
+
+	close(done)
+	readers.Wait()
+}
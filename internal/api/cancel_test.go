@@ -0,0 +1,72 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"algorthmia/internal/algorithms/sorting"
+	"algorthmia/internal/config"
+	"algorthmia/internal/types"
+)
+
+// TestCancelStopsRunningExecution cancels a paced bubble sort part way
+// through. It must stop well before the remaining steps would have taken
+func TestCancelStopsRunningExecution(t *testing.T) {
+	server := newTestServer(t, &config.Config{})
+
+	// 30 elements take several hundred steps, so over 10s at 20ms a step
+	id := execute(t, server, "bubble_sort", map[string]interface{}{"array_size": 30, "step_delay_ms": 20})
+	waitForStatus(t, server, id, time.Second, types.StatusRunning)
+
+	var cancelled map[string]interface{}
+	response := doJSON(t, http.MethodPost, server.URL+"/api/v1/executions/"+id+"/cancel", nil, &cancelled)
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("cancelling: status %d", response.StatusCode)
+	}
+
+	execution := waitForStatus(t, server, id, 2*time.Second, types.StatusCancelled)
+	if execution.EndTime == nil {
+		t.Fatalf("cancelled execution has no end time")
+	}
+	if execution.Output != nil {
+		t.Errorf("cancelled execution has output %v", execution.Output)
+	}
+
+	response = doJSON(t, http.MethodPost, server.URL+"/api/v1/executions/"+id+"/cancel", nil, nil)
+	if response.StatusCode != http.StatusConflict {
+		t.Errorf("cancelling again: status %d, expected %d", response.StatusCode, http.StatusConflict)
+	}
+}
+
+// TestRunAlgorithmReturnsCancelCause checks that an algorithm stops soon
+// after its context is cancelled and the run reports the cause
+func TestRunAlgorithmReturnsCancelCause(t *testing.T) {
+	ctx, cancel := context.WithCancelCause(context.Background())
+	execution := &types.AlgorithmExecution{
+		ID:          "exec_test",
+		AlgorithmID: "bubble_sort",
+		Parameters:  map[string]interface{}{"array_size": 30},
+	}
+
+	steps := 0
+	output, err := runAlgorithm(ctx, sorting.NewBubbleSort(), execution, func(types.ExecutionStep) {
+		steps++
+		if steps == 5 {
+			cancel(errExecutionCancelled)
+		}
+	})
+
+	if !errors.Is(err, errExecutionCancelled) {
+		t.Fatalf("error is %v, expected %v", err, errExecutionCancelled)
+	}
+	if output != nil {
+		t.Errorf("output is %v, expected none", output)
+	}
+	// Bubble sort checks between passes, so it may finish the current one
+	if steps > 100 {
+		t.Errorf("bubble sort ran %d steps after being cancelled at step 5", steps)
+	}
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock tells the executor the time. Step timestamps, start and end times
+// and the durations derived from them all come from it, so a FakeClock
+// makes them exact
+type Clock interface {
+	Now() time.Time
+}
+
+// realClock reads the system clock
+type realClock struct{}
+
+// Now returns the current system time
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// FakeClock is a deterministic Clock for tests. Every reading returns the
+// current time and then advances it by the tick, so consecutive timestamps
+// differ by exactly the tick; a zero tick keeps the time fixed until Advance
+type FakeClock struct {
+	now   time.Time
+	tick  time.Duration
+	mutex sync.Mutex
+}
+
+// NewFakeClock creates a fake clock reading start first
+func NewFakeClock(start time.Time, tick time.Duration) *FakeClock {
+	return &FakeClock{now: start, tick: tick}
+}
+
+// Now returns the fake time and advances it by the tick
+func (c *FakeClock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := c.now
+	c.now = c.now.Add(c.tick)
+	return now
+}
+
+// Advance moves the fake time forward by d
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.now = c.now.Add(d)
+}
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"time"
+
+	"algorthmia/internal/types"
+)
+
+// stringMatcherIDs lists the string matching algorithms compared side by
+// side, in the order they are reported. Matchers that are not registered
+// are skipped
+var stringMatcherIDs = []string{"naive_string_match", "kmp", "rabin_karp", "boyer_moore", "z_algorithm"}
+
+// matcherResult is one matcher's outcome in a string matcher comparison
+type matcherResult struct {
+	AlgorithmID string `json:"algorithm_id"`
+	Name        string `json:"name"`
+	Matches     []int  `json:"matches"`
+	Comparisons int    `json:"comparisons"`
+}
+
+// CompareStringMatchers runs every available string matcher on the same
+// text and pattern without recording steps, and reports each one's match
+// positions and comparison count. All matchers must agree on the positions
+func (h *Handlers) CompareStringMatchers(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		Text    string `json:"text"`
+		Pattern string `json:"pattern"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	parameters := map[string]interface{}{
+		"text":    request.Text,
+		"pattern": request.Pattern,
+	}
+
+	// The matchers run one after another, so the comparison holds a single
+	// execution slot for its whole duration
+	if !h.limiter.acquire() {
+		h.limiter.rejectOverloaded(w)
+		return
+	}
+	start := time.Now()
+	defer func() { h.limiter.release(time.Since(start)) }()
+
+	results := []matcherResult{}
+	for _, id := range stringMatcherIDs {
+		algorithm, exists := h.algorithmRegistry.GetAlgorithm(id)
+		if !exists {
+			continue
+		}
+
+		if err := algorithm.ValidateParameters(parameters); err != nil {
+			http.Error(w, fmt.Sprintf("Invalid parameters: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		output, err := algorithm.Execute(r.Context(), nil, parameters, func(types.ExecutionStep) {})
+		if err != nil {
+			http.Error(w, fmt.Sprintf("%s failed: %v", id, err), http.StatusInternalServerError)
+			return
+		}
+
+		result, ok := output.(map[string]interface{})
+		matches, matchesOK := result["matches"].([]int)
+		comparisons, comparisonsOK := result["comparisons"].(int)
+		if !ok || !matchesOK || !comparisonsOK {
+			http.Error(w, fmt.Sprintf("%s did not report matches and comparisons", id), http.StatusInternalServerError)
+			return
+		}
+
+		results = append(results, matcherResult{
+			AlgorithmID: id,
+			Name:        algorithm.GetMetadata().Name,
+			Matches:     matches,
+			Comparisons: comparisons,
+		})
+	}
+
+	if len(results) == 0 {
+		http.Error(w, "No string matchers are available", http.StatusNotFound)
+		return
+	}
+
+	// Every matcher must find exactly the same positions
+	for _, result := range results[1:] {
+		if !reflect.DeepEqual(result.Matches, results[0].Matches) {
+			http.Error(w, fmt.Sprintf("Matcher mismatch: %s found %v but %s found %v",
+				results[0].AlgorithmID, results[0].Matches, result.AlgorithmID, result.Matches), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"text":    request.Text,
+		"pattern": request.Pattern,
+		"matches": results[0].Matches,
+		"results": results,
+	})
+}
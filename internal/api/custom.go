@@ -0,0 +1,121 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"algorthmia/internal/types"
+)
+
+// maxCustomSteps caps the number of steps accepted in a custom visualization
+const maxCustomSteps = 5000
+
+// customVisualizationID is the algorithm ID recorded for custom visualizations
+const customVisualizationID = "custom"
+
+// customVisualization replays a precomputed sequence of steps through the
+// regular execution pipeline
+type customVisualization struct {
+	metadata types.Algorithm
+	steps    []types.ExecutionStep
+}
+
+// GetMetadata returns the visualization metadata
+func (cv *customVisualization) GetMetadata() types.Algorithm {
+	return cv.metadata
+}
+
+// Execute emits the stored steps in order. The executor stamps each one
+// with the time it is replayed
+func (cv *customVisualization) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	for _, step := range cv.steps {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		stepCallback(step)
+	}
+
+	return map[string]interface{}{
+		"title":       cv.metadata.Name,
+		"steps_count": len(cv.steps),
+	}, nil
+}
+
+// ValidateParameters accepts no parameters
+func (cv *customVisualization) ValidateParameters(parameters map[string]interface{}) error {
+	return nil
+}
+
+// CreateCustomVisualization stores a user-provided sequence of steps and
+// plays it back like a real execution
+func (h *Handlers) CreateCustomVisualization(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		Title    string                  `json:"title"`
+		Category types.AlgorithmCategory `json:"category"`
+		Steps    []types.ExecutionStep   `json:"steps"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := validateCustomVisualization(request.Title, request.Category, request.Steps); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid custom visualization: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	visualization := &customVisualization{
+		metadata: types.Algorithm{
+			ID:       customVisualizationID,
+			Name:     request.Title,
+			Category: request.Category,
+		},
+		steps: request.Steps,
+	}
+
+	if !h.limiter.acquire() {
+		h.limiter.rejectOverloaded(w)
+		return
+	}
+	record := h.startExecution(visualization, map[string]interface{}{
+		"title":    request.Title,
+		"category": request.Category,
+	}, nil, "")
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"execution_id": record.execution.ID,
+		"status":       "started",
+		"steps_count":  len(request.Steps),
+		"message":      "Custom visualization playback started",
+	})
+}
+
+// validateCustomVisualization checks the title, category and shape of every step
+func validateCustomVisualization(title string, category types.AlgorithmCategory, steps []types.ExecutionStep) error {
+	if title == "" || len(title) > 200 {
+		return fmt.Errorf("title must be between 1 and 200 characters")
+	}
+
+	if !category.IsValid() {
+		return fmt.Errorf("unknown category %q", category)
+	}
+
+	if len(steps) == 0 || len(steps) > maxCustomSteps {
+		return fmt.Errorf("steps must contain between 1 and %d entries", maxCustomSteps)
+	}
+
+	for i, step := range steps {
+		if step.Action == "" {
+			return fmt.Errorf("step %d is missing an action", i)
+		}
+		if step.Data == nil {
+			return fmt.Errorf("step %d is missing data", i)
+		}
+	}
+
+	return nil
+}
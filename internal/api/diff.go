@@ -0,0 +1,174 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"algorthmia/internal/types"
+)
+
+// stepState is one side of the first divergence between two executions
+type stepState struct {
+	Seq    int             `json:"seq"`
+	Action string          `json:"action"`
+	Array  json.RawMessage `json:"array"`
+}
+
+// DiffExecutions aligns the stored steps of two executions one to one and
+// reports where their array states first diverge, along with the
+// differences in step counts and operation counts. The executions must have
+// run on the same input, and neither may have had steps dropped
+func (h *Handlers) DiffExecutions(w http.ResponseWriter, r *http.Request) {
+	idA, idB := r.URL.Query().Get("a"), r.URL.Query().Get("b")
+	if idA == "" || idB == "" {
+		http.Error(w, "Both a and b execution IDs are required", http.StatusBadRequest)
+		return
+	}
+
+	recordA, existsA := h.store.Get(idA)
+	recordB, existsB := h.store.Get(idB)
+	if !existsA || !existsB {
+		missing := idA
+		if existsA {
+			missing = idB
+		}
+		http.Error(w, fmt.Sprintf("Execution %s not found", missing), http.StatusNotFound)
+		return
+	}
+
+	a, b := recordA.snapshot(), recordB.snapshot()
+	for _, execution := range []types.AlgorithmExecution{a, b} {
+		if execution.Status == types.StatusPending || execution.Status == types.StatusRunning || execution.Status == types.StatusPaused {
+			http.Error(w, fmt.Sprintf("Execution %s has not finished", execution.ID), http.StatusConflict)
+			return
+		}
+		for _, step := range execution.Steps {
+			if step.Action == stepsTruncatedAction {
+				http.Error(w, fmt.Sprintf("Execution %s has dropped steps and cannot be aligned", execution.ID), http.StatusConflict)
+				return
+			}
+		}
+	}
+
+	inputA, inputB := executionInput(a), executionInput(b)
+	if inputA == nil || inputB == nil {
+		http.Error(w, "Both executions must record an input or an initial array state to be compared", http.StatusBadRequest)
+		return
+	}
+	if !bytes.Equal(inputA, inputB) {
+		http.Error(w, fmt.Sprintf("Executions ran on different inputs (%s vs %s), so their steps cannot be meaningfully diffed", inputA, inputB), http.StatusBadRequest)
+		return
+	}
+
+	// Walk both step lists together, carrying the latest array state forward
+	// through steps that do not report one
+	var divergence map[string]interface{}
+	var arrayA, arrayB json.RawMessage
+	aligned := len(a.Steps)
+	if len(b.Steps) < aligned {
+		aligned = len(b.Steps)
+	}
+	for i := 0; i < aligned && divergence == nil; i++ {
+		if state := stepArray(a.Steps[i]); state != nil {
+			arrayA = state
+		}
+		if state := stepArray(b.Steps[i]); state != nil {
+			arrayB = state
+		}
+		if !bytes.Equal(arrayA, arrayB) {
+			divergence = map[string]interface{}{
+				"step_index": i,
+				"a":          stepState{Seq: a.Steps[i].Seq, Action: a.Steps[i].Action, Array: arrayA},
+				"b":          stepState{Seq: b.Steps[i].Seq, Action: b.Steps[i].Action, Array: arrayB},
+			}
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"a": map[string]interface{}{
+			"execution_id": a.ID,
+			"algorithm_id": a.AlgorithmID,
+			"steps_count":  len(a.Steps),
+		},
+		"b": map[string]interface{}{
+			"execution_id": b.ID,
+			"algorithm_id": b.AlgorithmID,
+			"steps_count":  len(b.Steps),
+		},
+		"input":                 inputA,
+		"aligned_steps":         aligned,
+		"first_divergence":      divergence,
+		"steps_count_delta":     len(b.Steps) - len(a.Steps),
+		"steps_by_action_delta": actionDeltas(a.Steps, b.Steps),
+		"operations_delta":      operationDeltas(operationCounts(a.Output), operationCounts(b.Output)),
+	})
+}
+
+// executionInput returns the JSON form of an execution's input: the input
+// it was given, or else the array in its first step
+func executionInput(execution types.AlgorithmExecution) json.RawMessage {
+	if execution.Input != nil {
+		input, _ := json.Marshal(execution.Input)
+		return input
+	}
+	if len(execution.Steps) > 0 {
+		return stepArray(execution.Steps[0])
+	}
+	return nil
+}
+
+// stepArray returns the JSON form of a step's array state, or nil if it has none
+func stepArray(step types.ExecutionStep) json.RawMessage {
+	array, exists := step.Data["array"]
+	if !exists {
+		return nil
+	}
+	state, _ := json.Marshal(array)
+	return state
+}
+
+// actionDeltas counts the steps of each action and returns b's count minus
+// a's, leaving out actions with equal counts
+func actionDeltas(a, b []types.ExecutionStep) map[string]int {
+	deltas := make(map[string]int)
+	for _, step := range a {
+		deltas[step.Action]--
+	}
+	for _, step := range b {
+		deltas[step.Action]++
+	}
+	for action, delta := range deltas {
+		if delta == 0 {
+			delete(deltas, action)
+		}
+	}
+	return deltas
+}
+
+// operationDeltas returns b's operation counters minus a's, for counters
+// that are numeric in both
+func operationDeltas(a, b map[string]interface{}) map[string]float64 {
+	deltas := make(map[string]float64)
+	for name, valueA := range a {
+		countA, okA := operationCount(valueA)
+		countB, okB := operationCount(b[name])
+		if okA && okB {
+			deltas[name] = countB - countA
+		}
+	}
+	return deltas
+}
+
+// operationCount converts a numeric operation counter to float64
+func operationCount(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
@@ -0,0 +1,59 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"algorthmia/internal/config"
+	"algorthmia/internal/types"
+
+	gorilla "github.com/gorilla/websocket"
+)
+
+// TestDisconnectDuringBroadcast closes a WebSocket while its execution is
+// broadcasting steps as fast as it can. Cancelling the execution from the
+// hub's disconnect handling must not wait on the executor, which may itself
+// be waiting to broadcast through the hub, and the stored execution must
+// stay readable throughout
+func TestDisconnectDuringBroadcast(t *testing.T) {
+	server := newTestServer(t, &config.Config{CancelOnDisconnect: true})
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws"
+
+	for attempt := 0; attempt < 20; attempt++ {
+		conn, _, err := gorilla.DefaultDialer.Dial(wsURL, nil)
+		if err != nil {
+			t.Fatalf("dialing: %v", err)
+		}
+
+		var connected struct {
+			Data struct {
+				ClientID string `json:"client_id"`
+			} `json:"data"`
+		}
+		if err := conn.ReadJSON(&connected); err != nil {
+			t.Fatalf("reading connection message: %v", err)
+		}
+
+		var started struct {
+			ExecutionID string `json:"execution_id"`
+		}
+		url := fmt.Sprintf("%s/api/v1/algorithms/bubble_sort/execute", server.URL)
+		body := map[string]interface{}{
+			"parameters": map[string]interface{}{"array_size": 100},
+			"client_id":  connected.Data.ClientID,
+		}
+		if response := doJSON(t, http.MethodPost, url, body, &started); response.StatusCode != http.StatusOK {
+			t.Fatalf("executing: status %d", response.StatusCode)
+		}
+
+		conn.Close()
+
+		execution := waitForStatus(t, server, started.ExecutionID, 5*time.Second, types.StatusCompleted, types.StatusCancelled)
+		if execution.EndTime == nil {
+			t.Fatalf("attempt %d: execution %s is %s without an end time", attempt, execution.ID, execution.Status)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Error codes sent in execution_error and execution_cancel messages, so
+// clients can tell a run to retry from input to fix or a bug to report
+const (
+	codeCancelled    = "cancelled"     // The run was cancelled on request or when its client disconnected
+	codePanic        = "panic"         // The algorithm panicked
+	codeInvalidInput = "invalid_input" // The algorithm rejected its input or parameters
+	codeInternal     = "internal"      // Any other failure
+)
+
+// errExecutionCancelled aborts an execution whose context was cancelled
+var errExecutionCancelled = errors.New("execution cancelled")
+
+// algorithmError is an error returned by an algorithm's Execute. Algorithms
+// return errors only to reject the input or parameters they were given
+type algorithmError struct {
+	err error
+}
+
+func (e *algorithmError) Error() string { return e.err.Error() }
+func (e *algorithmError) Unwrap() error { return e.err }
+
+// panicError is a panic recovered from an algorithm
+type panicError struct {
+	value interface{}
+}
+
+func (e *panicError) Error() string {
+	return fmt.Sprintf("algorithm panicked: %v", e.value)
+}
+
+// errorCode classifies an execution error
+func errorCode(err error) string {
+	var algorithmErr *algorithmError
+	var panicErr *panicError
+
+	switch {
+	case errors.Is(err, errExecutionCancelled):
+		return codeCancelled
+	case errors.As(err, &panicErr):
+		return codePanic
+	case errors.As(err, &algorithmErr):
+		return codeInvalidInput
+	default:
+		return codeInternal
+	}
+}
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"algorthmia/internal/types"
+)
+
+// mockAlgorithm runs a function in place of an algorithm
+type mockAlgorithm struct {
+	execute func(ctx context.Context, stepCallback func(types.ExecutionStep)) (interface{}, error)
+}
+
+func (m *mockAlgorithm) GetMetadata() types.Algorithm {
+	return types.Algorithm{ID: "mock", Name: "Mock"}
+}
+
+func (m *mockAlgorithm) Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+	return m.execute(ctx, stepCallback)
+}
+
+func (m *mockAlgorithm) ValidateParameters(parameters map[string]interface{}) error {
+	return nil
+}
+
+func TestRunAlgorithmErrorCodes(t *testing.T) {
+	cases := []struct {
+		name    string
+		execute func(ctx context.Context, stepCallback func(types.ExecutionStep)) (interface{}, error)
+		cancel  bool
+		code    string
+	}{
+		{
+			name: "cancelled",
+			execute: func(ctx context.Context, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+				return nil, ctx.Err()
+			},
+			cancel: true,
+			code:   codeCancelled,
+		},
+		{
+			name: "cancelled while returning a result",
+			execute: func(ctx context.Context, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+				return []int{1, 2, 3}, nil
+			},
+			cancel: true,
+			code:   codeCancelled,
+		},
+		{
+			name: "panic",
+			execute: func(ctx context.Context, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+				var arr []int
+				return arr[3], nil
+			},
+			code: codePanic,
+		},
+		{
+			name: "invalid input",
+			execute: func(ctx context.Context, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+				return nil, errors.New("invalid input type, expected []int")
+			},
+			code: codeInvalidInput,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancelCause(context.Background())
+			defer cancel(nil)
+			if c.cancel {
+				cancel(errExecutionCancelled)
+			}
+
+			execution := &types.AlgorithmExecution{ID: "exec_test", AlgorithmID: "mock"}
+			output, err := runAlgorithm(ctx, &mockAlgorithm{execute: c.execute}, execution, func(types.ExecutionStep) {})
+			if err == nil {
+				t.Fatalf("run succeeded with output %v", output)
+			}
+			if output != nil {
+				t.Errorf("failed run has output %v", output)
+			}
+			if code := errorCode(err); code != c.code {
+				t.Errorf("code is %q for %v, expected %q", code, err, c.code)
+			}
+		})
+	}
+}
+
+func TestErrorCodeInternal(t *testing.T) {
+	for _, err := range []error{errors.New("store unavailable"), context.DeadlineExceeded} {
+		if code := errorCode(err); code != codeInternal {
+			t.Errorf("code is %q for %v, expected %q", code, err, codeInternal)
+		}
+	}
+}
+
+func TestRunAlgorithmSucceeds(t *testing.T) {
+	execution := &types.AlgorithmExecution{ID: "exec_test", AlgorithmID: "mock"}
+	algorithm := &mockAlgorithm{execute: func(ctx context.Context, stepCallback func(types.ExecutionStep)) (interface{}, error) {
+		return 42, nil
+	}}
+
+	output, err := runAlgorithm(context.Background(), algorithm, execution, func(types.ExecutionStep) {})
+	if err != nil || output != 42 {
+		t.Fatalf("got %v, %v; expected 42, nil", output, err)
+	}
+}
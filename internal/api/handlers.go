@@ -1,12 +1,18 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log"
 	"net/http"
+	"reflect"
+	"runtime/debug"
 	"time"
 
 	"algorthmia/internal/algorithms"
+	"algorthmia/internal/config"
 	"algorthmia/internal/types"
 	"algorthmia/internal/websocket"
 
@@ -17,14 +23,37 @@ import (
 type Handlers struct {
 	algorithmRegistry *algorithms.Registry
 	hub               *websocket.Hub
+	store             *ExecutionStore
+	sequences         *SequenceStore
+	limiter           *executionLimiter
+	clock             Clock
+	config            *config.Config
 }
 
 // NewHandlers creates a new Handlers instance
-func NewHandlers(algorithmRegistry *algorithms.Registry, hub *websocket.Hub) *Handlers {
-	return &Handlers{
+func NewHandlers(algorithmRegistry *algorithms.Registry, hub *websocket.Hub, cfg *config.Config) *Handlers {
+	h := &Handlers{
 		algorithmRegistry: algorithmRegistry,
 		hub:               hub,
+		store:             NewExecutionStore(cfg.StoredStepsHead, cfg.StoredStepsTail, cfg.MaxStepDataBytes, cfg.MaxStoredExecutions),
+		sequences:         NewSequenceStore(cfg.MaxStoredExecutions),
+		limiter:           newExecutionLimiter(cfg.MaxConcurrentExecutions),
+		clock:             realClock{},
+		config:            cfg,
 	}
+
+	if cfg.CancelOnDisconnect {
+		hub.OnDisconnect(h.cancelClientExecutions)
+	}
+
+	return h
+}
+
+// SetClock replaces the clock that executions read the time from. It is
+// meant for tests and must be called before any execution starts
+func (h *Handlers) SetClock(clock Clock) {
+	h.clock = clock
+	h.store.clock = clock
 }
 
 // GetAlgorithms returns all available algorithms
@@ -50,7 +79,7 @@ func (h *Handlers) GetAlgorithm(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(algorithm)
+	json.NewEncoder(w).Encode(algorithm.GetMetadata())
 }
 
 // ExecuteAlgorithm executes an algorithm with given parameters
@@ -67,6 +96,7 @@ func (h *Handlers) ExecuteAlgorithm(w http.ResponseWriter, r *http.Request) {
 	var request struct {
 		Parameters map[string]interface{} `json:"parameters"`
 		Input      interface{}            `json:"input,omitempty"`
+		ClientID   string                 `json:"client_id,omitempty"`
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
@@ -74,99 +104,468 @@ func (h *Handlers) ExecuteAlgorithm(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Validate parameters
-	if err := algorithm.ValidateParameters(request.Parameters); err != nil {
+	// Validate the parameters the algorithm will actually run with
+	parameters := effectiveParameters(algorithm, request.Parameters)
+	if err := checkParameterTypes(algorithm, parameters); err != nil {
 		http.Error(w, fmt.Sprintf("Invalid parameters: %v", err), http.StatusBadRequest)
 		return
 	}
+	if err := algorithm.ValidateParameters(parameters); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid parameters: %v", err), http.StatusBadRequest)
+		return
+	}
+	pacing, err := parseStepPacing(parameters)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid parameters: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	// Convert the input to the shape the algorithm declares
+	input, err := normalizeInput(algorithm.GetMetadata().InputType, request.Input)
+	if err == nil {
+		err = checkInputSize(algorithm.GetMetadata(), input)
+	}
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid input: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	// Tie the execution to the WebSocket connection that asked for it
+	if request.ClientID != "" && !h.hub.HasClient(request.ClientID) {
+		http.Error(w, fmt.Sprintf("Unknown client_id %q: no WebSocket connection has that ID", request.ClientID), http.StatusBadRequest)
+		return
+	}
+
+	if !h.limiter.acquire() {
+		h.limiter.rejectOverloaded(w)
+		return
+	}
+	record := h.startExecution(algorithm, parameters, input, request.ClientID)
 
-	// Create execution ID
-	executionID := fmt.Sprintf("exec_%d", time.Now().UnixNano())
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"execution_id":         record.execution.ID,
+		"status":               "started",
+		"message":              "Algorithm execution started",
+		"effective_parameters": record.execution.Parameters,
+		"input":                record.execution.Input,
+		"step_pacing":          pacing.describe(),
+	})
+}
+
+// startExecution stores a new execution and runs it in the background in
+// a slot already reserved with h.limiter.acquire, releasing it when done
+func (h *Handlers) startExecution(algorithm types.AlgorithmExecutor, parameters map[string]interface{}, input interface{}, clientID string) *executionRecord {
+	record := h.newExecution(algorithm, parameters, input, types.StatusRunning, clientID)
+
+	// Execute algorithm in a goroutine
+	go func() {
+		start := time.Now()
+		h.executeAlgorithmAsync(algorithm, record)
+		h.limiter.release(time.Since(start))
+	}()
+
+	return record
+}
+
+// newExecution creates and stores an execution without running it. A
+// non-empty clientID ties the execution to that WebSocket connection
+func (h *Handlers) newExecution(algorithm types.AlgorithmExecutor, parameters map[string]interface{}, input interface{}, status types.ExecutionStatus, clientID string) *executionRecord {
+	parameters = withSeed(algorithm, parameters)
+
+	// Generate the problem instance now rather than inside Execute, so the
+	// stored input reproduces the run and reruns reuse the same instance
+	if generator, ok := algorithm.(types.InstanceGenerator); ok && input == nil {
+		input = generator.GenerateInstance(parameters)
+	}
 
 	// Create execution context
 	execution := &types.AlgorithmExecution{
-		ID:          executionID,
-		AlgorithmID: algorithmID,
-		Parameters:  request.Parameters,
-		Input:       request.Input,
+		ID:          nextID("exec"),
+		AlgorithmID: algorithm.GetMetadata().ID,
+		Parameters:  parameters,
+		Input:       input,
 		Steps:       []types.ExecutionStep{},
-		Status:      types.StatusRunning,
-		StartTime:   time.Now(),
+		Status:      status,
+		StartTime:   h.clock.Now(),
+		ClientID:    clientID,
 	}
 
-	// Execute algorithm in a goroutine
-	go h.executeAlgorithmAsync(algorithm, execution)
+	// Store the execution so it can be queried and streamed
+	record := h.store.Add(execution, algorithm)
+	record.attach(&hubSink{hub: h.hub, executionID: execution.ID})
+
+	return record
+}
+
+// withSeed fills in a random seed for algorithms that accept one when the
+// request left it out, so the stored parameters reproduce the run exactly
+func withSeed(algorithm types.AlgorithmExecutor, parameters map[string]interface{}) map[string]interface{} {
+	if _, exists := parameters["seed"]; exists {
+		return parameters
+	}
+
+	for _, parameter := range algorithm.GetMetadata().Parameters {
+		if parameter.Name == "seed" {
+			seeded := make(map[string]interface{}, len(parameters)+1)
+			for name, value := range parameters {
+				seeded[name] = value
+			}
+			seeded["seed"] = int(time.Now().UnixNano() & 0x7fffffff)
+			return seeded
+		}
+	}
+
+	return parameters
+}
+
+// RerunExecution starts a fresh execution with the same algorithm,
+// parameters (including the seed) and input as a stored one
+func (h *Handlers) RerunExecution(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	executionID := vars["id"]
+
+	record, exists := h.store.Get(executionID)
+	if !exists {
+		http.Error(w, "Execution not found", http.StatusNotFound)
+		return
+	}
+
+	if !h.limiter.acquire() {
+		h.limiter.rejectOverloaded(w)
+		return
+	}
+
+	original := record.snapshot()
+	parameters := effectiveParameters(record.algorithm, original.Parameters)
+	rerun := h.startExecution(record.algorithm, parameters, original.Input, "")
+	pacing, _ := parseStepPacing(parameters)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"execution_id":         rerun.execution.ID,
+		"rerun_of":             executionID,
+		"status":               "started",
+		"message":              "Algorithm execution restarted",
+		"effective_parameters": rerun.execution.Parameters,
+		"input":                rerun.execution.Input,
+		"step_pacing":          pacing.describe(),
+	})
+}
+
+// CancelExecution stops an unfinished execution at its next step. It ends
+// with status cancelled and an execution_cancel message
+func (h *Handlers) CancelExecution(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	executionID := vars["id"]
+
+	record, exists := h.store.Get(executionID)
+	if !exists {
+		http.Error(w, "Execution not found", http.StatusNotFound)
+		return
+	}
+
+	if !record.requestCancel() {
+		http.Error(w, fmt.Sprintf("Execution %s has already finished", executionID), http.StatusConflict)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"execution_id": executionID,
+		"status":       "cancelling",
+		"message":      "Execution will stop at its next step",
+	})
+}
+
+// PauseExecution holds a running execution before its next step until it
+// is resumed
+func (h *Handlers) PauseExecution(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	executionID := vars["id"]
+
+	record, exists := h.store.Get(executionID)
+	if !exists {
+		http.Error(w, "Execution not found", http.StatusNotFound)
+		return
+	}
+
+	if !record.pause() {
+		http.Error(w, fmt.Sprintf("Execution %s is not running", executionID), http.StatusConflict)
+		return
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(map[string]interface{}{
 		"execution_id": executionID,
-		"status":       "started",
-		"message":      "Algorithm execution started",
+		"status":       types.StatusPaused,
+		"message":      "Execution will pause before its next step",
 	})
 }
 
-// executeAlgorithmAsync executes the algorithm and sends updates via WebSocket
-func (h *Handlers) executeAlgorithmAsync(algorithm types.AlgorithmExecutor, execution *types.AlgorithmExecution) {
+// ResumeExecution lets a paused execution continue
+func (h *Handlers) ResumeExecution(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	executionID := vars["id"]
+
+	record, exists := h.store.Get(executionID)
+	if !exists {
+		http.Error(w, "Execution not found", http.StatusNotFound)
+		return
+	}
+
+	if !record.resume() {
+		http.Error(w, fmt.Sprintf("Execution %s is not paused", executionID), http.StatusConflict)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"execution_id": executionID,
+		"status":       types.StatusRunning,
+		"message":      "Execution resumed",
+	})
+}
+
+// executeAlgorithmAsync executes the algorithm and publishes updates to the execution's sinks
+func (h *Handlers) executeAlgorithmAsync(algorithm types.AlgorithmExecutor, record *executionRecord) {
+	execution := record.execution
+
+	// The parameters were validated when the execution was created. Ramps
+	// follow the steps' progress estimates; steps without one keep the last
+	// estimate, so algorithms that report none stay at the schedule's start
+	pacing, _ := parseStepPacing(execution.Parameters)
+	progress := 0.0
+
 	stepCallback := func(step types.ExecutionStep) {
-		execution.Steps = append(execution.Steps, step)
+		// Once the run has been cancelled the algorithm stops at its next
+		// check of the context; steps until then are dropped
+		if record.ctx.Err() != nil {
+			return
+		}
 
-		// Send step update via WebSocket
-		message := types.WebSocketMessage{
-			Type:      string(types.MessageTypeExecutionStep),
-			Data:      step,
-			Timestamp: time.Now(),
+		// Hold the step back while the execution is paused
+		if record.waitIfPaused() != nil {
+			return
 		}
 
-		jsonData, _ := json.Marshal(message)
-		h.hub.Broadcast(jsonData)
+		// Stamp the step here rather than trusting the algorithm's own
+		// timestamp, so every step is on the executor's clock
+		step.Timestamp = h.clock.Now()
+
+		// Send step update to all sinks
+		record.publishStep(step)
+
+		if step.Progress != nil {
+			progress = *step.Progress
+		}
+		if delay := pacing.delay(progress); delay > 0 && step.StepNumber != -1 {
+			select {
+			case <-time.After(delay):
+			case <-record.ctx.Done():
+			}
+		}
 	}
 
 	// Execute the algorithm
-	output, err := algorithm.Execute(execution.Input, execution.Parameters, stepCallback)
-
-	execution.Status = types.StatusCompleted
-	now := time.Now()
-	execution.EndTime = &now
-	execution.Output = output
+	output, err := runAlgorithm(record.ctx, algorithm, execution, stepCallback)
 
 	// Send completion message
+	endTime := h.clock.Now()
+	status := types.StatusCompleted
 	var messageType types.WebSocketMessageType
 	var messageData interface{}
 
-	if err != nil {
-		execution.Status = types.StatusError
+	if errors.Is(err, errExecutionCancelled) {
+		status = types.StatusCancelled
+		messageType = types.MessageTypeExecutionCancel
+		messageData = map[string]interface{}{
+			"execution_id": execution.ID,
+			"reason":       err.Error(),
+			"code":         codeCancelled,
+		}
+	} else if err != nil {
+		status = types.StatusError
 		messageType = types.MessageTypeExecutionError
 		messageData = map[string]interface{}{
 			"execution_id": execution.ID,
 			"error":        err.Error(),
+			"code":         errorCode(err),
 		}
 	} else {
 		messageType = types.MessageTypeExecutionComplete
 		messageData = map[string]interface{}{
 			"execution_id": execution.ID,
 			"output":       output,
-			"steps_count":  len(execution.Steps),
+			"steps_count":  record.stepsCount(),
+			"summary":      record.summary(output, endTime),
 		}
 	}
 
 	message := types.WebSocketMessage{
 		Type:      string(messageType),
 		Data:      messageData,
-		Timestamp: time.Now(),
+		Timestamp: endTime,
 	}
 
-	jsonData, _ := json.Marshal(message)
-	h.hub.Broadcast(jsonData)
+	record.finish(status, output, message)
+
+	h.logSlowExecution(execution, endTime)
+}
+
+// runAlgorithm executes the algorithm under the execution's context. Once
+// the context is cancelled the run counts as stopped, whatever the
+// algorithm returned, and the error is the cancellation's cause:
+// errExecutionCancelled. A panic is logged and
+// returned as a panicError, and errors the algorithm returns as
+// algorithmErrors
+func runAlgorithm(ctx context.Context, algorithm types.AlgorithmExecutor, execution *types.AlgorithmExecution, stepCallback func(types.ExecutionStep)) (output interface{}, err error) {
+	// An execution cancelled before it started never runs
+	if ctx.Err() != nil {
+		return nil, context.Cause(ctx)
+	}
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			log.Printf("Execution %s of %s panicked: %v\n%s", execution.ID, execution.AlgorithmID, recovered, debug.Stack())
+			output, err = nil, &panicError{value: recovered}
+		}
+	}()
+
+	output, err = algorithm.Execute(ctx, execution.Input, execution.Parameters, stepCallback)
+	if ctx.Err() != nil {
+		return nil, context.Cause(ctx)
+	}
+	if err != nil {
+		err = &algorithmError{err: err}
+	}
+	return output, err
+}
+
+// cancelClientExecutions cancels the running executions started for a
+// WebSocket client that has disconnected
+func (h *Handlers) cancelClientExecutions(clientID string) {
+	if cancelled := h.store.CancelClient(clientID); cancelled > 0 {
+		log.Printf("Cancelled %d execution(s) for disconnected client %s", cancelled, clientID)
+	}
+}
+
+// logSlowExecution warns about executions that ran longer than the
+// configured threshold
+func (h *Handlers) logSlowExecution(execution *types.AlgorithmExecution, endTime time.Time) {
+	if h.config.SlowExecutionMs <= 0 {
+		return
+	}
+
+	duration := endTime.Sub(execution.StartTime)
+	if duration < time.Duration(h.config.SlowExecutionMs)*time.Millisecond {
+		return
+	}
+
+	parameters, _ := json.Marshal(execution.Parameters)
+	log.Printf("WARN slow execution: execution_id=%s algorithm=%s duration_ms=%d threshold_ms=%d input_size=%d parameters=%s",
+		execution.ID, execution.AlgorithmID, duration.Milliseconds(), h.config.SlowExecutionMs, inputSize(execution), parameters)
+}
+
+// sizeParameters are the parameters that set the size of a generated
+// instance, checked in order when an execution has no slice input
+var sizeParameters = []string{"array_size", "graph_size", "num_nodes", "num_cities", "num_points", "num_items", "size"}
+
+// inputSize returns the length of an execution's input, whatever slice type
+// it was normalized to, or the size parameter the input would be generated from
+func inputSize(execution *types.AlgorithmExecution) int {
+	if execution.Input != nil {
+		if input := reflect.ValueOf(execution.Input); input.Kind() == reflect.Slice {
+			return input.Len()
+		}
+	}
+
+	for _, name := range sizeParameters {
+		if size, ok := execution.Parameters[name].(int); ok {
+			return size
+		}
+	}
+	return 0
 }
 
 // GetExecutionStatus returns the status of a specific execution
 func (h *Handlers) GetExecutionStatus(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
-	_ = vars["id"] // executionID
+	executionID := vars["id"]
+
+	record, exists := h.store.Get(executionID)
+	if !exists {
+		http.Error(w, "Execution not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(record.snapshot())
+}
+
+// StreamExecution streams an execution's messages as server-sent events,
+// replaying the steps recorded so far before following live updates
+func (h *Handlers) StreamExecution(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	executionID := vars["id"]
+
+	record, exists := h.store.Get(executionID)
+	if !exists {
+		http.Error(w, "Execution not found", http.StatusNotFound)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
 
-	// In a real implementation, you would store executions in a database
-	// For now, we'll return a placeholder response
-	http.Error(w, "Execution status not implemented yet", http.StatusNotImplemented)
+	sink := newChannelSink(256)
+	backlog, final := record.subscribe(sink)
+	defer record.unsubscribe(sink)
+
+	for _, message := range backlog {
+		writeEvent(w, message)
+	}
+
+	if final != nil {
+		writeEvent(w, *final)
+		flusher.Flush()
+		return
+	}
+	flusher.Flush()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case message, ok := <-sink.messages:
+			if !ok {
+				// The client fell too far behind to keep streaming
+				return
+			}
+
+			writeEvent(w, message)
+			flusher.Flush()
+
+			// Pause and resume messages leave the execution running
+			switch types.WebSocketMessageType(message.Type) {
+			case types.MessageTypeExecutionComplete, types.MessageTypeExecutionError, types.MessageTypeExecutionCancel:
+				return
+			}
+		}
+	}
+}
+
+// writeEvent writes a message as a single server-sent event
+func writeEvent(w http.ResponseWriter, message types.WebSocketMessage) {
+	jsonData, _ := json.Marshal(message)
+	fmt.Fprintf(w, "event: %s\ndata: %s\n\n", message.Type, jsonData)
 }
 
 // HealthCheck returns the health status of the API
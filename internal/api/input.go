@@ -0,0 +1,140 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/types"
+)
+
+// normalizeInput converts decoded JSON input into the shape declared by the
+// algorithm. Input for algorithms without a declared type is passed through
+func normalizeInput(inputType types.InputType, input interface{}) (interface{}, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	switch inputType {
+	case types.InputIntArray:
+		var arr []int
+		if err := reshapeInput(input, &arr); err != nil {
+			return nil, fmt.Errorf("input must be an array of integers")
+		}
+		return arr, nil
+
+	case types.InputIntMatrix:
+		rows, err := numericMatrix(input)
+		if err != nil {
+			return nil, err
+		}
+
+		matrix := make([][]int, len(rows))
+		for i, row := range rows {
+			matrix[i] = make([]int, len(row))
+			for j, value := range row {
+				if value != math.Trunc(value) {
+					return nil, fmt.Errorf("element [%d][%d] must be an integer, got %v", i, j, value)
+				}
+				matrix[i][j] = int(value)
+			}
+		}
+		return matrix, nil
+
+	case types.InputFloatMatrix:
+		return numericMatrix(input)
+
+	case types.InputEdgeList:
+		var edges []generators.Edge
+		if err := reshapeInput(input, &edges); err != nil {
+			return nil, fmt.Errorf("input must be an array of {\"from\", \"to\", \"weight\"} edges with integer fields")
+		}
+		return edges, nil
+
+	case types.InputPointList:
+		var points []generators.Point
+		if err := reshapeInput(input, &points); err != nil {
+			return nil, fmt.Errorf("input must be an array of {\"x\", \"y\"} points with integer coordinates")
+		}
+		return points, nil
+
+	case types.InputBoolGrid:
+		var grid [][]bool
+		if err := reshapeInput(input, &grid); err != nil || len(grid) == 0 {
+			return nil, fmt.Errorf("input must be a grid (array of arrays) of booleans marking obstacles")
+		}
+		return grid, nil
+
+	case types.InputAdjacencyList:
+		var graph [][]int
+		if err := reshapeInput(input, &graph); err != nil {
+			return nil, fmt.Errorf("input must be an adjacency list (array of arrays of node indices)")
+		}
+		return graph, nil
+	}
+
+	return input, nil
+}
+
+// reshapeInput converts decoded JSON input into target by encoding it again,
+// for input shapes that have a Go type of their own
+func reshapeInput(input interface{}, target interface{}) error {
+	data, err := json.Marshal(input)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, target)
+}
+
+// numericMatrix converts nested JSON arrays into a rectangular matrix of numbers
+func numericMatrix(input interface{}) ([][]float64, error) {
+	rows, ok := input.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("input must be a matrix (array of arrays)")
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("input matrix must have at least one row")
+	}
+
+	matrix := make([][]float64, len(rows))
+	for i, r := range rows {
+		row, ok := r.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("row %d must be an array", i)
+		}
+		if len(row) == 0 {
+			return nil, fmt.Errorf("row %d must not be empty", i)
+		}
+		if i > 0 && len(row) != len(matrix[0]) {
+			return nil, fmt.Errorf("matrix is ragged: row %d has %d columns, expected %d", i, len(row), len(matrix[0]))
+		}
+
+		matrix[i] = make([]float64, len(row))
+		for j, element := range row {
+			value, ok := element.(float64)
+			if !ok {
+				return nil, fmt.Errorf("element [%d][%d] must be a number, got %v", i, j, element)
+			}
+			matrix[i][j] = value
+		}
+	}
+
+	return matrix, nil
+}
+
+// checkInputSize rejects array input longer than the algorithm's array_size
+// parameter allows, so supplied input is bounded like generated input
+func checkInputSize(metadata types.Algorithm, input interface{}) error {
+	arr, ok := input.([]int)
+	if !ok {
+		return nil
+	}
+
+	for _, parameter := range metadata.Parameters {
+		if parameter.Name == "array_size" && parameter.Max != nil && len(arr) > *parameter.Max {
+			return fmt.Errorf("input has %d elements, at most %d are allowed", len(arr), *parameter.Max)
+		}
+	}
+	return nil
+}
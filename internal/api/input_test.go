@@ -0,0 +1,97 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"algorthmia/internal/config"
+	"algorthmia/internal/types"
+)
+
+// decodeInput decodes JSON the way a request body's input is decoded
+func decodeInput(t *testing.T, data string) interface{} {
+	t.Helper()
+
+	var input interface{}
+	if err := json.Unmarshal([]byte(data), &input); err != nil {
+		t.Fatalf("decoding %s: %v", data, err)
+	}
+	return input
+}
+
+func TestNormalizeMatrixInput(t *testing.T) {
+	intMatrix, err := normalizeInput(types.InputIntMatrix, decodeInput(t, `[[1, 2, 3], [4, 5, 6]]`))
+	if err != nil {
+		t.Fatalf("normalizing an int matrix: %v", err)
+	}
+	if expected := [][]int{{1, 2, 3}, {4, 5, 6}}; !reflect.DeepEqual(intMatrix, expected) {
+		t.Errorf("int matrix %v, expected %v", intMatrix, expected)
+	}
+
+	floatMatrix, err := normalizeInput(types.InputFloatMatrix, decodeInput(t, `[[0.5, 1], [2, -3.25]]`))
+	if err != nil {
+		t.Fatalf("normalizing a float matrix: %v", err)
+	}
+	if expected := [][]float64{{0.5, 1}, {2, -3.25}}; !reflect.DeepEqual(floatMatrix, expected) {
+		t.Errorf("float matrix %v, expected %v", floatMatrix, expected)
+	}
+}
+
+func TestNormalizeMatrixInputRejects(t *testing.T) {
+	cases := []struct {
+		input     string
+		inputType types.InputType
+		reason    string
+	}{
+		{`[[1, 2, 3], [4, 5]]`, types.InputIntMatrix, "ragged"},
+		{`[[1], [2, 3]]`, types.InputFloatMatrix, "ragged"},
+		{`[[1, 2], [3, 4.5]]`, types.InputIntMatrix, "must be an integer"},
+		{`[[1, 2], "row"]`, types.InputIntMatrix, "must be an array"},
+		{`[[1, 2], []]`, types.InputFloatMatrix, "must not be empty"},
+		{`[]`, types.InputIntMatrix, "at least one row"},
+		{`[[1, "2"]]`, types.InputFloatMatrix, "must be a number"},
+		{`{"rows": 2}`, types.InputIntMatrix, "must be a matrix"},
+	}
+	for _, c := range cases {
+		_, err := normalizeInput(c.inputType, decodeInput(t, c.input))
+		if err == nil {
+			t.Errorf("%s input %s was accepted", c.inputType, c.input)
+			continue
+		}
+		if !strings.Contains(err.Error(), c.reason) {
+			t.Errorf("%s input %s: error %q does not mention %q", c.inputType, c.input, err, c.reason)
+		}
+	}
+}
+
+func TestOversizeInputRejected(t *testing.T) {
+	server := newTestServer(t, &config.Config{})
+
+	// merge_sort allows up to 100 elements and counting_sort up to 50
+	cases := []struct {
+		algorithmID string
+		size        int
+		status      int
+	}{
+		{"merge_sort", 100, http.StatusOK},
+		{"merge_sort", 2000, http.StatusBadRequest},
+		{"counting_sort", 50, http.StatusOK},
+		{"counting_sort", 51, http.StatusBadRequest},
+	}
+	for _, c := range cases {
+		input := make([]int, c.size)
+		for i := range input {
+			input[i] = c.size - i
+		}
+
+		url := fmt.Sprintf("%s/api/v1/algorithms/%s/execute", server.URL, c.algorithmID)
+		response := doJSON(t, http.MethodPost, url, map[string]interface{}{"input": input}, nil)
+		if response.StatusCode != c.status {
+			t.Errorf("%s with %d elements: status %d, expected %d", c.algorithmID, c.size, response.StatusCode, c.status)
+		}
+	}
+}
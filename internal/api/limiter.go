@@ -0,0 +1,83 @@
+package api
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// maxRetryAfter caps the delay suggested to rejected clients
+const maxRetryAfter = 60 * time.Second
+
+// executionLimiter caps the number of executions running at once and
+// estimates how long a rejected client should wait before retrying
+type executionLimiter struct {
+	max     int64 // 0 means unlimited
+	running int64
+
+	mutex   sync.Mutex
+	average time.Duration // Moving average of recent execution durations
+}
+
+// newExecutionLimiter creates a limiter allowing max concurrent executions
+func newExecutionLimiter(max int) *executionLimiter {
+	return &executionLimiter{max: int64(max), average: time.Second}
+}
+
+// acquire reserves an execution slot, reporting false if all are taken
+func (l *executionLimiter) acquire() bool {
+	for {
+		current := atomic.LoadInt64(&l.running)
+		if l.max > 0 && current >= l.max {
+			return false
+		}
+		if atomic.CompareAndSwapInt64(&l.running, current, current+1) {
+			return true
+		}
+	}
+}
+
+// release frees a slot reserved by acquire, folding the execution's
+// duration into the moving average
+func (l *executionLimiter) release(duration time.Duration) {
+	atomic.AddInt64(&l.running, -1)
+
+	l.mutex.Lock()
+	l.average = (3*l.average + duration) / 4
+	l.mutex.Unlock()
+}
+
+// retryAfter estimates the wait until a slot frees: the average execution
+// time scaled by how far the running executions exceed the limit, between
+// one second and maxRetryAfter
+func (l *executionLimiter) retryAfter() time.Duration {
+	l.mutex.Lock()
+	average := l.average
+	l.mutex.Unlock()
+
+	load := 1.0
+	if l.max > 0 {
+		load = float64(atomic.LoadInt64(&l.running)) / float64(l.max)
+	}
+
+	wait := time.Duration(math.Ceil(average.Seconds()*load)) * time.Second
+	if wait < time.Second {
+		wait = time.Second
+	}
+	if wait > maxRetryAfter {
+		wait = maxRetryAfter
+	}
+	return wait
+}
+
+// rejectOverloaded answers a request that found every execution slot taken
+// with 503 and a Retry-After header so clients back off
+func (l *executionLimiter) rejectOverloaded(w http.ResponseWriter) {
+	seconds := int(l.retryAfter() / time.Second)
+	w.Header().Set("Retry-After", strconv.Itoa(seconds))
+	http.Error(w, fmt.Sprintf("Too many executions running; retry after %d seconds", seconds), http.StatusServiceUnavailable)
+}
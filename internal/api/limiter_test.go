@@ -0,0 +1,45 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"algorthmia/internal/config"
+)
+
+// TestOverloadRetryAfter fills the only execution slot and checks that
+// further work is refused with a Retry-After clients can parse
+func TestOverloadRetryAfter(t *testing.T) {
+	server := newTestServer(t, &config.Config{MaxConcurrentExecutions: 1})
+
+	id := execute(t, server, "bubble_sort", map[string]interface{}{"array_size": 30, "step_delay_ms": 20})
+	defer doJSON(t, http.MethodPost, server.URL+"/api/v1/executions/"+id+"/cancel", nil, nil)
+
+	requests := map[string]interface{}{
+		"/api/v1/algorithms/quick_sort/execute": map[string]interface{}{"parameters": map[string]interface{}{}},
+		"/api/v1/executions/" + id + "/rerun":   nil,
+		"/api/v1/sequence": map[string]interface{}{
+			"items": []map[string]interface{}{{"algorithm_id": "quick_sort"}},
+		},
+		"/api/v1/strings/compare": map[string]interface{}{"text": "abracadabra", "pattern": "abra"},
+	}
+	for path, body := range requests {
+		response := doJSON(t, http.MethodPost, server.URL+path, body, nil)
+		if response.StatusCode != http.StatusServiceUnavailable {
+			t.Errorf("%s: status %d, expected %d", path, response.StatusCode, http.StatusServiceUnavailable)
+			continue
+		}
+
+		header := response.Header.Get("Retry-After")
+		seconds, err := strconv.Atoi(header)
+		if err != nil {
+			t.Errorf("%s: Retry-After %q is not a number of seconds", path, header)
+			continue
+		}
+		if seconds < 1 || time.Duration(seconds)*time.Second > maxRetryAfter {
+			t.Errorf("%s: Retry-After is %d seconds, expected between 1 and %v", path, seconds, maxRetryAfter)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"algorthmia/internal/types"
+)
+
+const (
+	// defaultListLimit is the page size when no limit is given
+	defaultListLimit = 20
+	// maxListLimit caps the page size
+	maxListLimit = 100
+)
+
+// listedStatuses are the statuses an execution list can be filtered by
+var listedStatuses = map[types.ExecutionStatus]bool{
+	types.StatusPending:   true,
+	types.StatusRunning:   true,
+	types.StatusPaused:    true,
+	types.StatusCompleted: true,
+	types.StatusError:     true,
+	types.StatusCancelled: true,
+}
+
+// executionListing describes an execution in a list without its steps,
+// input or output
+type executionListing struct {
+	ID          string                `json:"id"`
+	AlgorithmID string                `json:"algorithm_id"`
+	Status      types.ExecutionStatus `json:"status"`
+	StartTime   time.Time             `json:"start_time"`
+	EndTime     *time.Time            `json:"end_time,omitempty"`
+	StepsCount  int                   `json:"steps_count"`
+}
+
+// ListExecutions returns a page of stored executions, newest first,
+// optionally filtered by algorithm_id and status, with the total number
+// that match
+func (h *Handlers) ListExecutions(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	status := types.ExecutionStatus(query.Get("status"))
+	if status != "" && !listedStatuses[status] {
+		http.Error(w, fmt.Sprintf("Unknown status %q", status), http.StatusBadRequest)
+		return
+	}
+
+	limit, err := listParameter(query.Get("limit"), defaultListLimit)
+	if err != nil || limit < 1 || limit > maxListLimit {
+		http.Error(w, fmt.Sprintf("limit must be an integer between 1 and %d", maxListLimit), http.StatusBadRequest)
+		return
+	}
+
+	offset, err := listParameter(query.Get("offset"), 0)
+	if err != nil || offset < 0 {
+		http.Error(w, "offset must be a non-negative integer", http.StatusBadRequest)
+		return
+	}
+
+	listings := h.store.List(query.Get("algorithm_id"), status)
+	total := len(listings)
+
+	page := []executionListing{}
+	if offset < total {
+		end := offset + limit
+		if end > total {
+			end = total
+		}
+		page = listings[offset:end]
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"executions": page,
+		"total":      total,
+		"limit":      limit,
+		"offset":     offset,
+	})
+}
+
+// listParameter parses an integer query parameter, returning fallback when
+// it is absent
+func listParameter(value string, fallback int) (int, error) {
+	if value == "" {
+		return fallback, nil
+	}
+	return strconv.Atoi(value)
+}
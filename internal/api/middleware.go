@@ -0,0 +1,69 @@
+package api
+
+import (
+	"crypto/subtle"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// apiKeyHeader is the request header carrying the API key
+const apiKeyHeader = "X-API-Key"
+
+// APIKeyMiddleware requires a valid API key on requests that run work on the
+// server. Read-only requests are public unless protectReads is set, and the
+// health check is always public
+func APIKeyMiddleware(keys map[string]string, protectReads bool) mux.MiddlewareFunc {
+	if len(keys) == 0 {
+		log.Printf("API key authentication enabled but no API_KEYS configured; protected endpoints will reject all requests")
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !requiresAPIKey(r, protectReads) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			label, ok := lookupAPIKey(keys, r.Header.Get(apiKeyHeader))
+			if !ok {
+				http.Error(w, "Invalid or missing API key", http.StatusUnauthorized)
+				return
+			}
+
+			log.Printf("%s %s authorized with API key %q", r.Method, r.URL.Path, label)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// requiresAPIKey reports whether the request must carry an API key
+func requiresAPIKey(r *http.Request, protectReads bool) bool {
+	if r.URL.Path == "/api/v1/health" {
+		return false
+	}
+
+	switch r.Method {
+	case http.MethodOptions:
+		return false
+	case http.MethodGet, http.MethodHead:
+		return protectReads
+	default:
+		return true
+	}
+}
+
+// lookupAPIKey returns the label of a configured key, comparing in constant time
+func lookupAPIKey(keys map[string]string, provided string) (string, bool) {
+	if provided == "" {
+		return "", false
+	}
+
+	for key, label := range keys {
+		if subtle.ConstantTimeCompare([]byte(key), []byte(provided)) == 1 {
+			return label, true
+		}
+	}
+	return "", false
+}
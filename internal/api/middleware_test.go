@@ -0,0 +1,70 @@
+package api
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+)
+
+// requestWithKey sends a request with an optional API key and returns the status
+func requestWithKey(t *testing.T, method, url, key string, body []byte) int {
+	t.Helper()
+
+	request, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("building request: %v", err)
+	}
+	if key != "" {
+		request.Header.Set(apiKeyHeader, key)
+	}
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatalf("%s %s: %v", method, url, err)
+	}
+	response.Body.Close()
+	return response.StatusCode
+}
+
+func TestAPIKeyMiddleware(t *testing.T) {
+	server := newTestServer(t, authConfig())
+	executeURL := server.URL + "/api/v1/algorithms/bubble_sort/execute"
+	body := []byte(`{"parameters": {"array_size": 5}}`)
+
+	cases := []struct {
+		name     string
+		key      string
+		expected int
+	}{
+		{"missing key", "", http.StatusUnauthorized},
+		{"invalid key", "wrong-key", http.StatusUnauthorized},
+		{"valid key", testAPIKey, http.StatusOK},
+	}
+	for _, c := range cases {
+		if status := requestWithKey(t, http.MethodPost, executeURL, c.key, body); status != c.expected {
+			t.Errorf("execute with %s: status %d, expected %d", c.name, status, c.expected)
+		}
+	}
+
+	for _, path := range []string{"/api/v1/health", "/api/v1/algorithms"} {
+		if status := requestWithKey(t, http.MethodGet, server.URL+path, "", nil); status != http.StatusOK {
+			t.Errorf("GET %s without a key: status %d, expected %d", path, status, http.StatusOK)
+		}
+	}
+}
+
+func TestAPIKeyMiddlewareProtectReads(t *testing.T) {
+	cfg := authConfig()
+	cfg.APIAuthProtectReads = true
+	server := newTestServer(t, cfg)
+
+	if status := requestWithKey(t, http.MethodGet, server.URL+"/api/v1/algorithms", "", nil); status != http.StatusUnauthorized {
+		t.Errorf("listing without a key: status %d, expected %d", status, http.StatusUnauthorized)
+	}
+	if status := requestWithKey(t, http.MethodGet, server.URL+"/api/v1/algorithms", testAPIKey, nil); status != http.StatusOK {
+		t.Errorf("listing with a key: status %d, expected %d", status, http.StatusOK)
+	}
+	if status := requestWithKey(t, http.MethodGet, server.URL+"/api/v1/health", "", nil); status != http.StatusOK {
+		t.Errorf("health check without a key: status %d, expected %d", status, http.StatusOK)
+	}
+}
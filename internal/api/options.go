@@ -0,0 +1,51 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// DescribeAlgorithmOptions answers OPTIONS requests on the algorithm routes
+// with the allowed methods and the request schema they accept. CORS
+// preflight requests are answered by the cors middleware before reaching it
+func (h *Handlers) DescribeAlgorithmOptions(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	algorithmID := vars["id"]
+
+	// Algorithms the allowlist filters out are still described, as disabled
+	algorithm, exists := h.algorithmRegistry.GetKnownAlgorithm(algorithmID)
+	if !exists {
+		http.Error(w, "Algorithm not found", http.StatusNotFound)
+		return
+	}
+
+	methods := []string{"GET", "OPTIONS"}
+	var schema map[string]interface{}
+	if strings.HasSuffix(r.URL.Path, "/execute") {
+		methods = []string{"POST", "OPTIONS"}
+		schema = map[string]interface{}{
+			"content_type": "application/json",
+			"parameters":   algorithm.GetMetadata().Parameters,
+			"input":        "Optional algorithm input, such as an integer array; generated from the parameters when omitted",
+		}
+	}
+
+	w.Header().Set("Allow", strings.Join(methods, ", "))
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"algorithm_id":   algorithmID,
+		"methods":        methods,
+		"enabled":        h.algorithmEnabled(algorithmID),
+		"request_schema": schema,
+	})
+}
+
+// algorithmEnabled reports whether the current filter exposes an algorithm,
+// so it may be executed
+func (h *Handlers) algorithmEnabled(id string) bool {
+	_, exists := h.algorithmRegistry.GetAlgorithm(id)
+	return exists
+}
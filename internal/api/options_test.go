@@ -0,0 +1,41 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"algorthmia/internal/config"
+)
+
+func TestDescribeAlgorithmOptions(t *testing.T) {
+	server := newTestServer(t, &config.Config{AlgorithmsDeny: []string{"bubble_sort"}})
+
+	cases := []struct {
+		path    string
+		status  int
+		enabled bool
+	}{
+		{"/api/v1/algorithms/quick_sort", http.StatusOK, true},
+		{"/api/v1/algorithms/quick_sort/execute", http.StatusOK, true},
+		{"/api/v1/algorithms/bubble_sort", http.StatusOK, false},
+		{"/api/v1/algorithms/bubble_sort/execute", http.StatusOK, false},
+		{"/api/v1/algorithms/no_such_sort", http.StatusNotFound, false},
+	}
+	for _, c := range cases {
+		var described struct {
+			Enabled bool `json:"enabled"`
+		}
+		response := doJSON(t, http.MethodOptions, server.URL+c.path, nil, &described)
+		if response.StatusCode != c.status {
+			t.Errorf("OPTIONS %s: status %d, expected %d", c.path, response.StatusCode, c.status)
+			continue
+		}
+		if c.status == http.StatusOK && described.Enabled != c.enabled {
+			t.Errorf("OPTIONS %s: enabled %v, expected %v", c.path, described.Enabled, c.enabled)
+		}
+	}
+
+	if response := doJSON(t, http.MethodPost, server.URL+"/api/v1/algorithms/bubble_sort/execute", map[string]interface{}{}, nil); response.StatusCode != http.StatusNotFound {
+		t.Errorf("executing a denied algorithm: status %d, expected %d", response.StatusCode, http.StatusNotFound)
+	}
+}
@@ -0,0 +1,108 @@
+package api
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// maxStepDelayMs limits the pause after each step
+const maxStepDelayMs = 10000
+
+// Step delay schedules
+const (
+	scheduleConstant = "constant"  // Every step waits step_delay_ms
+	scheduleRampDown = "ramp_down" // Slow start: from step_delay_ms down to min_step_delay_ms
+	scheduleRampUp   = "ramp_up"   // Fast start: from min_step_delay_ms up to step_delay_ms
+)
+
+// stepPacing is the pause the executor inserts after each published step
+type stepPacing struct {
+	schedule string
+	min, max time.Duration
+}
+
+// parseStepPacing reads the step_delay_ms, min_step_delay_ms and
+// step_delay_schedule execution parameters. These are handled by the
+// executor rather than by algorithms, so they are not in any algorithm's
+// metadata and arrive as JSON numbers
+func parseStepPacing(parameters map[string]interface{}) (stepPacing, error) {
+	pacing := stepPacing{schedule: scheduleConstant}
+
+	maxMs, err := delayParameter(parameters, "step_delay_ms")
+	if err != nil {
+		return pacing, err
+	}
+	minMs, err := delayParameter(parameters, "min_step_delay_ms")
+	if err != nil {
+		return pacing, err
+	}
+	if minMs > maxMs {
+		return pacing, fmt.Errorf("min_step_delay_ms must not exceed step_delay_ms")
+	}
+
+	if value, exists := parameters["step_delay_schedule"]; exists && value != nil {
+		schedule, ok := value.(string)
+		if !ok {
+			return pacing, fmt.Errorf("step_delay_schedule must be a string")
+		}
+		switch schedule {
+		case scheduleConstant, scheduleRampDown, scheduleRampUp:
+			pacing.schedule = schedule
+		default:
+			return pacing, fmt.Errorf("step_delay_schedule must be %s, %s or %s", scheduleConstant, scheduleRampDown, scheduleRampUp)
+		}
+	}
+
+	pacing.min = time.Duration(minMs) * time.Millisecond
+	pacing.max = time.Duration(maxMs) * time.Millisecond
+	return pacing, nil
+}
+
+// delayParameter reads a whole number of milliseconds between 0 and
+// maxStepDelayMs, defaulting to 0
+func delayParameter(parameters map[string]interface{}, name string) (int, error) {
+	value, exists := parameters[name]
+	if !exists || value == nil {
+		return 0, nil
+	}
+
+	var ms float64
+	switch v := value.(type) {
+	case int:
+		ms = float64(v)
+	case float64:
+		ms = v
+	default:
+		return 0, fmt.Errorf("%s must be a number", name)
+	}
+
+	if ms != math.Trunc(ms) || ms < 0 || ms > maxStepDelayMs {
+		return 0, fmt.Errorf("%s must be a whole number between 0 and %d", name, maxStepDelayMs)
+	}
+	return int(ms), nil
+}
+
+// describe reports the pacing a run will follow, as returned when it starts
+func (p stepPacing) describe() map[string]interface{} {
+	return map[string]interface{}{
+		"schedule":          p.schedule,
+		"step_delay_ms":     p.max.Milliseconds(),
+		"min_step_delay_ms": p.min.Milliseconds(),
+	}
+}
+
+// delay returns the pause after a step at the given progress, from 0 to 1.
+// Ramps interpolate linearly between the bounds
+func (p stepPacing) delay(progress float64) time.Duration {
+	progress = math.Max(0, math.Min(1, progress))
+
+	switch p.schedule {
+	case scheduleRampDown:
+		return p.max - time.Duration(progress*float64(p.max-p.min))
+	case scheduleRampUp:
+		return p.min + time.Duration(progress*float64(p.max-p.min))
+	default:
+		return p.max
+	}
+}
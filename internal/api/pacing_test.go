@@ -0,0 +1,93 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"algorthmia/internal/config"
+	"algorthmia/internal/types"
+)
+
+// TestStepPacingSchedules checks the delay at the start, middle and end of
+// a run for each schedule
+func TestStepPacingSchedules(t *testing.T) {
+	cases := []struct {
+		schedule string
+		expected [3]time.Duration // at progress 0, 0.5 and 1
+	}{
+		{scheduleConstant, [3]time.Duration{100 * time.Millisecond, 100 * time.Millisecond, 100 * time.Millisecond}},
+		{scheduleRampDown, [3]time.Duration{100 * time.Millisecond, 60 * time.Millisecond, 20 * time.Millisecond}},
+		{scheduleRampUp, [3]time.Duration{20 * time.Millisecond, 60 * time.Millisecond, 100 * time.Millisecond}},
+	}
+
+	for _, c := range cases {
+		pacing, err := parseStepPacing(map[string]interface{}{
+			"step_delay_ms":       100.0,
+			"min_step_delay_ms":   20.0,
+			"step_delay_schedule": c.schedule,
+		})
+		if err != nil {
+			t.Fatalf("%s: %v", c.schedule, err)
+		}
+
+		for i, progress := range []float64{0, 0.5, 1} {
+			if delay := pacing.delay(progress); delay != c.expected[i] {
+				t.Errorf("%s at progress %v: delay %v, expected %v", c.schedule, progress, delay, c.expected[i])
+			}
+		}
+
+		// Progress outside [0, 1] is clamped
+		if pacing.delay(-1) != c.expected[0] || pacing.delay(2) != c.expected[2] {
+			t.Errorf("%s: delay is not clamped to the bounds", c.schedule)
+		}
+	}
+}
+
+func TestStepPacingRejectsInvalidParameters(t *testing.T) {
+	invalid := []map[string]interface{}{
+		{"step_delay_ms": -1.0},
+		{"step_delay_ms": 1.5},
+		{"step_delay_ms": float64(maxStepDelayMs + 1)},
+		{"step_delay_ms": "10"},
+		{"step_delay_ms": 10.0, "min_step_delay_ms": 20.0},
+		{"step_delay_schedule": "ramp_sideways"},
+		{"step_delay_schedule": 1.0},
+	}
+	for _, parameters := range invalid {
+		if _, err := parseStepPacing(parameters); err == nil {
+			t.Errorf("accepted %v", parameters)
+		}
+	}
+
+	server := newTestServer(t, &config.Config{})
+	url := server.URL + "/api/v1/algorithms/bubble_sort/execute"
+	body := map[string]interface{}{"parameters": map[string]interface{}{"step_delay_schedule": "ramp_sideways"}}
+	if response := doJSON(t, http.MethodPost, url, body, nil); response.StatusCode != http.StatusBadRequest {
+		t.Errorf("executing with an unknown schedule: status %d, expected %d", response.StatusCode, http.StatusBadRequest)
+	}
+}
+
+// TestStepPacingSlowsSteps checks that a ramp_down run spaces its early
+// steps further apart than its late ones
+func TestStepPacingSlowsSteps(t *testing.T) {
+	server := newTestServer(t, &config.Config{})
+	id := execute(t, server, "merge_sort", map[string]interface{}{
+		"array_size":          8,
+		"step_delay_ms":       30,
+		"min_step_delay_ms":   0,
+		"step_delay_schedule": scheduleRampDown,
+	})
+
+	execution := waitForStatus(t, server, id, 10*time.Second, types.StatusCompleted)
+	steps := execution.Steps
+	if len(steps) < 10 {
+		t.Fatalf("only %d steps were stored", len(steps))
+	}
+
+	early := steps[2].Timestamp.Sub(steps[1].Timestamp)
+	late := steps[len(steps)-2].Timestamp.Sub(steps[len(steps)-3].Timestamp)
+	if early < 20*time.Millisecond || late >= early {
+		t.Errorf("early steps %v apart and late steps %v apart, expected a slow start", early, late)
+	}
+}
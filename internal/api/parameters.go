@@ -0,0 +1,84 @@
+package api
+
+import (
+	"fmt"
+	"math"
+
+	"algorthmia/internal/types"
+)
+
+// effectiveParameters returns the parameters an execution actually runs
+// with: the request's values merged over the defaults declared in the
+// algorithm's metadata. JSON numbers decode as float64, so whole numbers
+// given for "int" parameters are converted to int; otherwise the
+// algorithm's int type assertions would fail and silently fall back to
+// hardcoded defaults. The request map is not modified
+func effectiveParameters(algorithm types.AlgorithmExecutor, parameters map[string]interface{}) map[string]interface{} {
+	effective := make(map[string]interface{}, len(parameters))
+	for name, value := range parameters {
+		effective[name] = value
+	}
+
+	for _, parameter := range algorithm.GetMetadata().Parameters {
+		value, exists := effective[parameter.Name]
+		if !exists || value == nil {
+			if parameter.Default != nil {
+				effective[parameter.Name] = parameter.Default
+			} else {
+				delete(effective, parameter.Name)
+			}
+			continue
+		}
+
+		if parameter.Type == "int" {
+			if f, ok := value.(float64); ok && f == math.Trunc(f) && math.Abs(f) < 1<<53 {
+				effective[parameter.Name] = int(f)
+			}
+		}
+	}
+
+	return effective
+}
+
+// checkParameterTypes reports a parameter whose value does not have the
+// type its metadata declares. Algorithms read parameters with type
+// assertions and fall back to defaults when they fail, so a value like
+// 2.5 for an "int" or "yes" for a "bool" would otherwise be silently
+// ignored rather than rejected. Call it on effectiveParameters' result
+func checkParameterTypes(algorithm types.AlgorithmExecutor, parameters map[string]interface{}) error {
+	for _, parameter := range algorithm.GetMetadata().Parameters {
+		value, exists := parameters[parameter.Name]
+		if !exists || value == nil {
+			continue
+		}
+
+		var ok bool
+		switch parameter.Type {
+		case "int":
+			_, ok = value.(int)
+		case "float":
+			_, ok = value.(float64)
+		case "bool":
+			_, ok = value.(bool)
+		case "string":
+			_, ok = value.(string)
+		default:
+			// Other types, such as "array", are checked by the algorithm
+			ok = true
+		}
+
+		if !ok {
+			return fmt.Errorf("%s must be a %s, got %#v", parameter.Name, parameterTypeNames[parameter.Type], value)
+		}
+	}
+
+	return nil
+}
+
+// parameterTypeNames describes each declared parameter type in errors
+var parameterTypeNames = map[string]string{
+	"int":    "whole number",
+	"float":  "number",
+	"bool":   "boolean",
+	"string": "string",
+}
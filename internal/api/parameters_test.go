@@ -0,0 +1,73 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"algorthmia/internal/algorithms/sorting"
+	"algorthmia/internal/config"
+	"algorthmia/internal/types"
+)
+
+func TestEffectiveParametersConvertsWholeNumbers(t *testing.T) {
+	parameters := effectiveParameters(sorting.NewBubbleSort(), map[string]interface{}{"array_size": float64(25)})
+	if size, ok := parameters["array_size"].(int); !ok || size != 25 {
+		t.Errorf("array_size is %#v, expected the int 25", parameters["array_size"])
+	}
+
+	parameters = effectiveParameters(sorting.NewBubbleSort(), map[string]interface{}{"array_size": 2.5})
+	if _, ok := parameters["array_size"].(int); ok {
+		t.Errorf("fractional array_size was converted to %v", parameters["array_size"])
+	}
+}
+
+// TestArraySizeTakesEffect posts a JSON array_size, which decodes as a
+// float64, and checks the sorts really generate that many elements
+func TestArraySizeTakesEffect(t *testing.T) {
+	server := newTestServer(t, &config.Config{})
+
+	for _, algorithmID := range []string{"bubble_sort", "merge_sort", "quick_sort", "heap_sort"} {
+		id := execute(t, server, algorithmID, map[string]interface{}{"array_size": 25})
+		execution := waitForStatus(t, server, id, 5*time.Second, types.StatusCompleted)
+
+		if execution.Parameters["array_size"] != float64(25) {
+			t.Errorf("%s: stored array_size is %v", algorithmID, execution.Parameters["array_size"])
+		}
+		if len(execution.Steps) == 0 {
+			t.Fatalf("%s: no steps were stored", algorithmID)
+		}
+		array, ok := execution.Steps[0].Data["array"].([]interface{})
+		if !ok || len(array) != 25 {
+			t.Errorf("%s: initial array is %v, expected 25 elements", algorithmID, execution.Steps[0].Data["array"])
+		}
+		output, ok := execution.Output.([]interface{})
+		if !ok || len(output) != 25 {
+			t.Errorf("%s: output is %v, expected 25 elements", algorithmID, execution.Output)
+		}
+	}
+}
+
+// TestOutOfRangeParametersRejected checks that the ValidateParameters range
+// checks apply to JSON numbers
+func TestOutOfRangeParametersRejected(t *testing.T) {
+	server := newTestServer(t, &config.Config{})
+
+	cases := []struct {
+		algorithmID string
+		parameters  map[string]interface{}
+	}{
+		{"bubble_sort", map[string]interface{}{"array_size": 1000}},
+		{"bfs", map[string]interface{}{"start_node": 19}},
+		{"dfs", map[string]interface{}{"target_node": 6}},
+		{"bfs", map[string]interface{}{"graph_size": 4, "target_node": 5}},
+	}
+	for _, c := range cases {
+		url := fmt.Sprintf("%s/api/v1/algorithms/%s/execute", server.URL, c.algorithmID)
+		response := doJSON(t, http.MethodPost, url, map[string]interface{}{"parameters": c.parameters}, nil)
+		if response.StatusCode != http.StatusBadRequest {
+			t.Errorf("%s with %v: status %d, expected %d", c.algorithmID, c.parameters, response.StatusCode, http.StatusBadRequest)
+		}
+	}
+}
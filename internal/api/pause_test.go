@@ -0,0 +1,105 @@
+package api
+
+import (
+	"bufio"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"algorthmia/internal/config"
+	"algorthmia/internal/types"
+)
+
+// streamEvents follows an execution's event stream, sending the type of
+// each event until the server ends the stream
+func streamEvents(t *testing.T, url string) <-chan string {
+	t.Helper()
+
+	response, err := http.Get(url)
+	if err != nil {
+		t.Fatalf("streaming: %v", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("streaming: status %d", response.StatusCode)
+	}
+
+	events := make(chan string, 1024)
+	go func() {
+		defer response.Body.Close()
+		defer close(events)
+
+		scanner := bufio.NewScanner(response.Body)
+		scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			if event, ok := strings.CutPrefix(scanner.Text(), "event: "); ok {
+				events <- event
+			}
+		}
+	}()
+	return events
+}
+
+// TestPauseResumeComplete pauses a paced execution, checks that no steps
+// are published while it is paused, then resumes it to completion. The
+// event stream stays open across the pause and ends with the completion
+func TestPauseResumeComplete(t *testing.T) {
+	server := newTestServer(t, &config.Config{})
+	base := server.URL + "/api/v1/executions/"
+
+	id := execute(t, server, "bubble_sort", map[string]interface{}{"array_size": 8, "step_delay_ms": 5})
+	events := streamEvents(t, base+id+"/stream")
+
+	if response := doJSON(t, http.MethodPost, base+id+"/pause", nil, nil); response.StatusCode != http.StatusOK {
+		t.Fatalf("pausing: status %d", response.StatusCode)
+	}
+	paused := waitForStatus(t, server, id, time.Second, types.StatusPaused)
+
+	if response := doJSON(t, http.MethodPost, base+id+"/pause", nil, nil); response.StatusCode != http.StatusConflict {
+		t.Errorf("pausing twice: status %d, expected %d", response.StatusCode, http.StatusConflict)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	if held := getExecution(t, server, id); len(held.Steps) != len(paused.Steps) {
+		t.Fatalf("%d steps were published while paused", len(held.Steps)-len(paused.Steps))
+	}
+
+	if response := doJSON(t, http.MethodPost, base+id+"/resume", nil, nil); response.StatusCode != http.StatusOK {
+		t.Fatalf("resuming: status %d", response.StatusCode)
+	}
+	if response := doJSON(t, http.MethodPost, base+id+"/resume", nil, nil); response.StatusCode != http.StatusConflict {
+		t.Errorf("resuming twice: status %d, expected %d", response.StatusCode, http.StatusConflict)
+	}
+
+	completed := waitForStatus(t, server, id, 5*time.Second, types.StatusCompleted)
+	if len(completed.Steps) <= len(paused.Steps) {
+		t.Errorf("no steps were published after resuming")
+	}
+
+	// The stream reports the pause and resume in order and ends on completion
+	var order []string
+	timeout := time.After(5 * time.Second)
+	for collecting := true; collecting; {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				collecting = false
+				break
+			}
+			if event != string(types.MessageTypeExecutionStep) {
+				order = append(order, event)
+			}
+		case <-timeout:
+			t.Fatalf("stream still open after completion; saw %v", order)
+		}
+	}
+
+	expected := []string{
+		string(types.MessageTypeExecutionPause),
+		string(types.MessageTypeExecutionResume),
+		string(types.MessageTypeExecutionComplete),
+	}
+	if strings.Join(order, ",") != strings.Join(expected, ",") {
+		t.Errorf("stream events %v, expected %v", order, expected)
+	}
+}
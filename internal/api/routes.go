@@ -1,23 +1,48 @@
 package api
 
 import (
+	"log"
+
 	"algorthmia/internal/algorithms"
+	"algorthmia/internal/config"
 	"algorthmia/internal/websocket"
 
 	"github.com/gorilla/mux"
 )
 
 // SetupRoutes configures all API routes
-func SetupRoutes(router *mux.Router, hub *websocket.Hub) {
-	// Create algorithm registry
+func SetupRoutes(router *mux.Router, hub *websocket.Hub, cfg *config.Config) {
+	// Create algorithm registry, limited to the configured algorithms
 	registry := algorithms.NewRegistry()
+	filter := algorithms.Filter{Allow: cfg.AlgorithmsAllow, Deny: cfg.AlgorithmsDeny}
+	if !filter.IsEmpty() {
+		if ids, err := registry.Reload(filter); err != nil {
+			log.Printf("Ignoring algorithm allow/deny lists: %v", err)
+		} else {
+			log.Printf("Exposing %d algorithms", len(ids))
+		}
+	}
+
+	// Verify algorithm correctness before serving requests
+	if cfg.SelfTestOnStartup {
+		if failures := registry.RunSelfTests(); len(failures) > 0 {
+			log.Printf("%d algorithm self-tests failed", len(failures))
+		} else {
+			log.Printf("All algorithm self-tests passed")
+		}
+	}
 
 	// Create handlers
-	handlers := NewHandlers(registry, hub)
+	handlers := NewHandlers(registry, hub, cfg)
 
 	// API version prefix
 	api := router.PathPrefix("/api/v1").Subrouter()
 
+	// Require API keys for execution endpoints on shared deployments
+	if cfg.APIAuthEnabled {
+		api.Use(APIKeyMiddleware(cfg.APIKeys, cfg.APIAuthProtectReads))
+	}
+
 	// Health check
 	api.HandleFunc("/health", handlers.HealthCheck).Methods("GET")
 
@@ -26,11 +51,38 @@ func SetupRoutes(router *mux.Router, hub *websocket.Hub) {
 	api.HandleFunc("/algorithms/{id}", handlers.GetAlgorithm).Methods("GET")
 	api.HandleFunc("/algorithms/{id}/execute", handlers.ExecuteAlgorithm).Methods("POST")
 
+	// Self-describing algorithm routes for tooling; CORS preflights never reach these
+	api.HandleFunc("/algorithms/{id}", handlers.DescribeAlgorithmOptions).Methods("OPTIONS")
+	api.HandleFunc("/algorithms/{id}/execute", handlers.DescribeAlgorithmOptions).Methods("OPTIONS")
+
+	// Custom step sequences for teaching
+	api.HandleFunc("/custom-visualization", handlers.CreateCustomVisualization).Methods("POST")
+
+	// Sequences of algorithms run back-to-back for guided lessons
+	api.HandleFunc("/sequence", handlers.CreateSequence).Methods("POST")
+	api.HandleFunc("/sequences/{id}", handlers.GetSequenceStatus).Methods("GET")
+
+	// Side-by-side comparison of the string matchers
+	api.HandleFunc("/strings/compare", handlers.CompareStringMatchers).Methods("POST")
+
+	// Runtime changes to the exposed algorithm set, only where callers must
+	// present an API key
+	if cfg.APIAuthEnabled {
+		api.HandleFunc("/admin/registry/reload", handlers.ReloadRegistry).Methods("POST")
+	}
+
 	// Categories
 	api.HandleFunc("/categories", handlers.GetCategories).Methods("GET")
 
-	// Execution status
+	// Execution status; the diff route must precede the {id} routes
+	api.HandleFunc("/executions", handlers.ListExecutions).Methods("GET")
+	api.HandleFunc("/executions/diff", handlers.DiffExecutions).Methods("GET")
 	api.HandleFunc("/executions/{id}", handlers.GetExecutionStatus).Methods("GET")
+	api.HandleFunc("/executions/{id}/stream", handlers.StreamExecution).Methods("GET")
+	api.HandleFunc("/executions/{id}/rerun", handlers.RerunExecution).Methods("POST")
+	api.HandleFunc("/executions/{id}/cancel", handlers.CancelExecution).Methods("POST")
+	api.HandleFunc("/executions/{id}/pause", handlers.PauseExecution).Methods("POST")
+	api.HandleFunc("/executions/{id}/resume", handlers.ResumeExecution).Methods("POST")
 
 	// WebSocket endpoint is handled in main.go
 }
@@ -0,0 +1,315 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"algorthmia/internal/types"
+
+	"github.com/gorilla/mux"
+)
+
+// maxSequenceItems limits the number of algorithms chained in one sequence
+const maxSequenceItems = 20
+
+// maxSequenceDelayMs limits the pause between sequence items
+const maxSequenceDelayMs = 60000
+
+// sequenceSlotPoll is how often a sequence item waiting for an execution
+// slot tries again
+const sequenceSlotPoll = 100 * time.Millisecond
+
+// sequenceItem is one algorithm run within a sequence
+type sequenceItem struct {
+	AlgorithmID string                 `json:"algorithm_id"`
+	Parameters  map[string]interface{} `json:"parameters"`
+	Input       interface{}            `json:"input,omitempty"`
+}
+
+// sequence is an ordered list of executions run back-to-back. Cancelling
+// ctx stops the sequence before its next item
+type sequence struct {
+	id        string
+	delay     time.Duration
+	records   []*executionRecord
+	startTime time.Time
+	endTime   *time.Time
+	ctx       context.Context
+	cancel    context.CancelFunc
+	mutex     sync.Mutex
+}
+
+// SequenceStore keeps sequences in memory so they can be queried. Beyond
+// maxFinished finished sequences the oldest are evicted; 0 keeps them all
+type SequenceStore struct {
+	sequences   map[string]*sequence
+	maxFinished int
+	mutex       sync.RWMutex
+}
+
+// NewSequenceStore creates an empty sequence store that keeps at most
+// maxFinished finished sequences
+func NewSequenceStore(maxFinished int) *SequenceStore {
+	return &SequenceStore{
+		sequences:   make(map[string]*sequence),
+		maxFinished: maxFinished,
+	}
+}
+
+// Add stores a sequence, evicting the oldest finished sequences beyond the
+// limit. Unfinished sequences are never evicted
+func (s *SequenceStore) Add(seq *sequence) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.sequences[seq.id] = seq
+	if s.maxFinished <= 0 {
+		return
+	}
+
+	finished := []string{}
+	for id, stored := range s.sequences {
+		if stored.finished() {
+			finished = append(finished, id)
+		}
+	}
+	if len(finished) <= s.maxFinished {
+		return
+	}
+
+	// IDs are issued in increasing time order, so the smallest are oldest
+	sort.Strings(finished)
+	for _, id := range finished[:len(finished)-s.maxFinished] {
+		delete(s.sequences, id)
+	}
+}
+
+// finished reports whether every item of the sequence has run or it has
+// been cancelled
+func (seq *sequence) finished() bool {
+	seq.mutex.Lock()
+	defer seq.mutex.Unlock()
+
+	return seq.endTime != nil
+}
+
+// Get returns a stored sequence
+func (s *SequenceStore) Get(id string) (*sequence, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	seq, exists := s.sequences[id]
+	return seq, exists
+}
+
+// CreateSequence runs an ordered list of algorithms back-to-back, broadcasting
+// each one's steps in turn
+func (h *Handlers) CreateSequence(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		Items   []sequenceItem `json:"items"`
+		DelayMs int            `json:"delay_ms"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if len(request.Items) == 0 || len(request.Items) > maxSequenceItems {
+		http.Error(w, fmt.Sprintf("Invalid sequence: items must contain between 1 and %d entries", maxSequenceItems), http.StatusBadRequest)
+		return
+	}
+
+	if request.DelayMs < 0 || request.DelayMs > maxSequenceDelayMs {
+		http.Error(w, fmt.Sprintf("Invalid sequence: delay_ms must be between 0 and %d", maxSequenceDelayMs), http.StatusBadRequest)
+		return
+	}
+
+	// Validate every item before running any of them
+	algorithms := make([]types.AlgorithmExecutor, len(request.Items))
+	parameterSets := make([]map[string]interface{}, len(request.Items))
+	inputs := make([]interface{}, len(request.Items))
+	for i, item := range request.Items {
+		algorithm, exists := h.algorithmRegistry.GetAlgorithm(item.AlgorithmID)
+		if !exists {
+			http.Error(w, fmt.Sprintf("Item %d: algorithm %q not found", i, item.AlgorithmID), http.StatusBadRequest)
+			return
+		}
+
+		parameters := effectiveParameters(algorithm, item.Parameters)
+		if err := checkParameterTypes(algorithm, parameters); err != nil {
+			http.Error(w, fmt.Sprintf("Item %d: invalid parameters: %v", i, err), http.StatusBadRequest)
+			return
+		}
+		if err := algorithm.ValidateParameters(parameters); err != nil {
+			http.Error(w, fmt.Sprintf("Item %d: invalid parameters: %v", i, err), http.StatusBadRequest)
+			return
+		}
+		if _, err := parseStepPacing(parameters); err != nil {
+			http.Error(w, fmt.Sprintf("Item %d: invalid parameters: %v", i, err), http.StatusBadRequest)
+			return
+		}
+
+		input, err := normalizeInput(algorithm.GetMetadata().InputType, item.Input)
+		if err == nil {
+			err = checkInputSize(algorithm.GetMetadata(), input)
+		}
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Item %d: invalid input: %v", i, err), http.StatusBadRequest)
+			return
+		}
+
+		algorithms[i] = algorithm
+		parameterSets[i] = parameters
+		inputs[i] = input
+	}
+
+	// Reserve a slot for the first item; later items take their own as
+	// they start
+	if !h.limiter.acquire() {
+		h.limiter.rejectOverloaded(w)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	seq := &sequence{
+		id:        nextID("seq"),
+		delay:     time.Duration(request.DelayMs) * time.Millisecond,
+		startTime: h.clock.Now(),
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+
+	executionIDs := make([]string, len(request.Items))
+	for i := range request.Items {
+		record := h.newExecution(algorithms[i], parameterSets[i], inputs[i], types.StatusPending, "")
+		seq.records = append(seq.records, record)
+		executionIDs[i] = record.execution.ID
+
+		// Cancelling any item, running or still pending, stops the sequence
+		context.AfterFunc(record.ctx, func() {
+			if errors.Is(context.Cause(record.ctx), errExecutionCancelled) {
+				seq.cancel()
+			}
+		})
+	}
+
+	h.sequences.Add(seq)
+
+	// Run the items serially so a sequence never occupies more than one
+	// execution at a time
+	go h.runSequence(seq, algorithms)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"sequence_id":   seq.id,
+		"execution_ids": executionIDs,
+		"status":        "started",
+		"message":       "Sequence execution started",
+	})
+}
+
+// runSequence executes each item of a sequence in order, pausing between
+// items. Each item holds an execution slot only while it runs: the first
+// runs in the slot CreateSequence reserved and later ones wait for a free
+// slot. Once the sequence is cancelled its remaining items end cancelled
+// without running
+func (h *Handlers) runSequence(seq *sequence, algorithms []types.AlgorithmExecutor) {
+	defer seq.cancel()
+
+	for i, record := range seq.records {
+		if i > 0 && seq.delay > 0 {
+			select {
+			case <-time.After(seq.delay):
+			case <-seq.ctx.Done():
+			}
+		}
+
+		acquired := i == 0 || h.acquireSequenceSlot(seq)
+		if !acquired {
+			record.requestCancel()
+		}
+
+		record.start()
+		start := time.Now()
+		h.executeAlgorithmAsync(algorithms[i], record)
+		if acquired {
+			h.limiter.release(time.Since(start))
+		}
+	}
+
+	seq.mutex.Lock()
+	endTime := h.clock.Now()
+	seq.endTime = &endTime
+	seq.mutex.Unlock()
+}
+
+// acquireSequenceSlot waits for a free execution slot, reporting false if
+// the sequence is cancelled first
+func (h *Handlers) acquireSequenceSlot(seq *sequence) bool {
+	for seq.ctx.Err() == nil {
+		if h.limiter.acquire() {
+			return true
+		}
+
+		select {
+		case <-time.After(sequenceSlotPoll):
+		case <-seq.ctx.Done():
+		}
+	}
+	return false
+}
+
+// GetSequenceStatus returns the status of a sequence and each of its executions
+func (h *Handlers) GetSequenceStatus(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	sequenceID := vars["id"]
+
+	seq, exists := h.sequences.Get(sequenceID)
+	if !exists {
+		http.Error(w, "Sequence not found", http.StatusNotFound)
+		return
+	}
+
+	seq.mutex.Lock()
+	endTime := seq.endTime
+	seq.mutex.Unlock()
+
+	status := types.StatusRunning
+	if endTime != nil {
+		status = types.StatusCompleted
+	}
+
+	items := make([]map[string]interface{}, len(seq.records))
+	for i, record := range seq.records {
+		itemStatus := record.status()
+		if itemStatus == types.StatusError && endTime != nil {
+			status = types.StatusError
+		}
+		if itemStatus == types.StatusCancelled && endTime != nil && status == types.StatusCompleted {
+			status = types.StatusCancelled
+		}
+
+		items[i] = map[string]interface{}{
+			"algorithm_id": record.execution.AlgorithmID,
+			"execution_id": record.execution.ID,
+			"status":       itemStatus,
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"id":         seq.id,
+		"status":     status,
+		"delay_ms":   seq.delay.Milliseconds(),
+		"items":      items,
+		"start_time": seq.startTime,
+		"end_time":   endTime,
+	})
+}
@@ -0,0 +1,180 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"algorthmia/internal/config"
+	"algorthmia/internal/types"
+)
+
+// sequenceStatus is the response of GET /sequences/{id}
+type sequenceStatus struct {
+	Status types.ExecutionStatus `json:"status"`
+	Items  []struct {
+		AlgorithmID string                `json:"algorithm_id"`
+		ExecutionID string                `json:"execution_id"`
+		Status      types.ExecutionStatus `json:"status"`
+	} `json:"items"`
+}
+
+// startSequence posts a sequence and returns its ID and execution IDs
+func startSequence(t *testing.T, server *httptest.Server, body map[string]interface{}) (string, []string) {
+	t.Helper()
+
+	var started struct {
+		SequenceID   string   `json:"sequence_id"`
+		ExecutionIDs []string `json:"execution_ids"`
+	}
+	if response := doJSON(t, http.MethodPost, server.URL+"/api/v1/sequence", body, &started); response.StatusCode != http.StatusOK {
+		t.Fatalf("starting sequence: status %d", response.StatusCode)
+	}
+	return started.SequenceID, started.ExecutionIDs
+}
+
+// waitForSequence polls a sequence until it ends
+func waitForSequence(t *testing.T, server *httptest.Server, id string, timeout time.Duration) sequenceStatus {
+	t.Helper()
+
+	deadline := time.Now().Add(timeout)
+	for {
+		var status sequenceStatus
+		if response := doJSON(t, http.MethodGet, server.URL+"/api/v1/sequences/"+id, nil, &status); response.StatusCode != http.StatusOK {
+			t.Fatalf("getting sequence %s: status %d", id, response.StatusCode)
+		}
+		if status.Status != types.StatusRunning {
+			return status
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("sequence %s still running after %v", id, timeout)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// TestSequenceRunsItemsInOrder runs a two-item sequence with room for only
+// one execution at a time, so the second item must take the slot the
+// first releases
+func TestSequenceRunsItemsInOrder(t *testing.T) {
+	server := newTestServer(t, &config.Config{MaxConcurrentExecutions: 1})
+
+	id, executionIDs := startSequence(t, server, map[string]interface{}{
+		"items": []map[string]interface{}{
+			{"algorithm_id": "bubble_sort", "parameters": map[string]interface{}{"array_size": 8}},
+			{"algorithm_id": "binary_search", "parameters": map[string]interface{}{"array_size": 16}},
+		},
+		"delay_ms": 20,
+	})
+	if len(executionIDs) != 2 {
+		t.Fatalf("sequence has %d executions, expected 2", len(executionIDs))
+	}
+
+	status := waitForSequence(t, server, id, 5*time.Second)
+	if status.Status != types.StatusCompleted {
+		t.Fatalf("sequence ended %s, expected completed", status.Status)
+	}
+	for i, algorithmID := range []string{"bubble_sort", "binary_search"} {
+		if item := status.Items[i]; item.AlgorithmID != algorithmID || item.ExecutionID != executionIDs[i] {
+			t.Errorf("item %d is %s (%s), expected %s (%s)", i, item.AlgorithmID, item.ExecutionID, algorithmID, executionIDs[i])
+		}
+	}
+
+	first := getExecution(t, server, executionIDs[0])
+	second := getExecution(t, server, executionIDs[1])
+	for i, execution := range []types.AlgorithmExecution{first, second} {
+		if execution.Status != types.StatusCompleted {
+			t.Errorf("item %d ended %s, expected completed", i, execution.Status)
+		}
+	}
+	if first.EndTime == nil || second.StartTime.Before(first.EndTime.Add(20*time.Millisecond)) {
+		t.Errorf("item 1 started at %v, before item 0 ended at %v plus the delay", second.StartTime, first.EndTime)
+	}
+
+	// The sequence released its slot when it finished
+	execute(t, server, "quick_sort", map[string]interface{}{})
+}
+
+// TestSequenceCancelSkipsDelay cancels the pending second item during a
+// long delay. The sequence must stop at once rather than sleep it out
+func TestSequenceCancelSkipsDelay(t *testing.T) {
+	server := newTestServer(t, &config.Config{})
+
+	id, executionIDs := startSequence(t, server, map[string]interface{}{
+		"items": []map[string]interface{}{
+			{"algorithm_id": "bubble_sort", "parameters": map[string]interface{}{"array_size": 5}},
+			{"algorithm_id": "quick_sort", "parameters": map[string]interface{}{"array_size": 5}},
+			{"algorithm_id": "merge_sort", "parameters": map[string]interface{}{"array_size": 5}},
+		},
+		"delay_ms": maxSequenceDelayMs,
+	})
+	waitForStatus(t, server, executionIDs[0], 2*time.Second, types.StatusCompleted)
+
+	url := fmt.Sprintf("%s/api/v1/executions/%s/cancel", server.URL, executionIDs[1])
+	if response := doJSON(t, http.MethodPost, url, nil, nil); response.StatusCode != http.StatusOK {
+		t.Fatalf("cancelling item 1: status %d", response.StatusCode)
+	}
+
+	status := waitForSequence(t, server, id, 2*time.Second)
+	if status.Status != types.StatusCancelled {
+		t.Errorf("sequence ended %s, expected cancelled", status.Status)
+	}
+	for i, expected := range []types.ExecutionStatus{types.StatusCompleted, types.StatusCancelled, types.StatusCancelled} {
+		if status.Items[i].Status != expected {
+			t.Errorf("item %d ended %s, expected %s", i, status.Items[i].Status, expected)
+		}
+	}
+}
+
+func TestSequenceRejectsInvalidItems(t *testing.T) {
+	server := newTestServer(t, &config.Config{})
+
+	tooMany := make([]map[string]interface{}, maxSequenceItems+1)
+	for i := range tooMany {
+		tooMany[i] = map[string]interface{}{"algorithm_id": "bubble_sort"}
+	}
+
+	bodies := []map[string]interface{}{
+		{"items": []map[string]interface{}{}},
+		{"items": tooMany},
+		{"items": []map[string]interface{}{{"algorithm_id": "bubble_sort"}}, "delay_ms": maxSequenceDelayMs + 1},
+		{"items": []map[string]interface{}{{"algorithm_id": "bubble_sort"}, {"algorithm_id": "no_such_sort"}}},
+		{"items": []map[string]interface{}{{"algorithm_id": "bubble_sort", "parameters": map[string]interface{}{"array_size": 1000}}}},
+	}
+	for _, body := range bodies {
+		if response := doJSON(t, http.MethodPost, server.URL+"/api/v1/sequence", body, nil); response.StatusCode != http.StatusBadRequest {
+			t.Errorf("sequence %v: status %d, expected %d", body, response.StatusCode, http.StatusBadRequest)
+		}
+	}
+}
+
+// TestEvictFinishedSequences keeps only the newest finished sequences
+// beyond the limit, never evicting unfinished ones
+func TestEvictFinishedSequences(t *testing.T) {
+	store := NewSequenceStore(1)
+	add := func(finished bool) *sequence {
+		seq := &sequence{id: nextID("seq"), startTime: time.Now()}
+		if finished {
+			endTime := time.Now()
+			seq.endTime = &endTime
+		}
+		store.Add(seq)
+		return seq
+	}
+
+	oldest := add(true)
+	running := add(false)
+	newest := add(true)
+
+	if _, exists := store.Get(oldest.id); exists {
+		t.Errorf("oldest finished sequence was kept")
+	}
+	if _, exists := store.Get(running.id); !exists {
+		t.Errorf("running sequence was evicted")
+	}
+	if _, exists := store.Get(newest.id); !exists {
+		t.Errorf("newest finished sequence was evicted")
+	}
+}
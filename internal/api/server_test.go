@@ -0,0 +1,136 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"algorthmia/internal/config"
+	"algorthmia/internal/types"
+	"algorthmia/internal/websocket"
+
+	"github.com/gorilla/mux"
+)
+
+// newTestServer serves the API and the WebSocket endpoint as main does
+func newTestServer(t *testing.T, cfg *config.Config) *httptest.Server {
+	t.Helper()
+
+	hub := websocket.NewHub(cfg.MaxWSConnections)
+	go hub.Run()
+
+	router := mux.NewRouter()
+	SetupRoutes(router, hub, cfg)
+	router.HandleFunc("/ws", func(w http.ResponseWriter, r *http.Request) {
+		websocket.HandleWebSocket(hub, w, r)
+	})
+
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// doJSON sends a request with an optional JSON body and decodes a JSON
+// response into out when it is not nil
+func doJSON(t *testing.T, method, url string, body interface{}, out interface{}) *http.Response {
+	t.Helper()
+
+	var reader *bytes.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			t.Fatalf("encoding request: %v", err)
+		}
+		reader = bytes.NewReader(data)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+
+	request, err := http.NewRequest(method, url, reader)
+	if err != nil {
+		t.Fatalf("building request: %v", err)
+	}
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatalf("%s %s: %v", method, url, err)
+	}
+	defer response.Body.Close()
+
+	if out != nil && response.StatusCode == http.StatusOK {
+		if err := json.NewDecoder(response.Body).Decode(out); err != nil {
+			t.Fatalf("decoding %s %s: %v", method, url, err)
+		}
+	}
+	return response
+}
+
+// fetchJSON gets a URL and decodes a 200 response into out when it is not
+// nil. Unlike doJSON it reports failures as errors, so it can be called
+// from goroutines other than the test's
+func fetchJSON(url string, out interface{}) error {
+	response, err := http.Get(url)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: status %d", url, response.StatusCode)
+	}
+	if out != nil {
+		return json.NewDecoder(response.Body).Decode(out)
+	}
+	return nil
+}
+
+// execute starts an algorithm with the given parameters and returns the
+// execution ID
+func execute(t *testing.T, server *httptest.Server, algorithmID string, parameters map[string]interface{}) string {
+	t.Helper()
+
+	var started struct {
+		ExecutionID string `json:"execution_id"`
+	}
+	url := fmt.Sprintf("%s/api/v1/algorithms/%s/execute", server.URL, algorithmID)
+	response := doJSON(t, http.MethodPost, url, map[string]interface{}{"parameters": parameters}, &started)
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("executing %s: status %d", algorithmID, response.StatusCode)
+	}
+	return started.ExecutionID
+}
+
+// getExecution fetches the stored state of an execution
+func getExecution(t *testing.T, server *httptest.Server, id string) types.AlgorithmExecution {
+	t.Helper()
+
+	var execution types.AlgorithmExecution
+	response := doJSON(t, http.MethodGet, server.URL+"/api/v1/executions/"+id, nil, &execution)
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("getting execution %s: status %d", id, response.StatusCode)
+	}
+	return execution
+}
+
+// waitForStatus polls an execution until it reaches one of the statuses,
+// failing the test if that takes longer than timeout
+func waitForStatus(t *testing.T, server *httptest.Server, id string, timeout time.Duration, statuses ...types.ExecutionStatus) types.AlgorithmExecution {
+	t.Helper()
+
+	deadline := time.Now().Add(timeout)
+	for {
+		execution := getExecution(t, server, id)
+		for _, status := range statuses {
+			if execution.Status == status {
+				return execution
+			}
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("execution %s is %s after %v, expected one of %v", id, execution.Status, timeout, statuses)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
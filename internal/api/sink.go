@@ -0,0 +1,77 @@
+package api
+
+import (
+	"encoding/json"
+	"sync"
+
+	"algorthmia/internal/types"
+	"algorthmia/internal/websocket"
+)
+
+// StepSink delivers execution messages to clients over a particular transport
+type StepSink interface {
+	Send(message types.WebSocketMessage)
+}
+
+// hubSink broadcasts an execution's messages to WebSocket clients through
+// the hub
+type hubSink struct {
+	hub         *websocket.Hub
+	executionID string
+}
+
+// Send serializes the message and broadcasts it to the WebSocket clients
+// subscribed to the execution, or to none
+func (s *hubSink) Send(message types.WebSocketMessage) {
+	jsonData, _ := json.Marshal(message)
+	s.hub.BroadcastExecution(s.executionID, jsonData)
+}
+
+// channelSink buffers messages for a single streaming subscriber. If the
+// subscriber falls too far behind the channel is closed rather than blocking
+// the execution
+type channelSink struct {
+	messages chan types.WebSocketMessage
+	closed   bool
+	mutex    sync.Mutex
+}
+
+// newChannelSink creates a channel sink with the given buffer size
+func newChannelSink(size int) *channelSink {
+	return &channelSink{
+		messages: make(chan types.WebSocketMessage, size),
+	}
+}
+
+// Send queues the message without blocking, closing the sink on overflow
+func (s *channelSink) Send(message types.WebSocketMessage) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	select {
+	case s.messages <- message:
+	default:
+		s.closeLocked()
+	}
+}
+
+// close closes the underlying channel once
+func (s *channelSink) close() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.closeLocked()
+}
+
+// closeLocked closes the channel unless it is already closed. Callers must
+// hold the sink's lock
+func (s *channelSink) closeLocked() {
+	if !s.closed {
+		s.closed = true
+		close(s.messages)
+	}
+}
@@ -0,0 +1,50 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"algorthmia/internal/algorithms/generators"
+	"algorthmia/internal/config"
+	"algorthmia/internal/types"
+)
+
+func TestSlowExecutionInputSize(t *testing.T) {
+	var output bytes.Buffer
+	log.SetOutput(&output)
+	defer log.SetOutput(os.Stderr)
+
+	h := &Handlers{config: &config.Config{SlowExecutionMs: 1}}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name       string
+		input      interface{}
+		parameters map[string]interface{}
+		expected   int
+	}{
+		{"int array", []int{5, 3, 1, 4}, map[string]interface{}{"array_size": 10}, 4},
+		{"edge list", []generators.Edge{{From: 0, To: 1, Weight: 2}, {From: 1, To: 2, Weight: 3}}, map[string]interface{}{}, 2},
+		{"matrix", [][]int{{1, 2}, {3, 4}, {5, 6}}, map[string]interface{}{}, 3},
+		{"generated array", nil, map[string]interface{}{"array_size": 12}, 12},
+		{"generated graph", nil, map[string]interface{}{"graph_size": 7}, 7},
+	}
+	for _, c := range cases {
+		output.Reset()
+		h.logSlowExecution(&types.AlgorithmExecution{
+			ID:         "exec_test",
+			Input:      c.input,
+			Parameters: c.parameters,
+			StartTime:  start,
+		}, start.Add(time.Second))
+
+		if expected := fmt.Sprintf("input_size=%d ", c.expected); !strings.Contains(output.String(), expected) {
+			t.Errorf("%s: log %q does not contain %q", c.name, output.String(), expected)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package api
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"algorthmia/internal/types"
+)
+
+// stepsTruncatedAction is the action of the marker standing in for the
+// steps dropped from a capped step log
+const stepsTruncatedAction = "steps_truncated"
+
+// stepLog stores the steps of one execution. With no caps it keeps every
+// step. With caps it keeps the first headCap steps plus the most recent
+// tailCap steps in a ring buffer, dropping the steps in between. Step and
+// action counts and the peak depth always cover every step, stored or not
+type stepLog struct {
+	headCap, tailCap int
+	head             []types.ExecutionStep
+	tail             []types.ExecutionStep // ring buffer once full
+	next             int                   // oldest entry in a full ring
+	total            int
+	firstDropped     time.Time
+	actions          map[string]int
+	peakDepth        int
+	maxDataBytes     int // 0 leaves step data intact
+}
+
+// newStepLog creates a step log; headCap and tailCap both 0 means
+// unlimited, and maxDataBytes 0 never summarizes step data
+func newStepLog(headCap, tailCap, maxDataBytes int) *stepLog {
+	return &stepLog{
+		headCap:      headCap,
+		tailCap:      tailCap,
+		maxDataBytes: maxDataBytes,
+		head:         []types.ExecutionStep{},
+		actions:      make(map[string]int),
+		peakDepth:    -1,
+	}
+}
+
+// capped reports whether the log drops steps beyond its caps
+func (l *stepLog) capped() bool {
+	return l.headCap > 0 || l.tailCap > 0
+}
+
+// add records a step, numbering it with the next Seq, and returns it.
+// Algorithms pass their working slices in step data and keep mutating them,
+// so the data is copied to capture the state at this step. Oversized data
+// is summarized here, so stored and delivered steps stay within the limit
+func (l *stepLog) add(step types.ExecutionStep) types.ExecutionStep {
+	l.total++
+	step.Seq = l.total
+	step.Data = cloneValue(reflect.ValueOf(step.Data)).Interface().(map[string]interface{})
+	step.Data = limitStepData(step.Data, l.maxDataBytes)
+
+	l.actions[step.Action]++
+	if depth, ok := step.Data["depth"].(int); ok && depth > l.peakDepth {
+		l.peakDepth = depth
+	}
+
+	switch {
+	case !l.capped() || len(l.head) < l.headCap:
+		l.head = append(l.head, step)
+	case l.tailCap == 0:
+		// Only the head is kept
+		l.markDropped(step)
+	case len(l.tail) < l.tailCap:
+		l.tail = append(l.tail, step)
+	default:
+		l.markDropped(l.tail[l.next])
+		l.tail[l.next] = step
+		l.next = (l.next + 1) % l.tailCap
+	}
+
+	return step
+}
+
+// markDropped notes the time of the first step to be dropped
+func (l *stepLog) markDropped(step types.ExecutionStep) {
+	if l.firstDropped.IsZero() {
+		l.firstDropped = step.Timestamp
+	}
+}
+
+// dropped returns the number of steps that were not stored
+func (l *stepLog) dropped() int {
+	return l.total - len(l.head) - len(l.tail)
+}
+
+// steps returns the stored steps in order. If steps were dropped, a
+// steps_truncated marker with Seq 0 sits between the head and the tail,
+// giving the number and Seq range of the missing steps
+func (l *stepLog) steps() []types.ExecutionStep {
+	steps := make([]types.ExecutionStep, 0, len(l.head)+len(l.tail)+1)
+	steps = append(steps, l.head...)
+
+	if dropped := l.dropped(); dropped > 0 {
+		first := len(l.head) + 1
+		steps = append(steps, types.ExecutionStep{
+			StepNumber: -1,
+			Action:     stepsTruncatedAction,
+			Data: map[string]interface{}{
+				"dropped":           dropped,
+				"first_dropped_seq": first,
+				"last_dropped_seq":  first + dropped - 1,
+			},
+			Message:   fmt.Sprintf("%d steps were not stored", dropped),
+			Timestamp: l.firstDropped,
+		})
+	}
+
+	steps = append(steps, l.tail[l.next:]...)
+	steps = append(steps, l.tail[:l.next]...)
+
+	return steps
+}
+
+// cloneValue deep-copies the slices, arrays and maps inside a value,
+// keeping their types. Pointers and struct fields are shared
+func cloneValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		clone := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			clone.Index(i).Set(cloneValue(v.Index(i)))
+		}
+		return clone
+	case reflect.Array:
+		clone := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			clone.Index(i).Set(cloneValue(v.Index(i)))
+		}
+		return clone
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		clone := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			clone.SetMapIndex(iter.Key(), cloneValue(iter.Value()))
+		}
+		return clone
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		clone := reflect.New(v.Type()).Elem()
+		clone.Set(cloneValue(v.Elem()))
+		return clone
+	default:
+		return v
+	}
+}
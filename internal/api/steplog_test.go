@@ -0,0 +1,77 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"algorthmia/internal/types"
+)
+
+// fillStepLog adds count steps to a log, each carrying its index
+func fillStepLog(log *stepLog, count int) {
+	for i := 0; i < count; i++ {
+		log.add(types.ExecutionStep{
+			StepNumber: i,
+			Action:     "compare",
+			Data:       map[string]interface{}{"index": i},
+			Timestamp:  time.Now(),
+		})
+	}
+}
+
+// storedSeqs returns the Seq of each stored step, with 0 for the marker
+func storedSeqs(steps []types.ExecutionStep) []int {
+	seqs := make([]int, len(steps))
+	for i, step := range steps {
+		seqs[i] = step.Seq
+	}
+	return seqs
+}
+
+func TestStepLogTruncation(t *testing.T) {
+	cases := []struct {
+		name             string
+		headCap, tailCap int
+		expected         []int
+	}{
+		{"uncapped", 0, 0, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
+		{"head and tail", 3, 4, []int{1, 2, 3, 0, 7, 8, 9, 10}},
+		{"head only", 3, 0, []int{1, 2, 3, 0}},
+		{"tail only", 0, 4, []int{0, 7, 8, 9, 10}},
+		{"under the caps", 8, 8, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
+	}
+
+	for _, c := range cases {
+		log := newStepLog(c.headCap, c.tailCap, 0)
+		fillStepLog(log, 10)
+
+		steps := log.steps()
+		seqs := storedSeqs(steps)
+		if len(seqs) != len(c.expected) {
+			t.Errorf("%s: stored Seqs %v, expected %v", c.name, seqs, c.expected)
+			continue
+		}
+		for i := range seqs {
+			if seqs[i] != c.expected[i] {
+				t.Errorf("%s: stored Seqs %v, expected %v", c.name, seqs, c.expected)
+				break
+			}
+		}
+
+		if log.total != 10 {
+			t.Errorf("%s: counted %d steps, expected 10", c.name, log.total)
+		}
+
+		// The marker accounts for exactly the missing Seqs
+		for _, step := range steps {
+			if step.Action != stepsTruncatedAction {
+				continue
+			}
+			dropped := step.Data["dropped"].(int)
+			first, last := step.Data["first_dropped_seq"].(int), step.Data["last_dropped_seq"].(int)
+			if dropped != log.dropped() || dropped != 10-len(steps)+1 || last-first+1 != dropped {
+				t.Errorf("%s: marker drops %d steps from %d to %d, but %d were dropped", c.name, dropped, first, last, log.dropped())
+			}
+		}
+	}
+}
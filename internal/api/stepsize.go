@@ -0,0 +1,111 @@
+package api
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+// stepSampleSize is how many leading elements (and, for matrices, leading
+// columns of each sampled row) a summary keeps
+const stepSampleSize = 5
+
+// limitStepData summarizes the largest arrays, matrices and maps of step
+// data until it serializes to at most maxBytes, largest first. Summarized
+// data gains truncated: true and the names of the summarized fields in
+// truncated_fields. Scalars are never summarized, so data of only scalars
+// can stay over the limit. A maxBytes of 0 leaves the data intact
+func limitStepData(data map[string]interface{}, maxBytes int) map[string]interface{} {
+	if maxBytes <= 0 {
+		return data
+	}
+
+	encoded, err := json.Marshal(data)
+	if err != nil || len(encoded) <= maxBytes {
+		return data
+	}
+
+	// Collect the collection-valued fields with their serialized sizes
+	type field struct {
+		name string
+		size int
+	}
+	fields := []field{}
+	for name, value := range data {
+		switch reflect.ValueOf(value).Kind() {
+		case reflect.Slice, reflect.Array, reflect.Map:
+			if encodedField, err := json.Marshal(value); err == nil {
+				fields = append(fields, field{name: name, size: len(encodedField)})
+			}
+		}
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		if fields[i].size != fields[j].size {
+			return fields[i].size > fields[j].size
+		}
+		return fields[i].name < fields[j].name
+	})
+
+	size := len(encoded)
+	truncated := []string{}
+	for _, f := range fields {
+		if size <= maxBytes {
+			break
+		}
+
+		summary := summarizeValue(reflect.ValueOf(data[f.name]))
+		encodedSummary, _ := json.Marshal(summary)
+		if len(encodedSummary) >= f.size {
+			continue
+		}
+
+		data[f.name] = summary
+		size -= f.size - len(encodedSummary)
+		truncated = append(truncated, f.name)
+	}
+
+	if len(truncated) > 0 {
+		sort.Strings(truncated)
+		data["truncated"] = true
+		data["truncated_fields"] = truncated
+	}
+	return data
+}
+
+// summarizeValue describes an array, matrix or map by its dimensions and a
+// small sample of its leading elements
+func summarizeValue(v reflect.Value) map[string]interface{} {
+	summary := map[string]interface{}{"truncated": true}
+
+	if v.Kind() == reflect.Map {
+		summary["dimensions"] = []int{v.Len()}
+		return summary
+	}
+
+	dimensions := []int{v.Len()}
+	sample := []interface{}{}
+	for i := 0; i < v.Len() && i < stepSampleSize; i++ {
+		element := v.Index(i)
+		for element.Kind() == reflect.Interface && !element.IsNil() {
+			element = element.Elem()
+		}
+
+		// Rows of a matrix are cut down to their leading columns
+		if element.Kind() == reflect.Slice || element.Kind() == reflect.Array {
+			if i == 0 {
+				dimensions = append(dimensions, element.Len())
+			}
+			row := []interface{}{}
+			for j := 0; j < element.Len() && j < stepSampleSize; j++ {
+				row = append(row, element.Index(j).Interface())
+			}
+			sample = append(sample, row)
+			continue
+		}
+		sample = append(sample, element.Interface())
+	}
+
+	summary["dimensions"] = dimensions
+	summary["sample"] = sample
+	return summary
+}
@@ -0,0 +1,96 @@
+package api
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"algorthmia/internal/types"
+)
+
+func TestLimitStepData(t *testing.T) {
+	array := make([]int, 200)
+	for i := range array {
+		array[i] = i
+	}
+	matrix := make([][]int, 30)
+	for i := range matrix {
+		matrix[i] = make([]int, 30)
+	}
+
+	data := map[string]interface{}{
+		"array":   array,
+		"matrix":  matrix,
+		"small":   []int{1, 2},
+		"current": 7,
+	}
+	limited := limitStepData(data, 400)
+
+	encoded, err := json.Marshal(limited)
+	if err != nil {
+		t.Fatalf("encoding: %v", err)
+	}
+	if len(encoded) > 400 {
+		t.Errorf("limited data is %d bytes, over the 400 byte limit: %s", len(encoded), encoded)
+	}
+
+	if limited["truncated"] != true {
+		t.Errorf("truncated is %v, expected true", limited["truncated"])
+	}
+	if fields := limited["truncated_fields"]; !reflect.DeepEqual(fields, []string{"array", "matrix"}) {
+		t.Errorf("truncated_fields %v, expected [array matrix]", fields)
+	}
+	if limited["current"] != 7 || !reflect.DeepEqual(limited["small"], []int{1, 2}) {
+		t.Errorf("small fields changed: current %v, small %v", limited["current"], limited["small"])
+	}
+
+	summary := limited["matrix"].(map[string]interface{})
+	if !reflect.DeepEqual(summary["dimensions"], []int{30, 30}) {
+		t.Errorf("matrix dimensions %v, expected [30 30]", summary["dimensions"])
+	}
+	if sample := summary["sample"].([]interface{}); len(sample) != stepSampleSize || len(sample[0].([]interface{})) != stepSampleSize {
+		t.Errorf("matrix sample %v, expected %d×%d", sample, stepSampleSize, stepSampleSize)
+	}
+
+	summary = limited["array"].(map[string]interface{})
+	if !reflect.DeepEqual(summary["dimensions"], []int{200}) || !reflect.DeepEqual(summary["sample"], []interface{}{0, 1, 2, 3, 4}) {
+		t.Errorf("array summarized as %v", summary)
+	}
+}
+
+func TestLimitStepDataWithinLimit(t *testing.T) {
+	data := map[string]interface{}{"array": []int{3, 1, 2}, "current": 1}
+
+	for _, maxBytes := range []int{0, 1000} {
+		limited := limitStepData(data, maxBytes)
+		if _, ok := limited["truncated"]; ok {
+			t.Errorf("limit %d: data within the limit was flagged truncated", maxBytes)
+		}
+		if !reflect.DeepEqual(limited["array"], []int{3, 1, 2}) {
+			t.Errorf("limit %d: array changed to %v", maxBytes, limited["array"])
+		}
+	}
+}
+
+// TestStepLogLimitsStepData checks that stored steps are truncated, while
+// the algorithm's own step data is left alone
+func TestStepLogLimitsStepData(t *testing.T) {
+	log := newStepLog(0, 0, 200)
+	data := map[string]interface{}{"array": make([]int, 100)}
+
+	stored := log.add(types.ExecutionStep{
+		Action:    "compare",
+		Data:      data,
+		Timestamp: time.Now(),
+	})
+	if stored.Data["truncated"] != true {
+		t.Errorf("stored step was not truncated: %v", stored.Data)
+	}
+	if array, ok := data["array"].([]int); !ok || len(array) != 100 {
+		t.Errorf("the algorithm's step data was changed to %v", data)
+	}
+	if steps := log.steps(); steps[0].Data["truncated"] != true {
+		t.Errorf("step log kept the untruncated step")
+	}
+}
@@ -0,0 +1,423 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"algorthmia/internal/types"
+)
+
+// lastID is the most recently issued ID timestamp
+var lastID int64
+
+// nextID returns a unique ID with the given prefix. IDs are based on the
+// current time in nanoseconds, bumped when several are issued at once
+func nextID(prefix string) string {
+	for {
+		last := atomic.LoadInt64(&lastID)
+		id := time.Now().UnixNano()
+		if id <= last {
+			id = last + 1
+		}
+		if atomic.CompareAndSwapInt64(&lastID, last, id) {
+			return fmt.Sprintf("%s_%d", prefix, id)
+		}
+	}
+}
+
+// ExecutionStore keeps executions in memory so they can be queried and
+// streamed. Each execution stores at most the first stepsHead and the last
+// stepsTail of its steps; both 0 stores every step. Step data larger than
+// maxStepBytes when serialized is summarized; 0 leaves it intact. Beyond
+// maxFinished finished executions the oldest are evicted; 0 keeps them all.
+// Records read the time from clock
+type ExecutionStore struct {
+	executions   map[string]*executionRecord
+	stepsHead    int
+	stepsTail    int
+	maxStepBytes int
+	maxFinished  int
+	clock        Clock
+	mutex        sync.RWMutex
+}
+
+// executionRecord guards a single execution together with the sinks
+// receiving its messages. Cancelling ctx stops the run at its next step.
+// While the execution is paused, resumed is open and closes when it resumes
+type executionRecord struct {
+	execution *types.AlgorithmExecution
+	algorithm types.AlgorithmExecutor
+	sinks     map[StepSink]bool
+	steps     *stepLog
+	clock     Clock
+	final     *types.WebSocketMessage
+	ctx       context.Context
+	cancel    context.CancelCauseFunc
+	resumed   chan struct{}
+	mutex     sync.Mutex
+}
+
+// NewExecutionStore creates an empty execution store whose executions keep
+// at most stepsHead leading and stepsTail trailing steps, each with at most
+// maxStepBytes of serialized data, and which keeps at most maxFinished
+// finished executions
+func NewExecutionStore(stepsHead, stepsTail, maxStepBytes, maxFinished int) *ExecutionStore {
+	return &ExecutionStore{
+		executions:   make(map[string]*executionRecord),
+		stepsHead:    stepsHead,
+		stepsTail:    stepsTail,
+		maxStepBytes: maxStepBytes,
+		maxFinished:  maxFinished,
+		clock:        realClock{},
+	}
+}
+
+// Add stores an execution and returns its record
+func (s *ExecutionStore) Add(execution *types.AlgorithmExecution, algorithm types.AlgorithmExecutor) *executionRecord {
+	ctx, cancel := context.WithCancelCause(context.Background())
+	record := &executionRecord{
+		execution: execution,
+		algorithm: algorithm,
+		sinks:     make(map[StepSink]bool),
+		steps:     newStepLog(s.stepsHead, s.stepsTail, s.maxStepBytes),
+		clock:     s.clock,
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+
+	s.mutex.Lock()
+	s.executions[execution.ID] = record
+	s.evictFinished()
+	s.mutex.Unlock()
+
+	return record
+}
+
+// evictFinished removes the oldest finished executions while more than
+// maxFinished are stored. Unfinished executions are never evicted. Callers
+// must hold the store's lock
+func (s *ExecutionStore) evictFinished() {
+	if s.maxFinished <= 0 {
+		return
+	}
+
+	finished := []string{}
+	for id, record := range s.executions {
+		if record.finished() {
+			finished = append(finished, id)
+		}
+	}
+	if len(finished) <= s.maxFinished {
+		return
+	}
+
+	// IDs are issued in increasing time order, so the smallest are oldest
+	sort.Strings(finished)
+	for _, id := range finished[:len(finished)-s.maxFinished] {
+		delete(s.executions, id)
+	}
+}
+
+// Get returns the record for an execution
+func (s *ExecutionStore) Get(id string) (*executionRecord, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	record, exists := s.executions[id]
+	return record, exists
+}
+
+// List returns a listing of every execution of the given algorithm with
+// the given status, newest first; empty strings match any. IDs are issued
+// in increasing time order, so they give the order the executions were
+// created in, including those still pending
+func (s *ExecutionStore) List(algorithmID string, status types.ExecutionStatus) []executionListing {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	listings := []executionListing{}
+	for _, record := range s.executions {
+		listing := record.listing()
+		if (algorithmID == "" || listing.AlgorithmID == algorithmID) && (status == "" || listing.Status == status) {
+			listings = append(listings, listing)
+		}
+	}
+
+	sort.Slice(listings, func(i, j int) bool {
+		return listings[i].ID > listings[j].ID
+	})
+
+	return listings
+}
+
+// CancelClient cancels every unfinished execution started on behalf of a
+// WebSocket client, returning how many were cancelled
+func (s *ExecutionStore) CancelClient(clientID string) int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	cancelled := 0
+	for _, record := range s.executions {
+		if record.execution.ClientID == clientID && record.requestCancel() {
+			cancelled++
+		}
+	}
+
+	return cancelled
+}
+
+// requestCancel cancels the execution's context unless it has already
+// finished, reporting whether it did. The run stops at its next step
+func (r *executionRecord) requestCancel() bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.final != nil {
+		return false
+	}
+
+	r.cancel(errExecutionCancelled)
+	return true
+}
+
+// pause marks a running execution as paused, so its next step waits for
+// resume, and delivers an execution_pause message. It reports whether the
+// execution was running
+func (r *executionRecord) pause() bool {
+	r.mutex.Lock()
+	if r.final != nil || r.execution.Status != types.StatusRunning {
+		r.mutex.Unlock()
+		return false
+	}
+
+	r.execution.Status = types.StatusPaused
+	r.resumed = make(chan struct{})
+	message, sinks := r.controlMessage(types.MessageTypeExecutionPause), r.sinkList()
+	r.mutex.Unlock()
+
+	deliver(sinks, message)
+	return true
+}
+
+// resume lets a paused execution continue and delivers an
+// execution_resume message. It reports whether the execution was paused
+func (r *executionRecord) resume() bool {
+	r.mutex.Lock()
+	if r.final != nil || r.execution.Status != types.StatusPaused {
+		r.mutex.Unlock()
+		return false
+	}
+
+	r.execution.Status = types.StatusRunning
+	close(r.resumed)
+	r.resumed = nil
+	message, sinks := r.controlMessage(types.MessageTypeExecutionResume), r.sinkList()
+	r.mutex.Unlock()
+
+	deliver(sinks, message)
+	return true
+}
+
+// waitIfPaused blocks while the execution is paused. It returns the cause
+// if the execution is cancelled while waiting
+func (r *executionRecord) waitIfPaused() error {
+	r.mutex.Lock()
+	resumed := r.resumed
+	r.mutex.Unlock()
+
+	if resumed == nil {
+		return nil
+	}
+
+	select {
+	case <-resumed:
+		return nil
+	case <-r.ctx.Done():
+		return context.Cause(r.ctx)
+	}
+}
+
+// controlMessage builds a pause or resume message. Callers must hold the
+// record's lock
+func (r *executionRecord) controlMessage(messageType types.WebSocketMessageType) types.WebSocketMessage {
+	return types.WebSocketMessage{
+		Type: string(messageType),
+		Data: map[string]interface{}{
+			"execution_id": r.execution.ID,
+			"status":       r.execution.Status,
+		},
+		Timestamp: r.clock.Now(),
+	}
+}
+
+// sinkList returns the attached sinks. Callers must hold the record's lock
+// and deliver to the sinks only after releasing it, since a sink may block:
+// the hub's waits for the hub, which may itself be waiting on this record
+func (r *executionRecord) sinkList() []StepSink {
+	sinks := make([]StepSink, 0, len(r.sinks))
+	for sink := range r.sinks {
+		sinks = append(sinks, sink)
+	}
+	return sinks
+}
+
+// deliver sends a message to each sink
+func deliver(sinks []StepSink, message types.WebSocketMessage) {
+	for _, sink := range sinks {
+		sink.Send(message)
+	}
+}
+
+// attach registers a sink that receives every message published for the execution
+func (r *executionRecord) attach(sink StepSink) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.sinks[sink] = true
+}
+
+// publishStep records a step and delivers it to all attached sinks. Steps
+// are numbered with a strictly increasing Seq here, under the record's lock,
+// so clients can detect dropped or reordered frames whatever StepNumber the
+// algorithm reported. Every step is delivered, even if the step log does
+// not keep it. Only the executing goroutine publishes, so each sink still
+// receives the steps in order
+func (r *executionRecord) publishStep(step types.ExecutionStep) {
+	r.mutex.Lock()
+	message, sinks := r.stepMessage(r.steps.add(step)), r.sinkList()
+	r.mutex.Unlock()
+
+	deliver(sinks, message)
+}
+
+// stepMessageData is the data of an execution_step message: the step's
+// fields alongside the execution it belongs to, so clients watching
+// several executions can tell their steps apart
+type stepMessageData struct {
+	types.ExecutionStep
+	ExecutionID string `json:"execution_id"`
+	AlgorithmID string `json:"algorithm_id"`
+}
+
+// stepMessage wraps a step in an execution_step message
+func (r *executionRecord) stepMessage(step types.ExecutionStep) types.WebSocketMessage {
+	return types.WebSocketMessage{
+		Type: string(types.MessageTypeExecutionStep),
+		Data: stepMessageData{
+			ExecutionStep: step,
+			ExecutionID:   r.execution.ID,
+			AlgorithmID:   r.execution.AlgorithmID,
+		},
+		Timestamp: step.Timestamp,
+	}
+}
+
+// start marks a pending execution as running
+func (r *executionRecord) start() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.execution.Status = types.StatusRunning
+	r.execution.StartTime = r.clock.Now()
+}
+
+// finished reports whether the execution has completed, failed or been
+// cancelled
+func (r *executionRecord) finished() bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.final != nil
+}
+
+// status returns the current status of the execution
+func (r *executionRecord) status() types.ExecutionStatus {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.execution.Status
+}
+
+// stepsCount returns the number of steps published so far, stored or not
+func (r *executionRecord) stepsCount() int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.steps.total
+}
+
+// finish records the outcome of the execution and delivers the final message
+func (r *executionRecord) finish(status types.ExecutionStatus, output interface{}, message types.WebSocketMessage) {
+	r.mutex.Lock()
+	endTime := message.Timestamp
+	r.execution.Status = status
+	r.execution.EndTime = &endTime
+	r.execution.Output = output
+	r.final = &message
+	sinks := r.sinkList()
+
+	// Release the context now that nothing can be cancelled
+	r.cancel(nil)
+	r.mutex.Unlock()
+
+	deliver(sinks, message)
+}
+
+// subscribe returns the messages for the steps recorded so far and, unless
+// the execution has already finished, attaches the sink for live updates.
+// The final message is returned if the execution has finished
+func (r *executionRecord) subscribe(sink StepSink) ([]types.WebSocketMessage, *types.WebSocketMessage) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	steps := r.steps.steps()
+	backlog := make([]types.WebSocketMessage, len(steps))
+	for i, step := range steps {
+		backlog[i] = r.stepMessage(step)
+	}
+
+	if r.final == nil {
+		r.sinks[sink] = true
+	}
+
+	return backlog, r.final
+}
+
+// unsubscribe detaches a sink previously attached with subscribe
+func (r *executionRecord) unsubscribe(sink *channelSink) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	delete(r.sinks, sink)
+	sink.close()
+}
+
+// listing returns the execution's fields shown in a list
+func (r *executionRecord) listing() executionListing {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return executionListing{
+		ID:          r.execution.ID,
+		AlgorithmID: r.execution.AlgorithmID,
+		Status:      r.execution.Status,
+		StartTime:   r.execution.StartTime,
+		EndTime:     r.execution.EndTime,
+		StepsCount:  r.steps.total,
+	}
+}
+
+// snapshot returns a copy of the execution that is safe to serialize
+func (r *executionRecord) snapshot() types.AlgorithmExecution {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	execution := *r.execution
+	execution.Steps = r.steps.steps()
+
+	return execution
+}
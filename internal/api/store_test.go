@@ -0,0 +1,222 @@
+package api
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"algorthmia/internal/config"
+	"algorthmia/internal/types"
+)
+
+// TestRecordConcurrentAccess publishes steps and finishes an execution
+// while other goroutines read it the way the handlers do. Run with -race
+func TestRecordConcurrentAccess(t *testing.T) {
+	store := NewExecutionStore(0, 0, 0, 0)
+	record := store.Add(&types.AlgorithmExecution{
+		ID:          nextID("exec"),
+		AlgorithmID: "bubble_sort",
+		Steps:       []types.ExecutionStep{},
+		Status:      types.StatusPending,
+	}, nil)
+	record.start()
+
+	done := make(chan struct{})
+	var readers sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		readers.Add(1)
+		go func() {
+			defer readers.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+
+				execution := record.snapshot()
+				if _, err := json.Marshal(execution); err != nil {
+					t.Errorf("encoding snapshot: %v", err)
+					return
+				}
+				record.listing()
+				record.status()
+				store.List("", "")
+
+				sink := newChannelSink(8)
+				record.subscribe(sink)
+				record.unsubscribe(sink)
+			}
+		}()
+	}
+
+	const steps = 500
+	for i := 0; i < steps; i++ {
+		record.publishStep(types.ExecutionStep{
+			StepNumber: i,
+			Action:     "compare",
+			Data:       map[string]interface{}{"array": []int{i, i + 1}},
+			Timestamp:  time.Now(),
+		})
+	}
+	record.finish(types.StatusCompleted, []int{1, 2, 3}, types.WebSocketMessage{
+		Type:      string(types.MessageTypeExecutionComplete),
+		Timestamp: time.Now(),
+	})
+
+	close(done)
+	readers.Wait()
+
+	execution := record.snapshot()
+	if execution.Status != types.StatusCompleted || execution.EndTime == nil {
+		t.Fatalf("execution is %s with end time %v, expected completed", execution.Status, execution.EndTime)
+	}
+	if len(execution.Steps) != steps {
+		t.Errorf("stored %d steps, expected %d", len(execution.Steps), steps)
+	}
+}
+
+// TestPollingRunningExecutions reads executions over HTTP while they run
+func TestPollingRunningExecutions(t *testing.T) {
+	server := newTestServer(t, &config.Config{})
+
+	ids := make([]string, 4)
+	for i := range ids {
+		ids[i] = execute(t, server, "bubble_sort", map[string]interface{}{"array_size": 25})
+	}
+
+	deadline := time.Now().Add(10 * time.Second)
+	var pollers sync.WaitGroup
+	for _, id := range ids {
+		pollers.Add(1)
+		go func(id string) {
+			defer pollers.Done()
+			for {
+				var execution types.AlgorithmExecution
+				if err := fetchJSON(server.URL+"/api/v1/executions/"+id, &execution); err != nil {
+					t.Errorf("getting execution: %v", err)
+					return
+				}
+				if execution.Status == types.StatusCompleted {
+					return
+				}
+				if execution.Status == types.StatusError || time.Now().After(deadline) {
+					t.Errorf("execution %s is %s, expected it to complete", id, execution.Status)
+					return
+				}
+				if err := fetchJSON(server.URL+"/api/v1/executions", nil); err != nil {
+					t.Errorf("listing executions: %v", err)
+					return
+				}
+			}
+		}(id)
+	}
+	pollers.Wait()
+}
+
+// TestStepSeqStrictlyIncreasing publishes steps from several goroutines and
+// checks that the stored steps are numbered 1, 2, 3, ... in order
+func TestStepSeqStrictlyIncreasing(t *testing.T) {
+	store := NewExecutionStore(0, 0, 0, 0)
+	record := store.Add(&types.AlgorithmExecution{
+		ID:          nextID("exec"),
+		AlgorithmID: "merge_sort",
+		Steps:       []types.ExecutionStep{},
+		Status:      types.StatusPending,
+	}, nil)
+	record.start()
+
+	const publishers, steps = 4, 100
+	sink := newChannelSink(publishers * steps)
+	record.attach(sink)
+
+	var wg sync.WaitGroup
+	for p := 0; p < publishers; p++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < steps; i++ {
+				// StepNumber is the algorithm's own numbering and may repeat
+				record.publishStep(types.ExecutionStep{StepNumber: -1, Action: "compare", Timestamp: time.Now()})
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i, step := range record.snapshot().Steps {
+		if step.Seq != i+1 {
+			t.Fatalf("stored step %d has Seq %d, expected %d", i, step.Seq, i+1)
+		}
+	}
+
+	// Publishers race for delivery, so delivered steps are only checked
+	// to be numbered uniquely
+	seen := make(map[int]bool)
+	for i := 0; i < publishers*steps; i++ {
+		message := <-sink.messages
+		seq := message.Data.(stepMessageData).Seq
+		if seq < 1 || seq > publishers*steps || seen[seq] {
+			t.Fatalf("delivered step has Seq %d, which is out of range or repeated", seq)
+		}
+		seen[seq] = true
+	}
+}
+
+// TestExecutionStepSeq checks Seq on the steps of a real execution
+func TestExecutionStepSeq(t *testing.T) {
+	server := newTestServer(t, &config.Config{})
+	id := execute(t, server, "heap_sort", map[string]interface{}{"array_size": 20})
+
+	execution := waitForStatus(t, server, id, 5*time.Second, types.StatusCompleted)
+	if len(execution.Steps) == 0 {
+		t.Fatalf("execution stored no steps")
+	}
+	for i, step := range execution.Steps {
+		if step.Seq != i+1 {
+			t.Fatalf("step %d has Seq %d, expected %d", i, step.Seq, i+1)
+		}
+	}
+}
+
+// TestEvictFinishedExecutions keeps only the newest finished executions
+// beyond the limit, never evicting unfinished ones
+func TestEvictFinishedExecutions(t *testing.T) {
+	store := NewExecutionStore(0, 0, 0, 2)
+	add := func() *executionRecord {
+		return store.Add(&types.AlgorithmExecution{
+			ID:          nextID("exec"),
+			AlgorithmID: "bubble_sort",
+			Steps:       []types.ExecutionStep{},
+			Status:      types.StatusRunning,
+		}, nil)
+	}
+	finish := func(record *executionRecord) {
+		record.finish(types.StatusCompleted, nil, types.WebSocketMessage{
+			Type:      string(types.MessageTypeExecutionComplete),
+			Timestamp: time.Now(),
+		})
+	}
+
+	running := add()
+	records := []*executionRecord{}
+	for i := 0; i < 4; i++ {
+		record := add()
+		finish(record)
+		records = append(records, record)
+	}
+	add()
+
+	if _, exists := store.Get(running.execution.ID); !exists {
+		t.Errorf("running execution was evicted")
+	}
+	for i, record := range records {
+		_, exists := store.Get(record.execution.ID)
+		if expected := i >= len(records)-2; exists != expected {
+			t.Errorf("finished execution %d stored %v, expected %v", i, exists, expected)
+		}
+	}
+	if listed := len(store.List("", "")); listed != 4 {
+		t.Errorf("listed %d executions, expected 2 finished and 2 running", listed)
+	}
+}
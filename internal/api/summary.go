@@ -0,0 +1,63 @@
+package api
+
+import (
+	"time"
+
+	"algorthmia/internal/types"
+)
+
+// operationCounters are the output fields reported as operation counts in
+// an execution summary
+var operationCounters = []string{"comparisons", "swaps", "collisions", "probes", "iterations", "nodes_visited", "edges_examined", "max_frontier_size"}
+
+// summarizeExecution aggregates the steps of an execution into a post-run
+// report: duration, step counts by action, peak recursion depth and the
+// operation counters found in the output. The counts cover every published
+// step, including any the step log did not store
+func summarizeExecution(execution *types.AlgorithmExecution, steps *stepLog, output interface{}, endTime time.Time) map[string]interface{} {
+	actions := make(map[string]int, len(steps.actions))
+	for action, count := range steps.actions {
+		actions[action] = count
+	}
+
+	summary := map[string]interface{}{
+		"duration_ms":     float64(endTime.Sub(execution.StartTime).Microseconds()) / 1000,
+		"steps_count":     steps.total,
+		"steps_by_action": actions,
+	}
+
+	if steps.peakDepth >= 0 {
+		summary["peak_depth"] = steps.peakDepth
+	}
+
+	if dropped := steps.dropped(); dropped > 0 {
+		summary["steps_dropped"] = dropped
+	}
+
+	if counters := operationCounts(output); len(counters) > 0 {
+		summary["operations"] = counters
+	}
+
+	return summary
+}
+
+// operationCounts returns the operation counters found in an output map
+func operationCounts(output interface{}) map[string]interface{} {
+	counters := make(map[string]interface{})
+	if result, ok := output.(map[string]interface{}); ok {
+		for _, name := range operationCounters {
+			if value, exists := result[name]; exists {
+				counters[name] = value
+			}
+		}
+	}
+	return counters
+}
+
+// summary returns the summary of the steps published so far
+func (r *executionRecord) summary(output interface{}, endTime time.Time) map[string]interface{} {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return summarizeExecution(r.execution, r.steps, output, endTime)
+}
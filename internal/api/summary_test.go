@@ -0,0 +1,44 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"algorthmia/internal/algorithms/sorting"
+	"algorthmia/internal/types"
+)
+
+// TestSummaryActionsSumToTotal checks that the steps counted by action add
+// up to the step count, whether or not the step log stores every step
+func TestSummaryActionsSumToTotal(t *testing.T) {
+	for _, caps := range [][2]int{{0, 0}, {5, 5}, {3, 0}} {
+		steps := newStepLog(caps[0], caps[1], 0)
+		execution := &types.AlgorithmExecution{StartTime: time.Now()}
+
+		output, err := sorting.NewBubbleSort().Execute(context.Background(), []int{5, 1, 4, 2, 3}, map[string]interface{}{}, func(step types.ExecutionStep) {
+			steps.add(step)
+		})
+		if err != nil {
+			t.Fatalf("executing: %v", err)
+		}
+
+		summary := summarizeExecution(execution, steps, output, time.Now())
+		total := summary["steps_count"].(int)
+		if total != steps.total {
+			t.Errorf("caps %v: steps_count %d, expected %d", caps, total, steps.total)
+		}
+
+		sum := 0
+		for _, count := range summary["steps_by_action"].(map[string]int) {
+			sum += count
+		}
+		if sum != total {
+			t.Errorf("caps %v: steps by action sum to %d, expected %d", caps, sum, total)
+		}
+
+		if dropped, _ := summary["steps_dropped"].(int); dropped != steps.dropped() {
+			t.Errorf("caps %v: steps_dropped %d, expected %d", caps, dropped, steps.dropped())
+		}
+	}
+}
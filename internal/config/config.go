@@ -2,19 +2,49 @@ package config
 
 import (
 	"os"
+	"strconv"
+	"strings"
 )
 
 type Config struct {
-	Port        string
-	Environment string
-	Debug       bool
+	Port                    string
+	Environment             string
+	Debug                   bool
+	SelfTestOnStartup       bool
+	APIAuthEnabled          bool
+	APIKeys                 map[string]string // API key -> label
+	APIAuthProtectReads     bool
+	SlowExecutionMs         int // 0 disables slow execution logging
+	MaxWSConnections        int // 0 means unlimited
+	CancelOnDisconnect      bool
+	StoredStepsHead         int      // Leading steps kept per execution; 0 with StoredStepsTail 0 keeps all
+	StoredStepsTail         int      // Trailing steps kept per execution
+	AlgorithmsAllow         []string // Algorithm IDs to expose; empty exposes all
+	AlgorithmsDeny          []string // Algorithm IDs never exposed
+	MaxConcurrentExecutions int      // 0 means unlimited
+	MaxStepDataBytes        int      // 0 never summarizes step data
+	MaxStoredExecutions     int      // Finished executions and sequences kept; 0 keeps all
 }
 
 func Load() *Config {
 	return &Config{
-		Port:        getEnv("PORT", "8080"),
-		Environment: getEnv("ENVIRONMENT", "development"),
-		Debug:       getEnv("DEBUG", "false") == "true",
+		Port:                    getEnv("PORT", "8080"),
+		Environment:             getEnv("ENVIRONMENT", "development"),
+		Debug:                   getEnv("DEBUG", "false") == "true",
+		SelfTestOnStartup:       getEnv("SELF_TEST_ON_STARTUP", "false") == "true",
+		APIAuthEnabled:          getEnv("API_AUTH_ENABLED", "false") == "true",
+		APIKeys:                 parseAPIKeys(getEnv("API_KEYS", "")),
+		APIAuthProtectReads:     getEnv("API_AUTH_PROTECT_READS", "false") == "true",
+		SlowExecutionMs:         getEnvInt("SLOW_EXECUTION_MS", 0),
+		MaxWSConnections:        getEnvInt("MAX_WS_CONNECTIONS", 1000),
+		CancelOnDisconnect:      getEnv("CANCEL_ON_DISCONNECT", "true") == "true",
+		StoredStepsHead:         getEnvInt("STORED_STEPS_HEAD", 0),
+		StoredStepsTail:         getEnvInt("STORED_STEPS_TAIL", 0),
+		AlgorithmsAllow:         parseList(getEnv("ALGORITHMS_ALLOW", "")),
+		AlgorithmsDeny:          parseList(getEnv("ALGORITHMS_DENY", "")),
+		MaxConcurrentExecutions: getEnvInt("MAX_CONCURRENT_EXECUTIONS", 0),
+		MaxStepDataBytes:        getEnvInt("MAX_STEP_DATA_BYTES", 0),
+		MaxStoredExecutions:     getEnvInt("MAX_STORED_EXECUTIONS", 1000),
 	}
 }
 
@@ -24,3 +54,45 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+// getEnvInt reads an integer variable, falling back to the default when it
+// is unset or malformed
+func getEnvInt(key string, defaultValue int) int {
+	if value, err := strconv.Atoi(os.Getenv(key)); err == nil {
+		return value
+	}
+	return defaultValue
+}
+
+// parseAPIKeys parses a comma-separated list of "label:key" pairs. Keys
+// without a label are labelled "default"
+func parseAPIKeys(value string) map[string]string {
+	keys := make(map[string]string)
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		label, key := "default", entry
+		if i := strings.Index(entry, ":"); i >= 0 {
+			label, key = entry[:i], entry[i+1:]
+		}
+
+		if key != "" {
+			keys[key] = label
+		}
+	}
+	return keys
+}
+
+// parseList parses a comma-separated list, dropping empty entries
+func parseList(value string) []string {
+	items := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
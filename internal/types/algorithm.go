@@ -1,6 +1,9 @@
 package types
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 // AlgorithmCategory represents the category of an algorithm
 type AlgorithmCategory string
@@ -18,6 +21,30 @@ const (
 	CategoryOptimization       AlgorithmCategory = "optimization"
 )
 
+// Categories lists every algorithm category
+var Categories = []AlgorithmCategory{
+	CategorySorting,
+	CategorySearching,
+	CategoryGraphsTrees,
+	CategoryPathfinding,
+	CategoryDynamicProgramming,
+	CategoryGreedy,
+	CategoryStrings,
+	CategoryNumberTheory,
+	CategoryRandomized,
+	CategoryOptimization,
+}
+
+// IsValid reports whether the category is one of the known categories
+func (c AlgorithmCategory) IsValid() bool {
+	for _, category := range Categories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
 // Algorithm represents a single algorithm with its metadata
 type Algorithm struct {
 	ID          string            `json:"id"`
@@ -26,6 +53,31 @@ type Algorithm struct {
 	Description string            `json:"description"`
 	BigO        string            `json:"big_o"`
 	Parameters  []Parameter       `json:"parameters"`
+	InputType   InputType         `json:"input_type,omitempty"`
+	RelatedIDs  []string          `json:"related_ids,omitempty"`
+}
+
+// InputType declares the shape of the input an algorithm accepts, so
+// request input can be converted from JSON before execution
+type InputType string
+
+const (
+	InputIntArray      InputType = "int_array"      // []int
+	InputIntMatrix     InputType = "int_matrix"     // [][]int
+	InputFloatMatrix   InputType = "float_matrix"   // [][]float64
+	InputEdgeList      InputType = "edge_list"      // []generators.Edge, from {"from", "to", "weight"} objects
+	InputPointList     InputType = "point_list"     // []generators.Point, from {"x", "y"} objects
+	InputBoolGrid      InputType = "bool_grid"      // [][]bool, indexed [y][x]
+	InputAdjacencyList InputType = "adjacency_list" // [][]int
+)
+
+// InstanceGenerator is implemented by algorithms that generate their problem
+// instance, such as a graph, grid or point set, from their parameters. When
+// a request gives no input, the executor generates the instance before the
+// run starts and passes it to Execute as input, so the stored execution
+// holds the exact instance and reruns reuse it
+type InstanceGenerator interface {
+	GenerateInstance(parameters map[string]interface{}) interface{}
 }
 
 // Parameter represents a configurable parameter for an algorithm
@@ -39,6 +91,11 @@ type Parameter struct {
 	Required    bool        `json:"required"`
 }
 
+// IntPtr returns a pointer to i, for a Parameter's Min and Max bounds
+func IntPtr(i int) *int {
+	return &i
+}
+
 // AlgorithmExecution represents the execution state of an algorithm
 type AlgorithmExecution struct {
 	ID          string                 `json:"id"`
@@ -50,6 +107,7 @@ type AlgorithmExecution struct {
 	Status      ExecutionStatus        `json:"status"`
 	StartTime   time.Time              `json:"start_time"`
 	EndTime     *time.Time             `json:"end_time,omitempty"`
+	ClientID    string                 `json:"client_id,omitempty"`
 }
 
 // ExecutionStep represents a single step in algorithm execution
@@ -59,6 +117,8 @@ type ExecutionStep struct {
 	Data       map[string]interface{} `json:"data"`
 	Message    string                 `json:"message,omitempty"`
 	Timestamp  time.Time              `json:"timestamp"`
+	Seq        int                    `json:"seq"`                // Per-execution delivery order, starting at 1
+	Progress   *float64               `json:"progress,omitempty"` // Estimated fraction of the work done, from 0 to 1
 }
 
 // ExecutionStatus represents the current status of algorithm execution
@@ -73,10 +133,12 @@ const (
 	StatusCancelled ExecutionStatus = "cancelled"
 )
 
-// AlgorithmExecutor defines the interface that all algorithms must implement
+// AlgorithmExecutor defines the interface that all algorithms must implement.
+// Execute checks ctx between steps and, once it is cancelled, stops and
+// returns ctx.Err()
 type AlgorithmExecutor interface {
 	GetMetadata() Algorithm
-	Execute(input interface{}, parameters map[string]interface{}, stepCallback func(ExecutionStep)) (interface{}, error)
+	Execute(ctx context.Context, input interface{}, parameters map[string]interface{}, stepCallback func(ExecutionStep)) (interface{}, error)
 	ValidateParameters(parameters map[string]interface{}) error
 }
 
@@ -97,4 +159,9 @@ const (
 	MessageTypeExecutionPause    WebSocketMessageType = "execution_pause"
 	MessageTypeExecutionResume   WebSocketMessageType = "execution_resume"
 	MessageTypeExecutionCancel   WebSocketMessageType = "execution_cancel"
+	MessageTypeConnection        WebSocketMessageType = "connection"
+
+	// Sent by clients to choose the executions they receive
+	MessageTypeSubscribe   WebSocketMessageType = "subscribe"
+	MessageTypeUnsubscribe WebSocketMessageType = "unsubscribe"
 )
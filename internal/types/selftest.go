@@ -0,0 +1,7 @@
+package types
+
+// SelfTester is implemented by algorithms that can verify their own
+// correctness by running on a small, known input
+type SelfTester interface {
+	SelfTest() error
+}
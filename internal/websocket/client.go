@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"time"
 
+	"algorthmia/internal/types"
+
 	"github.com/gorilla/websocket"
 )
 
@@ -34,31 +36,54 @@ var upgrader = websocket.Upgrader{
 
 // HandleWebSocket handles WebSocket connections
 func HandleWebSocket(hub *Hub, w http.ResponseWriter, r *http.Request) {
+	// Refuse the handshake outright once the connection limit is reached
+	if !hub.acquireConnection() {
+		log.Printf("WebSocket connection rejected: limit of %d reached", hub.maxConnections)
+		http.Error(w, "Too many WebSocket connections", http.StatusServiceUnavailable)
+		return
+	}
+
 	conn, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
+		hub.releaseConnection()
 		log.Printf("WebSocket upgrade error: %v", err)
 		return
 	}
 
 	client := &Client{
-		hub:  hub,
-		conn: conn,
-		send: make(chan []byte, 256),
+		id:            nextClientID(),
+		hub:           hub,
+		conn:          conn,
+		send:          make(chan []byte, 256),
+		subscriptions: make(map[string]bool),
 	}
 
 	client.hub.register <- client
 
+	// Tell the client its ID so it can tie the executions it starts to this connection
+	client.SendMessage(string(types.MessageTypeConnection), map[string]interface{}{
+		"client_id": client.id,
+	})
+
 	// Allow collection of memory referenced by the caller by doing all work in
 	// new goroutines
 	go client.writePump()
 	go client.readPump()
 }
 
+// clientMessage is a message sent by a client, such as
+// {"type":"subscribe","execution_id":"exec_..."}
+type clientMessage struct {
+	Type        string `json:"type"`
+	ExecutionID string `json:"execution_id"`
+}
+
 // readPump pumps messages from the websocket connection to the hub
 func (c *Client) readPump() {
 	defer func() {
 		c.hub.unregister <- c
 		c.conn.Close()
+		c.hub.releaseConnection()
 	}()
 
 	c.conn.SetReadLimit(maxMessageSize)
@@ -69,13 +94,26 @@ func (c *Client) readPump() {
 	})
 
 	for {
-		_, _, err := c.conn.ReadMessage()
+		_, data, err := c.conn.ReadMessage()
 		if err != nil {
 			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
 				log.Printf("WebSocket error: %v", err)
 			}
 			break
 		}
+
+		// Messages other than subscription changes are ignored
+		var message clientMessage
+		if json.Unmarshal(data, &message) != nil || message.ExecutionID == "" {
+			continue
+		}
+
+		switch types.WebSocketMessageType(message.Type) {
+		case types.MessageTypeSubscribe:
+			c.hub.subscribe(c, message.ExecutionID)
+		case types.MessageTypeUnsubscribe:
+			c.hub.unsubscribe(c, message.ExecutionID)
+		}
 	}
 }
 
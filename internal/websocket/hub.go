@@ -1,8 +1,10 @@
 package websocket
 
 import (
+	"fmt"
 	"log"
 	"sync"
+	"sync/atomic"
 
 	"github.com/gorilla/websocket"
 )
@@ -12,8 +14,8 @@ type Hub struct {
 	// Registered clients
 	clients map[*Client]bool
 
-	// Inbound messages from the clients
-	broadcast chan []byte
+	// Messages to deliver to the clients
+	broadcast chan broadcastMessage
 
 	// Register requests from the clients
 	register chan *Client
@@ -23,22 +25,53 @@ type Hub struct {
 
 	// Mutex for thread safety
 	mutex sync.RWMutex
+
+	// Open connections, counted from before the upgrade until the connection closes
+	connections int64
+
+	// Maximum number of open connections; 0 means unlimited
+	maxConnections int64
+
+	// Callbacks run with a client's ID when it disconnects
+	disconnectHandlers []func(clientID string)
 }
 
-// Client represents a single WebSocket connection
+// broadcastMessage is a message for the clients, from the execution with
+// the given ID or, when it is empty, for every client
+type broadcastMessage struct {
+	executionID string
+	data        []byte
+}
+
+// Client represents a single WebSocket connection. A client with no
+// subscriptions receives every message; one that has subscribed receives
+// only the messages of the executions it subscribed to. Subscriptions are
+// guarded by the hub's mutex
 type Client struct {
-	hub  *Hub
-	conn *websocket.Conn
-	send chan []byte
+	id            string
+	hub           *Hub
+	conn          *websocket.Conn
+	send          chan []byte
+	subscriptions map[string]bool
 }
 
-// NewHub creates a new WebSocket hub
-func NewHub() *Hub {
+// lastClientID is the sequence number of the most recently connected client
+var lastClientID int64
+
+// nextClientID returns a unique ID for a new connection
+func nextClientID() string {
+	return fmt.Sprintf("client_%d", atomic.AddInt64(&lastClientID, 1))
+}
+
+// NewHub creates a new WebSocket hub accepting at most maxConnections
+// connections at once; 0 means unlimited
+func NewHub(maxConnections int) *Hub {
 	return &Hub{
-		clients:    make(map[*Client]bool),
-		broadcast:  make(chan []byte),
-		register:   make(chan *Client),
-		unregister: make(chan *Client),
+		clients:        make(map[*Client]bool),
+		broadcast:      make(chan broadcastMessage),
+		register:       make(chan *Client),
+		unregister:     make(chan *Client),
+		maxConnections: int64(maxConnections),
 	}
 }
 
@@ -58,27 +91,67 @@ func (h *Hub) Run() {
 				delete(h.clients, client)
 				close(client.send)
 			}
+			handlers := h.disconnectHandlers
 			h.mutex.Unlock()
 			log.Printf("Client disconnected. Total clients: %d", len(h.clients))
 
+			// Handlers may wait on executions that are themselves waiting to
+			// broadcast through this loop, so they must not run on it
+			for _, handler := range handlers {
+				go handler(client.id)
+			}
+
 		case message := <-h.broadcast:
-			h.mutex.RLock()
+			// Dropping a client that has fallen behind needs the write lock
+			h.mutex.Lock()
 			for client := range h.clients {
+				if !client.receives(message.executionID) {
+					continue
+				}
 				select {
-				case client.send <- message:
+				case client.send <- message.data:
 				default:
 					close(client.send)
 					delete(h.clients, client)
 				}
 			}
-			h.mutex.RUnlock()
+			h.mutex.Unlock()
 		}
 	}
 }
 
 // Broadcast sends a message to all connected clients
 func (h *Hub) Broadcast(message []byte) {
-	h.broadcast <- message
+	h.broadcast <- broadcastMessage{data: message}
+}
+
+// BroadcastExecution sends a message from an execution to the clients
+// subscribed to it and to the clients that have not subscribed to any
+func (h *Hub) BroadcastExecution(executionID string, message []byte) {
+	h.broadcast <- broadcastMessage{executionID: executionID, data: message}
+}
+
+// subscribe adds an execution to those the client receives
+func (h *Hub) subscribe(client *Client, executionID string) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	client.subscriptions[executionID] = true
+}
+
+// unsubscribe removes an execution from those the client receives. A
+// client left with no subscriptions receives every message again
+func (h *Hub) unsubscribe(client *Client, executionID string) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	delete(client.subscriptions, executionID)
+}
+
+// receives reports whether the client should get a message from the
+// given execution. Callers must hold the hub's mutex
+func (c *Client) receives(executionID string) bool {
+	return executionID == "" || len(c.subscriptions) == 0 || c.subscriptions[executionID]
 }
 
 // GetClientCount returns the number of connected clients
@@ -87,3 +160,42 @@ func (h *Hub) GetClientCount() int {
 	defer h.mutex.RUnlock()
 	return len(h.clients)
 }
+
+// HasClient reports whether a client with the given ID is connected
+func (h *Hub) HasClient(clientID string) bool {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	for client := range h.clients {
+		if client.id == clientID {
+			return true
+		}
+	}
+	return false
+}
+
+// OnDisconnect registers a callback run with a client's ID when it disconnects
+func (h *Hub) OnDisconnect(handler func(clientID string)) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.disconnectHandlers = append(h.disconnectHandlers, handler)
+}
+
+// acquireConnection reserves a connection slot, reporting false if the hub is full
+func (h *Hub) acquireConnection() bool {
+	for {
+		current := atomic.LoadInt64(&h.connections)
+		if h.maxConnections > 0 && current >= h.maxConnections {
+			return false
+		}
+		if atomic.CompareAndSwapInt64(&h.connections, current, current+1) {
+			return true
+		}
+	}
+}
+
+// releaseConnection frees a slot reserved by acquireConnection
+func (h *Hub) releaseConnection() {
+	atomic.AddInt64(&h.connections, -1)
+}
@@ -0,0 +1,56 @@
+package websocket
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// TestConnectionLimit fills the hub and checks that the next handshake is
+// refused with 503, and that closing a connection frees its slot
+func TestConnectionLimit(t *testing.T) {
+	hub := NewHub(2)
+	go hub.Run()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		HandleWebSocket(hub, w, r)
+	}))
+	defer server.Close()
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	var conns []*websocket.Conn
+	for i := 0; i < 2; i++ {
+		conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+		if err != nil {
+			t.Fatalf("connection %d: %v", i, err)
+		}
+		defer conn.Close()
+		conns = append(conns, conn)
+	}
+
+	_, response, err := websocket.DefaultDialer.Dial(url, nil)
+	if err == nil {
+		t.Fatalf("connection over the limit was accepted")
+	}
+	if response == nil || response.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("connection over the limit: %v, expected status %d", err, http.StatusServiceUnavailable)
+	}
+
+	conns[0].Close()
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+		if err == nil {
+			conn.Close()
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("no slot freed after closing a connection: %v", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
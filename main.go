@@ -15,7 +15,7 @@ import (
 
 func main() {
 	// Load configuration
-	_ = config.Load()
+	cfg := config.Load()
 
 	// Create router
 	router := mux.NewRouter()
@@ -29,11 +29,11 @@ func main() {
 	})
 
 	// Setup WebSocket hub
-	hub := websocket.NewHub()
+	hub := websocket.NewHub(cfg.MaxWSConnections)
 	go hub.Run()
 
 	// Setup API routes
-	api.SetupRoutes(router, hub)
+	api.SetupRoutes(router, hub, cfg)
 
 	// Setup WebSocket route
 	router.HandleFunc("/ws", func(w http.ResponseWriter, r *http.Request) {